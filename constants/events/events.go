@@ -0,0 +1,95 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+// event types emitted by the transactions, auxiliaries and blockers of the modules
+const (
+	ClassificationDefined = "classification_defined"
+
+	MaintainerCreated   = "maintainer_created"
+	MaintainerDeputized = "maintainer_deputized"
+	MaintainerRevoked   = "maintainer_revoked"
+
+	AssetMinted      = "asset_minted"
+	AssetMutated     = "asset_mutated"
+	AssetRenumerated = "asset_renumerated"
+	AssetBurned      = "asset_burned"
+
+	IdentityIssued        = "identity_issued"
+	IdentityNubbed        = "identity_nubbed"
+	IdentityMutated       = "identity_mutated"
+	IdentityProvisioned   = "identity_provisioned"
+	IdentityUnprovisioned = "identity_unprovisioned"
+	IdentityQuashed       = "identity_quashed"
+
+	MetaRevealed = "meta_revealed"
+
+	OrderMade      = "order_made"
+	OrderModified  = "order_modified"
+	OrderCancelled = "order_cancelled"
+	OrderExecuted  = "order_executed"
+	OrderExpired   = "order_expired"
+
+	StopMade      = "stop_made"
+	StopTriggered = "stop_triggered"
+
+	AuctionCleared = "auction_cleared"
+
+	SplitMinted      = "split_minted"
+	SplitBurned      = "split_burned"
+	SplitRenumerated = "split_renumerated"
+	SplitTransferred = "split_transferred"
+	SplitWrapped     = "split_wrapped"
+	SplitUnwrapped   = "split_unwrapped"
+	SplitVested      = "split_vested"
+	SplitDistributed = "split_distributed"
+	SplitClaimed     = "split_claimed"
+	SplitReclaimed   = "split_reclaimed"
+
+	EscrowLocked   = "escrow_locked"
+	EscrowReleased = "escrow_released"
+	EscrowSettled  = "escrow_settled"
+
+	AllowanceApproved = "allowance_approved"
+	AllowanceRevoked  = "allowance_revoked"
+
+	ParameterChanged = "parameter_changed"
+)
+
+// attribute keys of the events
+const (
+	AttributeKeyClassificationID = "classification_id"
+	AttributeKeyIdentityID       = "identity_id"
+	AttributeKeyAssetID          = "asset_id"
+	AttributeKeyMetaID           = "meta_id"
+	AttributeKeyOrderID          = "order_id"
+	AttributeKeyOwnableID        = "ownable_id"
+	AttributeKeyHeldOwnableID    = "held_ownable_id"
+
+	AttributeKeyFromID  = "from_id"
+	AttributeKeyToID    = "to_id"
+	AttributeKeyAddress = "address"
+	AttributeKeyValue   = "value"
+	AttributeKeyCoins   = "coins"
+
+	AttributeKeyHolderID    = "holder_id"
+	AttributeKeyReferenceID = "reference_id"
+	AttributeKeySpenderID   = "spender_id"
+
+	AttributeKeyModifiedOrderID   = "modified_order_id"
+	AttributeKeyMakerID           = "maker_id"
+	AttributeKeyTakerID           = "taker_id"
+	AttributeKeyMakerOwnableID    = "maker_ownable_id"
+	AttributeKeyTakerOwnableID    = "taker_ownable_id"
+	AttributeKeyMakerOwnableSplit = "maker_ownable_split"
+	AttributeKeyTakerOwnableSplit = "taker_ownable_split"
+	AttributeKeyExchangeRate      = "exchange_rate"
+	AttributeKeyTriggerRate       = "trigger_rate"
+	AttributeKeyFee               = "fee"
+	AttributeKeyRoyalty           = "royalty"
+
+	AttributeKeyModule      = "module"
+	AttributeKeyParameterID = "parameter_id"
+	AttributeKeyData        = "data"
+)
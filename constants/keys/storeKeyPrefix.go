@@ -17,6 +17,19 @@ const (
 	Metas
 	Orders
 	Splits
+	Indexes
+	Supplies
+	Versions
+	Expiries
+	Trades
+	Stops
+	LastPrices
+	Escrows
+	Vestings
+	Allowances
+	Claims
+	Distributions
+	Checkpoints
 )
 
 // TODO migrate to utilities
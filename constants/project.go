@@ -13,4 +13,9 @@ const PropertyIDAndDataSeparator = ":"
 const DataTypeAndValueSeparator = "|"
 const ToHashSeparator = "_"
 
+// MaxPropertyCount bounds the number of properties a classification can ever define, the max property count classifications are
+// defined under is governed within it
 const MaxPropertyCount = 22
+
+const DefaultPaginationLimit = 100
+const MaxPaginationLimit = 1000
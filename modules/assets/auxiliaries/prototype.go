@@ -4,10 +4,13 @@
 package auxiliaries
 
 import (
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 )
 
 func Prototype() helpers.Auxiliaries {
-	return baseHelpers.NewAuxiliaries()
+	return baseHelpers.NewAuxiliaries(
+		royalty.Auxiliary,
+	)
 }
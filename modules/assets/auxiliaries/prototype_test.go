@@ -4,25 +4,14 @@
 package auxiliaries
 
 import (
-	"github.com/AssetMantle/modules/schema/helpers"
-	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
-	"reflect"
 	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
 )
 
 func TestPrototype(t *testing.T) {
-	tests := []struct {
-		name string
-		want helpers.Auxiliaries
-	}{
-
-		{"+ve", baseHelpers.NewAuxiliaries()},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := Prototype(); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Prototype() = %v, want %v", got, tt.want)
-			}
-		})
-	}
+	require.Equal(t, royalty.Auxiliary.GetName(), Prototype().Get(royalty.Auxiliary.GetName()).GetName())
+	require.Equal(t, 1, len(Prototype().GetList()))
 }
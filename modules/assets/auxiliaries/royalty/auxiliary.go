@@ -0,0 +1,18 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package royalty
+
+import (
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+)
+
+var Auxiliary = baseHelpers.NewAuxiliary(
+	"royalty",
+	keeperPrototype,
+)
+
+var AuxiliaryMock = baseHelpers.NewAuxiliary(
+	"royalty",
+	keeperPrototypeMock,
+)
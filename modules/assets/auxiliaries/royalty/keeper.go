@@ -0,0 +1,82 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package royalty
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/assets/internal/key"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+type auxiliaryKeeper struct {
+	mapper              helpers.Mapper
+	supplementAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeper)(nil)
+
+func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request helpers.AuxiliaryRequest) helpers.AuxiliaryResponse {
+	auxiliaryRequest := auxiliaryRequestFromInterface(request)
+
+	// ownables that are not assets, like coins, read as partial asset keys
+	assetKey := key.FromID(auxiliaryRequest.OwnableID)
+	if assetKey.IsPartial() {
+		return newAuxiliaryResponse(nil, sdkTypes.ZeroDec(), nil)
+	}
+
+	asset, ok := auxiliaryKeeper.mapper.NewCollection(context).Fetch(assetKey).Get(assetKey).(mappables.Asset)
+	if !ok {
+		return newAuxiliaryResponse(nil, sdkTypes.ZeroDec(), nil)
+	}
+
+	recipientProperty, rateProperty := asset.GetImmutablePropertyList().GetProperty(constants.RoyaltyRecipientProperty), asset.GetImmutablePropertyList().GetProperty(constants.RoyaltyRateProperty)
+	if recipientProperty == nil || rateProperty == nil {
+		return newAuxiliaryResponse(nil, sdkTypes.ZeroDec(), nil)
+	}
+
+	metaProperties, err := supplement.GetMetaPropertiesFromResponse(auxiliaryKeeper.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(recipientProperty, rateProperty)))
+	if err != nil {
+		return newAuxiliaryResponse(nil, sdkTypes.Dec{}, err)
+	}
+
+	recipientMetaProperty, rateMetaProperty := metaProperties.GetMetaProperty(constants.RoyaltyRecipientProperty), metaProperties.GetMetaProperty(constants.RoyaltyRateProperty)
+	if recipientMetaProperty == nil || rateMetaProperty == nil {
+		return newAuxiliaryResponse(nil, sdkTypes.Dec{}, errors.MetaDataError)
+	}
+
+	rate := rateMetaProperty.GetData().(data.DecData).Get()
+	if rate.IsNegative() || rate.GTE(sdkTypes.OneDec()) {
+		return newAuxiliaryResponse(nil, sdkTypes.Dec{}, errors.InvalidParameter)
+	}
+
+	return newAuxiliaryResponse(recipientMetaProperty.GetData().(data.IDData).Get(), rate, nil)
+}
+
+func (auxiliaryKeeper auxiliaryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	auxiliaryKeeper.mapper = mapper
+
+	for _, externalKeeper := range auxiliaries {
+		switch value := externalKeeper.(type) {
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case supplement.Auxiliary.GetName():
+				auxiliaryKeeper.supplementAuxiliary = value
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return auxiliaryKeeper
+}
+
+func keeperPrototype() helpers.AuxiliaryKeeper {
+	return auxiliaryKeeper{}
+}
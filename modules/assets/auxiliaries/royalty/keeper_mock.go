@@ -0,0 +1,39 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package royalty
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+type auxiliaryKeeperMock struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeperMock)(nil)
+
+// Help returns the rate the ownable ID reads as, paid to the royaltyRecipientID identity, and no royalty for ownable IDs that are not rates
+func (auxiliaryKeeper auxiliaryKeeperMock) Help(_ sdkTypes.Context, request helpers.AuxiliaryRequest) helpers.AuxiliaryResponse {
+	auxiliaryRequest := auxiliaryRequestFromInterface(request)
+	if auxiliaryRequest.OwnableID.String() == "royaltyError" {
+		return newAuxiliaryResponse(nil, sdkTypes.Dec{}, errors.MockError)
+	}
+
+	if rate, err := sdkTypes.NewDecFromStr(auxiliaryRequest.OwnableID.String()); err == nil {
+		return newAuxiliaryResponse(baseIDs.NewID("royaltyRecipientID"), rate, nil)
+	}
+
+	return newAuxiliaryResponse(nil, sdkTypes.ZeroDec(), nil)
+}
+
+func (auxiliaryKeeperMock) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
+	return auxiliaryKeeperMock{mapper: mapper}
+}
+func keeperPrototypeMock() helpers.AuxiliaryKeeper {
+	return auxiliaryKeeperMock{}
+}
@@ -0,0 +1,110 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package royalty
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/assets/internal/key"
+	"github.com/AssetMantle/modules/modules/assets/internal/mappable"
+	"github.com/AssetMantle/modules/modules/assets/internal/mapper"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/properties"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+func createTestInput(t *testing.T) (sdkTypes.Context, helpers.Mapper, helpers.AuxiliaryKeeper, helpers.Auxiliary) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	metasStoreKey := sdkTypes.NewKVStoreKey("testMetas")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	metasModule := metas.Prototype().Initialize(metasStoreKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+	Mapper := mapper.Prototype().Initialize(storeKey)
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(metasStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	for _, parameter := range metasModule.GetParameters().GetList() {
+		metasModule.GetParameters().Mutate(context, parameter)
+	}
+
+	testAuxiliaryKeeper := keeperPrototype().Initialize(Mapper, nil, []interface{}{metasModule.GetAuxiliary(supplement.Auxiliary.GetName())}).(helpers.AuxiliaryKeeper)
+
+	return context, Mapper, testAuxiliaryKeeper, metasModule.GetAuxiliary(scrub.Auxiliary.GetName())
+}
+
+func addTestAsset(t *testing.T, context sdkTypes.Context, mapper helpers.Mapper, scrubAuxiliary helpers.Auxiliary, metaPropertyList ...properties.MetaProperty) ids.ID {
+	immutableProperties, err := scrub.GetPropertiesFromResponse(scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(metaPropertyList...)))
+	require.Nil(t, err)
+
+	assetID := key.NewAssetID(baseIDs.NewID("classification"), immutableProperties)
+	mapper.NewCollection(context).Add(mappable.NewAsset(assetID, immutableProperties, baseLists.NewPropertyList()))
+
+	return assetID
+}
+
+func Test_Royalty_Keeper(t *testing.T) {
+	context, mapper, testAuxiliaryKeeper, scrubAuxiliary := createTestInput(t)
+
+	recipientID := baseIDs.NewID("recipientID")
+	royaltyRecipient := baseProperties.NewMetaProperty(constants.RoyaltyRecipientProperty.GetKey(), baseData.NewIDData(recipientID))
+
+	// coins and assets without royalties
+	for _, ownableID := range []ids.ID{baseIDs.NewID("stake"), baseIDs.NewID("classification.missing"), addTestAsset(t, context, mapper, scrubAuxiliary, royaltyRecipient)} {
+		gotRecipientID, rate, err := GetRoyaltyFromResponse(testAuxiliaryKeeper.Help(context, NewAuxiliaryRequest(ownableID)))
+		require.Nil(t, err)
+		require.Nil(t, gotRecipientID)
+		require.Equal(t, sdkTypes.ZeroDec(), rate)
+	}
+
+	assetID := addTestAsset(t, context, mapper, scrubAuxiliary, royaltyRecipient, baseProperties.NewMetaProperty(constants.RoyaltyRateProperty.GetKey(), baseData.NewDecData(sdkTypes.NewDecWithPrec(5, 2))))
+	gotRecipientID, rate, err := GetRoyaltyFromResponse(testAuxiliaryKeeper.Help(context, NewAuxiliaryRequest(assetID)))
+	require.Nil(t, err)
+	require.Equal(t, recipientID, gotRecipientID)
+	require.Equal(t, sdkTypes.NewDecWithPrec(5, 2), rate)
+
+	assetID = addTestAsset(t, context, mapper, scrubAuxiliary, royaltyRecipient, baseProperties.NewMetaProperty(constants.RoyaltyRateProperty.GetKey(), baseData.NewDecData(sdkTypes.OneDec())))
+	_, _, err = GetRoyaltyFromResponse(testAuxiliaryKeeper.Help(context, NewAuxiliaryRequest(assetID)))
+	require.Equal(t, errors.InvalidParameter, err)
+}
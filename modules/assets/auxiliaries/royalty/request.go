@@ -0,0 +1,37 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package royalty
+
+import (
+	"github.com/asaskevich/govalidator"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+)
+
+type auxiliaryRequest struct {
+	OwnableID ids.ID `json:"ownableID" valid:"required~required field ownableID missing"`
+}
+
+var _ helpers.AuxiliaryRequest = (*auxiliaryRequest)(nil)
+
+func (auxiliaryRequest auxiliaryRequest) Validate() error {
+	_, err := govalidator.ValidateStruct(auxiliaryRequest)
+	return err
+}
+
+func auxiliaryRequestFromInterface(request helpers.AuxiliaryRequest) auxiliaryRequest {
+	switch value := request.(type) {
+	case auxiliaryRequest:
+		return value
+	default:
+		return auxiliaryRequest{}
+	}
+}
+
+func NewAuxiliaryRequest(ownableID ids.ID) helpers.AuxiliaryRequest {
+	return auxiliaryRequest{
+		OwnableID: ownableID,
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package royalty
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_Royalty_Request(t *testing.T) {
+	ownableID := baseIDs.NewID("ownableID")
+
+	testAuxiliaryRequest := NewAuxiliaryRequest(ownableID)
+
+	require.Equal(t, auxiliaryRequest{OwnableID: ownableID}, testAuxiliaryRequest)
+	require.Equal(t, nil, testAuxiliaryRequest.Validate())
+	require.Equal(t, testAuxiliaryRequest, auxiliaryRequestFromInterface(testAuxiliaryRequest))
+	require.Equal(t, auxiliaryRequest{}, auxiliaryRequestFromInterface(nil))
+}
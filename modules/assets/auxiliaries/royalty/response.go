@@ -0,0 +1,57 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package royalty
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+)
+
+type auxiliaryResponse struct {
+	Success     bool         `json:"success"`
+	Error       error        `json:"error"`
+	RecipientID ids.ID       `json:"recipientID"`
+	Rate        sdkTypes.Dec `json:"rate"`
+}
+
+var _ helpers.AuxiliaryResponse = (*auxiliaryResponse)(nil)
+
+func (auxiliaryResponse auxiliaryResponse) IsSuccessful() bool {
+	return auxiliaryResponse.Success
+}
+func (auxiliaryResponse auxiliaryResponse) GetError() error {
+	return auxiliaryResponse.Error
+}
+func newAuxiliaryResponse(recipientID ids.ID, rate sdkTypes.Dec, error error) helpers.AuxiliaryResponse {
+	if error != nil {
+		return auxiliaryResponse{
+			Success: false,
+			Error:   error,
+		}
+	}
+
+	return auxiliaryResponse{
+		Success:     true,
+		RecipientID: recipientID,
+		Rate:        rate,
+	}
+}
+
+// GetRoyaltyFromResponse returns the identity royalties on the ownable are paid to and the fraction of the proceeds of its sales they take,
+// the rate is zero for ownables without royalties
+func GetRoyaltyFromResponse(response helpers.AuxiliaryResponse) (ids.ID, sdkTypes.Dec, error) {
+	switch value := response.(type) {
+	case auxiliaryResponse:
+		if value.IsSuccessful() {
+			return value.RecipientID, value.Rate, nil
+		}
+
+		return nil, sdkTypes.Dec{}, value.GetError()
+	default:
+		return nil, sdkTypes.Dec{}, errors.InvalidRequest
+	}
+}
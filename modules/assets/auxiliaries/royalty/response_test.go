@@ -0,0 +1,39 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package royalty
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_Royalty_Response(t *testing.T) {
+	recipientID := baseIDs.NewID("recipientID")
+
+	testAuxiliaryResponse := newAuxiliaryResponse(recipientID, sdkTypes.NewDecWithPrec(5, 2), nil)
+	require.Equal(t, auxiliaryResponse{Success: true, Error: nil, RecipientID: recipientID, Rate: sdkTypes.NewDecWithPrec(5, 2)}, testAuxiliaryResponse)
+	require.Equal(t, true, testAuxiliaryResponse.IsSuccessful())
+	require.Equal(t, nil, testAuxiliaryResponse.GetError())
+
+	testAuxiliaryResponse2 := newAuxiliaryResponse(nil, sdkTypes.Dec{}, errors.MetaDataError)
+	require.Equal(t, auxiliaryResponse{Success: false, Error: errors.MetaDataError}, testAuxiliaryResponse2)
+	require.Equal(t, false, testAuxiliaryResponse2.IsSuccessful())
+	require.Equal(t, errors.MetaDataError, testAuxiliaryResponse2.GetError())
+
+	gotRecipientID, rate, err := GetRoyaltyFromResponse(testAuxiliaryResponse)
+	require.Equal(t, recipientID, gotRecipientID)
+	require.Equal(t, sdkTypes.NewDecWithPrec(5, 2), rate)
+	require.Nil(t, err)
+
+	_, _, err = GetRoyaltyFromResponse(testAuxiliaryResponse2)
+	require.Equal(t, errors.MetaDataError, err)
+
+	_, _, err = GetRoyaltyFromResponse(nil)
+	require.Equal(t, errors.InvalidRequest, err)
+}
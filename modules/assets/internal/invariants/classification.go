@@ -0,0 +1,64 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/assets/internal/key"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// classificationInvariant checks that every asset references an existing classification
+type classificationInvariant struct {
+	mapper           helpers.Mapper
+	conformAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.Invariant = (*classificationInvariant)(nil)
+
+func (classificationInvariant) GetName() string {
+	return "classification"
+}
+func (classificationInvariant classificationInvariant) Check(context sdkTypes.Context) (string, bool) {
+	var message string
+
+	count := 0
+
+	classificationInvariant.mapper.NewCollection(context).Iterate(
+		key.FromID(baseIDs.NewID("")),
+		func(mappable helpers.Mappable) bool {
+			asset := mappable.(mappables.Asset)
+
+			if auxiliaryResponse := classificationInvariant.conformAuxiliary.GetKeeper().Help(context, conform.NewAuxiliaryRequest(asset.GetClassificationID(), nil, nil)); !auxiliaryResponse.IsSuccessful() {
+				count++
+				message += fmt.Sprintf("\tasset %s references classification %s: %s\n", asset.GetID().String(), asset.GetClassificationID().String(), auxiliaryResponse.GetError())
+			}
+
+			return false
+		},
+	)
+
+	return fmt.Sprintf("found %d assets without a classification\n%s", count, message), count != 0
+}
+func (classificationInvariant classificationInvariant) Initialize(mapper helpers.Mapper, _ helpers.Parameters, auxiliaries ...interface{}) helpers.Invariant {
+	classificationInvariant.mapper = mapper
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case conform.Auxiliary.GetName():
+				classificationInvariant.conformAuxiliary = value
+			}
+		}
+	}
+
+	return classificationInvariant
+}
@@ -4,7 +4,7 @@
 package parameters
 
 import (
-	"github.com/AssetMantle/modules/modules/assets/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/assets/internal/parameters/royalties"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	"reflect"
 	"testing"
@@ -18,7 +18,7 @@ func TestPrototype(t *testing.T) {
 		wantError error
 	}{
 		// TODO: Update test case.
-		{"+ve", baseHelpers.NewParameters(dummy.Parameter).String(), nil},
+		{"+ve", baseHelpers.NewParameters(royalties.Parameter).String(), nil},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
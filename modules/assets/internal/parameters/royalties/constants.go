@@ -0,0 +1,17 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package royalties
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// ID is the parameter bounding the royalty rate an asset can be minted with, which is only checked on minting so that lowering it leaves
+// the royalties of minted assets payable
+var ID = baseIDs.NewID("maxRoyaltyRate")
+
+var DefaultData = baseData.NewDecData(sdkTypes.NewDecWithPrec(25, 2))
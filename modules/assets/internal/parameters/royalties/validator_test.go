@@ -1,7 +1,7 @@
 // Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
 // SPDX-License-Identifier: Apache-2.0
 
-package dummy
+package royalties
 
 import (
 	"testing"
@@ -26,11 +26,12 @@ func Test_validator(t *testing.T) {
 
 		{"-ve incorrectFormat", args{baseIDs.NewID("")}, errors.IncorrectFormat},
 		{"+ve", args{Parameter}, nil},
-		{"+ve with decData", args{baseData.NewDecData(sdkTypes.NewDec(-1))}, errors.InvalidParameter},
+		{"+ve with zero decData", args{baseData.NewDecData(sdkTypes.ZeroDec())}, nil},
+		{"-ve with -ve decData", args{baseData.NewDecData(sdkTypes.NewDec(-1))}, errors.InvalidParameter},
+		{"-ve with whole split", args{baseData.NewDecData(sdkTypes.OneDec())}, errors.InvalidParameter},
 		{"-ve with different type of Data", args{baseData.NewStringData("stringData")}, errors.IncorrectFormat},
 		{"-ve InvalidParameter", args{baseTypes.NewParameter(baseIDs.NewID(""), baseData.NewStringData(""), validator)}, errors.InvalidParameter},
-		{"-ve with -ve decData", args{baseTypes.NewParameter(baseIDs.NewID("ID"), baseData.NewDecData(sdkTypes.NewDec(-1)), validator)}, errors.InvalidParameter},
-		{"+ve with +ve decData", args{baseTypes.NewParameter(baseIDs.NewID("ID"), baseData.NewDecData(sdkTypes.NewDec(1)), validator)}, nil},
+		{"-ve with different ID", args{baseTypes.NewParameter(baseIDs.NewID("ID"), baseData.NewDecData(sdkTypes.NewDecWithPrec(1, 1)), validator)}, errors.InvalidParameter},
 		{"-ve nil", args{}, errors.IncorrectFormat},
 	}
 	for _, tt := range tests {
@@ -6,5 +6,31 @@ package simulator
 const OpWeightSubmitTextProposal = "op_weight_submit_text_proposal"
 const DefaultWeightTextProposal = 1
 
-const OpWeightMsg = "OpWeightMsg"
-const DefaultWeightMsg = 1
+const OpWeightSubmitParameterChangeProposal = "op_weight_submit_parameter_change_proposal"
+const DefaultWeightParameterChangeProposal = 1
+
+const (
+	OpWeightDefineMsg     = "op_weight_define_msg"
+	OpWeightMintMsg       = "op_weight_mint_msg"
+	OpWeightMutateMsg     = "op_weight_mutate_msg"
+	OpWeightRenumerateMsg = "op_weight_renumerate_msg"
+	OpWeightBurnMsg       = "op_weight_burn_msg"
+	OpWeightDeputizeMsg   = "op_weight_deputize_msg"
+	OpWeightRevokeMsg     = "op_weight_revoke_msg"
+)
+
+const (
+	DefaultWeightDefineMsg     = 20
+	DefaultWeightMintMsg       = 40
+	DefaultWeightMutateMsg     = 10
+	DefaultWeightRenumerateMsg = 20
+	DefaultWeightBurnMsg       = 5
+	DefaultWeightDeputizeMsg   = 5
+	DefaultWeightRevokeMsg     = 5
+)
+
+// maxSimulatedPropertyCount bounds the number of immutable and of mutable properties of simulated classifications
+const maxSimulatedPropertyCount = 3
+
+// maxSimulatedMaxRoyaltyRate bounds the max royalty rate of simulations in hundredths
+const maxSimulatedMaxRoyaltyRate = 50
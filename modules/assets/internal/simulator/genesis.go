@@ -14,34 +14,31 @@ import (
 	"github.com/AssetMantle/modules/modules/assets/internal/mappable"
 	assetsModule "github.com/AssetMantle/modules/modules/assets/internal/module"
 	"github.com/AssetMantle/modules/modules/assets/internal/parameters"
-	"github.com/AssetMantle/modules/modules/assets/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/assets/internal/parameters/royalties"
 	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	parameters2 "github.com/AssetMantle/modules/schema/parameters"
-	baseSimulation "github.com/AssetMantle/modules/simulation/schema/types/base"
 )
 
 func (simulator) RandomizedGenesisState(simulationState *module.SimulationState) {
-	var Data data.Data
+	var royaltiesData data.Data
 
 	simulationState.AppParams.GetOrGenerate(
 		simulationState.Cdc,
-		dummy.ID.String(),
-		&Data,
+		royalties.ID.String(),
+		&royaltiesData,
 		simulationState.Rand,
-		func(rand *rand.Rand) { Data = base.NewDecData(sdkTypes.NewDecWithPrec(int64(rand.Intn(99)), 2)) },
+		func(rand *rand.Rand) {
+			royaltiesData = base.NewDecData(sdkTypes.NewDecWithPrec(int64(rand.Intn(maxSimulatedMaxRoyaltyRate)), 2))
+		},
 	)
 
-	mappableList := make([]helpers.Mappable, simulationState.Rand.Intn(99))
+	// documents referencing other modules are created by the simulated transactions so that genesis satisfies the invariants
+	var mappableList []helpers.Mappable
 
-	for i := range mappableList {
-		immutableProperties := baseSimulation.GenerateRandomProperties(simulationState.Rand)
-		mappableList[i] = mappable.NewAsset(key.NewAssetID(baseSimulation.GenerateRandomID(simulationState.Rand), immutableProperties), immutableProperties, baseSimulation.GenerateRandomProperties(simulationState.Rand))
-	}
-
-	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{dummy.Parameter.Mutate(Data)})
+	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{royalties.Parameter.Mutate(royaltiesData)})
 
 	simulationState.GenState[assetsModule.Name] = common.Codec.MustMarshalJSON(genesisState)
 }
@@ -10,27 +10,337 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/AssetMantle/modules/modules/assets/internal/key"
+	"github.com/AssetMantle/modules/modules/assets/internal/module"
+	"github.com/AssetMantle/modules/modules/assets/internal/transactions/burn"
+	"github.com/AssetMantle/modules/modules/assets/internal/transactions/define"
+	"github.com/AssetMantle/modules/modules/assets/internal/transactions/deputize"
+	"github.com/AssetMantle/modules/modules/assets/internal/transactions/mint"
+	"github.com/AssetMantle/modules/modules/assets/internal/transactions/mutate"
+	"github.com/AssetMantle/modules/modules/assets/internal/transactions/renumerate"
+	"github.com/AssetMantle/modules/modules/assets/internal/transactions/revoke"
+	"github.com/AssetMantle/modules/modules/classifications"
+	"github.com/AssetMantle/modules/modules/identities"
+	"github.com/AssetMantle/modules/modules/maintainers"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/properties"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+	simulationUtilities "github.com/AssetMantle/modules/simulation"
 )
 
-func (simulator) WeightedOperations(appParams simulation.AppParams, codec *codec.Codec) simulation.WeightedOperations {
-	var weightMsg int
+func (simulator simulator) WeightedOperations(appParams simulation.AppParams, codec *codec.Codec) simulation.WeightedOperations {
+	weightedOperations := simulation.WeightedOperations{}
+
+	for _, operation := range []struct {
+		appParamsKey  string
+		defaultWeight int
+		operation     simulation.Operation
+	}{
+		{OpWeightDefineMsg, DefaultWeightDefineMsg, simulator.simulateDefineMsg(codec)},
+		{OpWeightMintMsg, DefaultWeightMintMsg, simulator.simulateMintMsg(codec)},
+		{OpWeightMutateMsg, DefaultWeightMutateMsg, simulator.simulateMutateMsg(codec)},
+		{OpWeightRenumerateMsg, DefaultWeightRenumerateMsg, simulator.simulateRenumerateMsg(codec)},
+		{OpWeightBurnMsg, DefaultWeightBurnMsg, simulator.simulateBurnMsg(codec)},
+		{OpWeightDeputizeMsg, DefaultWeightDeputizeMsg, simulator.simulateDeputizeMsg(codec)},
+		{OpWeightRevokeMsg, DefaultWeightRevokeMsg, simulator.simulateRevokeMsg(codec)},
+	} {
+		var weight int
+
+		appParams.GetOrGenerate(codec, operation.appParamsKey, &weight, nil,
+			func(_ *rand.Rand) {
+				weight = operation.defaultWeight
+			},
+		)
+
+		weightedOperations = append(weightedOperations, simulation.NewWeightedOperation(weight, operation.operation))
+	}
+
+	return weightedOperations
+}
+
+// simulateDefineMsg defines an asset classification with a supply property and schedules a mint of it for the next block
+func (simulator simulator) simulateDefineMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		identityIDList, err := simulationUtilities.GetIdentityIDList(context, baseApp, codec, identities.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		fromID, simulationAccount, found := simulationUtilities.RandomControlledIdentity(rand, context, simulator.authenticateAuxiliary, identityIDList, simulationAccountList)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
 
-	appParams.GetOrGenerate(codec, OpWeightMsg, &weightMsg, nil,
-		func(_ *rand.Rand) {
-			weightMsg = DefaultWeightMsg
-		},
-	)
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, define.NewSimulationMessage(
+			simulationAccount.Address,
+			fromID,
+			baseLists.NewMetaPropertyList(),
+			simulationUtilities.GenerateRandomPropertyDefinitionList(rand, maxSimulatedPropertyCount),
+			baseLists.NewMetaPropertyList(),
+			simulationUtilities.GenerateRandomPropertyDefinitionList(rand, maxSimulatedPropertyCount).Add(constants.Supply),
+		))
+		if err != nil {
+			return operationMsg, nil, err
+		}
 
-	return simulation.WeightedOperations{
-		simulation.NewWeightedOperation(
-			weightMsg,
-			simulateMsg(),
-		),
+		return operationMsg, []simulation.FutureOperation{{BlockHeight: int(context.BlockHeight()) + 1, Op: simulator.simulateMintMsg(codec)}}, nil
 	}
 }
 
-func simulateMsg() simulation.Operation {
+func (simulator simulator) simulateMintMsg(codec *codec.Codec) simulation.Operation {
 	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
-		return simulation.NewOperationMsg(nil, true, ""), nil, nil
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		identityIDList, err := simulationUtilities.GetIdentityIDList(context, baseApp, codec, identities.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		classificationID := baseIDs.NewID(classification.GetID().String())
+		immutableMetaProperties := simulationUtilities.GenerateRandomMetaPropertyList(rand, classification.GetImmutablePropertyList())
+
+		if assetID := key.NewAssetID(classificationID, immutableMetaProperties.ToPropertyList()); simulator.mapper.NewCollection(context).Fetch(key.FromID(assetID)).Get(key.FromID(assetID)) != nil {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, mint.NewSimulationMessage(
+			simulationAccount.Address,
+			baseIDs.NewID(maintainer.GetIdentityID().String()),
+			baseIDs.NewID(identityIDList[rand.Intn(len(identityIDList))].String()),
+			classificationID,
+			immutableMetaProperties,
+			baseLists.NewPropertyList(),
+			simulationUtilities.GenerateRandomMetaPropertyList(rand, classification.GetMutablePropertyList()),
+			baseLists.NewPropertyList(),
+		))
+
+		return operationMsg, nil, err
 	}
 }
+
+func (simulator simulator) simulateMutateMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		asset, found := simulator.randomAssetOfClassification(rand, context, classification)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		var maintainedPropertyList []properties.Property
+
+		for _, property := range classification.GetMutablePropertyList().GetList() {
+			if maintainer.MaintainsProperty(property.GetID()) {
+				maintainedPropertyList = append(maintainedPropertyList, property)
+			}
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, mutate.NewSimulationMessage(
+			simulationAccount.Address,
+			baseIDs.NewID(maintainer.GetIdentityID().String()),
+			baseIDs.NewID(asset.GetID().String()),
+			simulationUtilities.GenerateRandomMetaPropertyList(rand, baseLists.NewPropertyList(maintainedPropertyList...)),
+			baseLists.NewPropertyList(),
+		))
+
+		return operationMsg, nil, err
+	}
+}
+
+// simulateRenumerateMsg sets the split supply of an asset to its supply property, a decrease is only attempted when
+// the maintaining identity holds enough of the asset to cover it
+func (simulator simulator) simulateRenumerateMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found || !maintainer.MaintainsProperty(constants.SupplyProperty) {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		asset, found := simulator.randomAssetOfClassification(rand, context, classification)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		metaProperties, err := supplement.GetMetaPropertiesFromResponse(simulator.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(asset.GetSupply())))
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		supplyMetaProperty := metaProperties.GetMetaProperty(constants.SupplyProperty)
+		if supplyMetaProperty == nil {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		splitList, err := simulationUtilities.GetSplitList(context, baseApp, codec, splits.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		totalValue, fromValue := sdkTypes.ZeroDec(), sdkTypes.ZeroDec()
+
+		for _, split := range splitList {
+			if split.GetOwnableID().String() == asset.GetID().String() {
+				totalValue = totalValue.Add(split.GetValue())
+
+				if split.GetOwnerID().String() == maintainer.GetIdentityID().String() {
+					fromValue = fromValue.Add(split.GetValue())
+				}
+			}
+		}
+
+		if supplyValue := supplyMetaProperty.GetData().(data.DecData).Get(); supplyValue.LT(totalValue) && fromValue.LT(totalValue.Sub(supplyValue)) {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, renumerate.NewSimulationMessage(
+			simulationAccount.Address,
+			baseIDs.NewID(maintainer.GetIdentityID().String()),
+			baseIDs.NewID(asset.GetID().String()),
+		))
+
+		return operationMsg, nil, err
+	}
+}
+
+// simulateBurnMsg burns an asset through an identity holding a split of it
+func (simulator simulator) simulateBurnMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		splitList, err := simulationUtilities.GetSplitList(context, baseApp, codec, splits.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		for _, i := range rand.Perm(len(splitList)) {
+			assetID := splitList[i].GetOwnableID()
+			if simulator.mapper.NewCollection(context).Fetch(key.FromID(assetID)).Get(key.FromID(assetID)) == nil {
+				continue
+			}
+
+			if simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, splitList[i].GetOwnerID(), simulationAccountList); found {
+				operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, burn.NewSimulationMessage(
+					simulationAccount.Address,
+					baseIDs.NewID(splitList[i].GetOwnerID().String()),
+					baseIDs.NewID(assetID.String()),
+				))
+
+				return operationMsg, nil, err
+			}
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+func (simulator simulator) simulateDeputizeMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		identityIDList, err := simulationUtilities.GetIdentityIDList(context, baseApp, codec, identities.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		var maintainedPropertyList []properties.Property
+
+		for _, property := range maintainer.GetMutablePropertyList().GetList() {
+			if simulationUtilities.RandomBool(rand) {
+				maintainedPropertyList = append(maintainedPropertyList, property)
+			}
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, deputize.NewSimulationMessage(
+			simulationAccount.Address,
+			baseIDs.NewID(maintainer.GetIdentityID().String()),
+			baseIDs.NewID(identityIDList[rand.Intn(len(identityIDList))].String()),
+			baseIDs.NewID(classification.GetID().String()),
+			baseLists.NewPropertyList(maintainedPropertyList...),
+			simulationUtilities.RandomBool(rand),
+			simulationUtilities.RandomBool(rand),
+			simulationUtilities.RandomBool(rand),
+		))
+
+		return operationMsg, nil, err
+	}
+}
+
+func (simulator simulator) simulateRevokeMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		maintainerList, err := simulationUtilities.GetMaintainerList(context, baseApp, codec, maintainers.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		for _, i := range rand.Perm(len(maintainerList)) {
+			if maintainerList[i].GetMaintainedClassificationID().String() == classification.GetID().String() && maintainerList[i].GetIdentityID().String() != maintainer.GetIdentityID().String() {
+				operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, revoke.NewSimulationMessage(
+					simulationAccount.Address,
+					baseIDs.NewID(maintainer.GetIdentityID().String()),
+					baseIDs.NewID(maintainerList[i].GetIdentityID().String()),
+					baseIDs.NewID(classification.GetID().String()),
+				))
+
+				return operationMsg, nil, err
+			}
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+func (simulator simulator) randomAssetOfClassification(rand *rand.Rand, context sdkTypes.Context, classification mappables.Classification) (mappables.Asset, bool) {
+	var assetList []mappables.Asset
+
+	simulator.mapper.Iterate(context, key.FromID(baseIDs.NewID("")), func(mappable helpers.Mappable) bool {
+		if asset := mappable.(mappables.Asset); asset.GetClassificationID().String() == classification.GetID().String() {
+			assetList = append(assetList, asset)
+		}
+
+		return false
+	})
+
+	if len(assetList) == 0 {
+		return nil, false
+	}
+
+	return assetList[rand.Intn(len(assetList))], true
+}
+
+// randomControlledMaintainer picks a maintainer of a classification defined through the assets module, identified by its supply property
+func (simulator simulator) randomControlledMaintainer(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, codec *codec.Codec, simulationAccountList []simulation.Account) (mappables.Maintainer, mappables.Classification, simulation.Account, bool, error) {
+	maintainerList, err := simulationUtilities.GetMaintainerList(context, baseApp, codec, maintainers.Prototype().Name())
+	if err != nil {
+		return nil, nil, simulation.Account{}, false, err
+	}
+
+	classificationMap, err := simulationUtilities.GetClassificationMap(context, baseApp, codec, classifications.Prototype().Name())
+	if err != nil {
+		return nil, nil, simulation.Account{}, false, err
+	}
+
+	maintainer, classification, simulationAccount, found := simulationUtilities.RandomControlledMaintainer(rand, context, simulator.authenticateAuxiliary, maintainerList, classificationMap, func(classification mappables.Classification) bool {
+		return classification.GetMutablePropertyList().GetProperty(constants.SupplyProperty) != nil
+	}, simulationAccountList)
+
+	return maintainer, classification, simulationAccount, found, nil
+}
@@ -12,16 +12,16 @@ import (
 
 	"github.com/AssetMantle/modules/modules/assets/internal/common"
 	"github.com/AssetMantle/modules/modules/assets/internal/module"
-	"github.com/AssetMantle/modules/modules/assets/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/assets/internal/parameters/royalties"
 	"github.com/AssetMantle/modules/schema/data/base"
 )
 
 func (simulator) ParamChangeList(_ *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
 		simulation.NewSimParamChange(module.Name,
-			dummy.ID.String(),
+			royalties.ID.String(),
 			func(r *rand.Rand) string {
-				bytes, err := common.Codec.MarshalJSON(dummy.Parameter.Mutate(base.NewDecData(sdk.NewDecWithPrec(int64(r.Intn(99)), 2))).GetData())
+				bytes, err := common.Codec.MarshalJSON(royalties.Parameter.Mutate(base.NewDecData(sdk.NewDecWithPrec(int64(r.Intn(maxSimulatedMaxRoyaltyRate)), 2))).GetData())
 				if err != nil {
 					panic(err)
 				}
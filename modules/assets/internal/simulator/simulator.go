@@ -3,13 +3,41 @@
 
 package simulator
 
-import "github.com/AssetMantle/modules/schema/helpers"
+import (
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
 
 type simulator struct {
+	mapper                helpers.Mapper
+	authenticateAuxiliary helpers.Auxiliary
+	supplementAuxiliary   helpers.Auxiliary
 }
 
 var _ helpers.Simulator = (*simulator)(nil)
 
+func (simulator simulator) Initialize(mapper helpers.Mapper, _ helpers.Parameters, auxiliaries ...interface{}) helpers.Simulator {
+	simulator.mapper = mapper
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case authenticate.Auxiliary.GetName():
+				simulator.authenticateAuxiliary = value
+			case supplement.Auxiliary.GetName():
+				simulator.supplementAuxiliary = value
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return simulator
+}
+
 func newSimulator() helpers.Simulator {
 	return simulator{}
 }
@@ -78,3 +78,8 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, toID ids.ID, classifica
 		MutateMaintainer:     mutateMaintainer,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, toID ids.ID, classificationID ids.ID, maintainedProperties lists.PropertyList, addMaintainer bool, removeMaintainer bool, mutateMaintainer bool) sdkTypes.Msg {
+	return newMessage(from, fromID, toID, classificationID, maintainedProperties, addMaintainer, removeMaintainer, mutateMaintainer)
+}
@@ -7,8 +7,10 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/assets/internal/key"
 	"github.com/AssetMantle/modules/modules/assets/internal/mappable"
+	"github.com/AssetMantle/modules/modules/assets/internal/parameters/royalties"
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/maintainers/auxiliaries/verify"
@@ -50,6 +52,13 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	immutableProperties := base.NewPropertyList(append(immutableMetaProperties.GetList(), message.ImmutableProperties.GetList()...)...)
 
+	// royalty rates are bounded on minting only, which needs them revealed
+	if immutableProperties.GetProperty(constants.RoyaltyRateProperty) != nil {
+		if royaltyRateProperty := message.ImmutableMetaProperties.GetMetaProperty(constants.RoyaltyRateProperty); royaltyRateProperty == nil || royaltyRateProperty.GetData().(data.DecData).Get().IsNegative() || royaltyRateProperty.GetData().(data.DecData).Get().GT(transactionKeeper.parameters.Fetch(context, royalties.ID).Get(royalties.ID).GetData().(data.DecData).Get()) {
+			return newTransactionResponse(errors.InvalidRequest)
+		}
+	}
+
 	assetID := key.NewAssetID(message.ClassificationID, immutableProperties)
 
 	assets := transactionKeeper.mapper.NewCollection(context).Fetch(key.FromID(assetID))
@@ -80,6 +89,16 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	assets.Add(mappable.NewAsset(assetID, immutableProperties, mutableProperties))
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.AssetMinted,
+			sdkTypes.NewAttribute(events.AttributeKeyAssetID, assetID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyClassificationID, message.ClassificationID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyToID, message.ToID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, split.String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
@@ -7,6 +7,7 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/assets/internal/key"
 	"github.com/AssetMantle/modules/modules/assets/internal/mappable"
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
@@ -59,6 +60,13 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	assets.Mutate(mappable.NewAsset(asset.GetID(), asset.GetImmutablePropertyList(), asset.GetMutablePropertyList().Mutate(mutableProperties.GetList()...)))
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.AssetMutated,
+			sdkTypes.NewAttribute(events.AttributeKeyAssetID, asset.GetID().String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
@@ -74,3 +74,8 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, assetID ids.ID, mutable
 		MutableProperties:     mutableProperties,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, assetID ids.ID, mutableMetaProperties lists.MetaPropertyList, mutableProperties lists.PropertyList) sdkTypes.Msg {
+	return newMessage(from, fromID, assetID, mutableMetaProperties, mutableProperties)
+}
@@ -7,6 +7,7 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/assets/internal/key"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/maintainers/auxiliaries/maintain"
@@ -60,6 +61,14 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		return newTransactionResponse(errors.MetaDataError)
 	}
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.AssetRenumerated,
+			sdkTypes.NewAttribute(events.AttributeKeyAssetID, message.AssetID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, message.FromID.String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
@@ -7,7 +7,9 @@ import (
 	"github.com/AssetMantle/modules/modules/assets/auxiliaries"
 	"github.com/AssetMantle/modules/modules/assets/internal/block"
 	"github.com/AssetMantle/modules/modules/assets/internal/genesis"
+	"github.com/AssetMantle/modules/modules/assets/internal/invariants"
 	"github.com/AssetMantle/modules/modules/assets/internal/mapper"
+	"github.com/AssetMantle/modules/modules/assets/internal/migrations"
 	"github.com/AssetMantle/modules/modules/assets/internal/module"
 	"github.com/AssetMantle/modules/modules/assets/internal/parameters"
 	"github.com/AssetMantle/modules/modules/assets/internal/queries"
@@ -28,5 +30,7 @@ func Prototype() helpers.Module {
 		simulator.Prototype,
 		transactions.Prototype,
 		block.Prototype,
+		invariants.Prototype,
+		migrations.Prototype,
 	)
 }
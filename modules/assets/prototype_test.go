@@ -10,7 +10,9 @@ import (
 
 	"github.com/AssetMantle/modules/modules/assets/internal/block"
 	"github.com/AssetMantle/modules/modules/assets/internal/genesis"
+	"github.com/AssetMantle/modules/modules/assets/internal/invariants"
 	"github.com/AssetMantle/modules/modules/assets/internal/mapper"
+	"github.com/AssetMantle/modules/modules/assets/internal/migrations"
 	"github.com/AssetMantle/modules/modules/assets/internal/module"
 	"github.com/AssetMantle/modules/modules/assets/internal/parameters"
 	"github.com/AssetMantle/modules/modules/assets/internal/queries"
@@ -31,6 +33,8 @@ func TestPrototype(t *testing.T) {
 		simulator.Prototype,
 		transactions.Prototype,
 		block.Prototype,
+		invariants.Prototype,
+		migrations.Prototype,
 	).Name())
 
 }
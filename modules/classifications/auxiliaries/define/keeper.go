@@ -6,17 +6,20 @@ package define
 import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/AssetMantle/modules/constants"
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/classifications/internal/key"
 	"github.com/AssetMantle/modules/modules/classifications/internal/mappable"
+	"github.com/AssetMantle/modules/modules/classifications/internal/parameters/properties"
+	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
 	"github.com/AssetMantle/modules/utilities/property"
 )
 
 type auxiliaryKeeper struct {
-	mapper helpers.Mapper
+	mapper     helpers.Mapper
+	parameters helpers.Parameters
 }
 
 var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeper)(nil)
@@ -24,7 +27,7 @@ var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeper)(nil)
 func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request helpers.AuxiliaryRequest) helpers.AuxiliaryResponse {
 	auxiliaryRequest := auxiliaryRequestFromInterface(request)
 
-	if len(auxiliaryRequest.ImmutableProperties.GetList())+len(auxiliaryRequest.MutableProperties.GetList()) > constants.MaxPropertyCount {
+	if len(auxiliaryRequest.ImmutableProperties.GetList())+len(auxiliaryRequest.MutableProperties.GetList()) > int(auxiliaryKeeper.parameters.Fetch(context, properties.ID).Get(properties.ID).GetData().(data.DecData).Get().TruncateInt64()) {
 		return newAuxiliaryResponse(nil, errors.InvalidRequest)
 	}
 
@@ -41,11 +44,18 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 
 	classifications.Add(mappable.NewClassification(classificationID, auxiliaryRequest.ImmutableProperties, auxiliaryRequest.MutableProperties))
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.ClassificationDefined,
+			sdkTypes.NewAttribute(events.AttributeKeyClassificationID, classificationID.String()),
+		),
+	)
+
 	return newAuxiliaryResponse(baseIDs.NewID(classificationID.String()), nil)
 }
 
-func (auxiliaryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
-	return auxiliaryKeeper{mapper: mapper}
+func (auxiliaryKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, _ []interface{}) helpers.Keeper {
+	return auxiliaryKeeper{mapper: mapper, parameters: parameters}
 }
 
 func keeperPrototype() helpers.AuxiliaryKeeper {
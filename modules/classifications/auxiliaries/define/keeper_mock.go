@@ -6,15 +6,17 @@ package define
 import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/AssetMantle/modules/constants"
 	"github.com/AssetMantle/modules/constants/errors"
 	"github.com/AssetMantle/modules/modules/classifications/internal/key"
+	"github.com/AssetMantle/modules/modules/classifications/internal/parameters/properties"
+	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
 )
 
 type auxiliaryKeeperMock struct {
-	mapper helpers.Mapper
+	mapper     helpers.Mapper
+	parameters helpers.Parameters
 }
 
 var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeperMock)(nil)
@@ -22,7 +24,7 @@ var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeperMock)(nil)
 func (auxiliaryKeeper auxiliaryKeeperMock) Help(context sdkTypes.Context, request helpers.AuxiliaryRequest) helpers.AuxiliaryResponse {
 	auxiliaryRequest := auxiliaryRequestFromInterface(request)
 
-	if len(auxiliaryRequest.ImmutableProperties.GetList())+len(auxiliaryRequest.MutableProperties.GetList()) > constants.MaxPropertyCount {
+	if len(auxiliaryRequest.ImmutableProperties.GetList())+len(auxiliaryRequest.MutableProperties.GetList()) > int(auxiliaryKeeper.parameters.Fetch(context, properties.ID).Get(properties.ID).GetData().(data.DecData).Get().TruncateInt64()) {
 		return newAuxiliaryResponse(nil, errors.InvalidRequest)
 	}
 
@@ -31,8 +33,8 @@ func (auxiliaryKeeper auxiliaryKeeperMock) Help(context sdkTypes.Context, reques
 	return newAuxiliaryResponse(baseIDs.NewID(classificationID.String()), nil)
 }
 
-func (auxiliaryKeeperMock) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
-	return auxiliaryKeeperMock{mapper: mapper}
+func (auxiliaryKeeperMock) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, _ []interface{}) helpers.Keeper {
+	return auxiliaryKeeperMock{mapper: mapper, parameters: parameters}
 }
 func keeperPrototypeMock() helpers.AuxiliaryKeeper {
 	return auxiliaryKeeperMock{}
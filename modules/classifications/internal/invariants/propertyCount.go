@@ -0,0 +1,52 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/classifications/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// propertyCountInvariant checks that no classification defines more properties than allowed
+type propertyCountInvariant struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.Invariant = (*propertyCountInvariant)(nil)
+
+func (propertyCountInvariant) GetName() string {
+	return "property-count"
+}
+func (propertyCountInvariant propertyCountInvariant) Check(context sdkTypes.Context) (string, bool) {
+	var message string
+
+	count := 0
+
+	propertyCountInvariant.mapper.NewCollection(context).Iterate(
+		key.FromID(baseIDs.NewID("")),
+		func(mappable helpers.Mappable) bool {
+			classification := mappable.(mappables.Classification)
+
+			if propertyCount := len(classification.GetImmutablePropertyList().GetList()) + len(classification.GetMutablePropertyList().GetList()); propertyCount > constants.MaxPropertyCount {
+				count++
+				message += fmt.Sprintf("\tclassification %s defines %d properties\n", classification.GetID().String(), propertyCount)
+			}
+
+			return false
+		},
+	)
+
+	return fmt.Sprintf("found %d classifications exceeding %d properties\n%s", count, constants.MaxPropertyCount, message), count != 0
+}
+func (propertyCountInvariant propertyCountInvariant) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ ...interface{}) helpers.Invariant {
+	propertyCountInvariant.mapper = mapper
+	return propertyCountInvariant
+}
@@ -0,0 +1,17 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"github.com/AssetMantle/modules/modules/classifications/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+)
+
+func Prototype() helpers.Invariants {
+	return baseHelpers.NewInvariants(
+		module.Name,
+		propertyCountInvariant{},
+	)
+}
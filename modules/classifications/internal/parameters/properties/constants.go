@@ -0,0 +1,18 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// ID is the parameter bounding the number of immutable and mutable properties a classification can be defined with, which is only
+// checked on definition so that lowering it leaves defined classifications valid
+var ID = baseIDs.NewID("maxPropertyCount")
+
+var DefaultData = baseData.NewDecData(sdkTypes.NewDec(constants.MaxPropertyCount))
@@ -0,0 +1,33 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/parameters"
+)
+
+func validator(i interface{}) error {
+	switch value := i.(type) {
+	case parameters.Parameter:
+		if value.GetID().Compare(ID) != 0 {
+			return errors.InvalidParameter
+		}
+
+		return validator(value.GetData())
+	case data.DecData:
+		// the max property count is governed within the bound the property count invariant holds classifications to
+		if !value.Get().IsPositive() || !value.Get().IsInteger() || value.Get().GT(sdkTypes.NewDec(constants.MaxPropertyCount)) {
+			return errors.InvalidParameter
+		}
+
+		return nil
+	default:
+		return errors.IncorrectFormat
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/parameters/base"
+)
+
+func Test_validator(t *testing.T) {
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+
+		{"+ve", args{Parameter}, false},
+		{"+ve data", args{baseData.NewDecData(sdkTypes.NewDec(1))}, false},
+		{"+ve bound", args{baseData.NewDecData(sdkTypes.NewDec(constants.MaxPropertyCount))}, false},
+		{"-ve above bound", args{baseData.NewDecData(sdkTypes.NewDec(constants.MaxPropertyCount + 1))}, true},
+		{"-ve zero", args{baseData.NewDecData(sdkTypes.ZeroDec())}, true},
+		{"-ve fraction", args{baseData.NewDecData(sdkTypes.NewDecWithPrec(15, 1))}, true},
+		{"-ve wrong parameter ID", args{baseTypes.NewParameter(baseIDs.NewID("newID"), baseData.NewDecData(sdkTypes.NewDec(1)), validator)}, true},
+		{"-ve wrong data type", args{baseTypes.NewParameter(ID, baseData.NewStringData("newStringData"), validator)}, true},
+		{"-ve wrong type", args{baseIDs.NewID("")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validator(tt.args.i); (err != nil) != tt.wantErr {
+				t.Errorf("validator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,11 +4,11 @@
 package parameters
 
 import (
-	"github.com/AssetMantle/modules/modules/classifications/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/classifications/internal/parameters/properties"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 )
 
 func Prototype() helpers.Parameters {
-	return baseHelpers.NewParameters(dummy.Parameter)
+	return baseHelpers.NewParameters(properties.Parameter)
 }
@@ -3,8 +3,19 @@
 
 package simulator
 
+import (
+	"github.com/AssetMantle/modules/constants"
+)
+
 const OpWeightSubmitTextProposal = "op_weight_submit_text_proposal"
 const DefaultWeightTextProposal = 1
 
-const OpWeightMsg = "OpWeightMsg"
-const DefaultWeightMsg = 1
+const OpWeightSubmitParameterChangeProposal = "op_weight_submit_parameter_change_proposal"
+const DefaultWeightParameterChangeProposal = 1
+
+// minSimulatedMaxPropertyCount and maxSimulatedMaxPropertyCount bound the max property count of simulations, genesis classifications
+// define up to twenty properties
+const (
+	minSimulatedMaxPropertyCount = 20
+	maxSimulatedMaxPropertyCount = constants.MaxPropertyCount
+)
@@ -14,7 +14,7 @@ import (
 	"github.com/AssetMantle/modules/modules/classifications/internal/mappable"
 	classificationsModule "github.com/AssetMantle/modules/modules/classifications/internal/module"
 	"github.com/AssetMantle/modules/modules/classifications/internal/parameters"
-	"github.com/AssetMantle/modules/modules/classifications/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/classifications/internal/parameters/properties"
 	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
@@ -24,14 +24,16 @@ import (
 )
 
 func (simulator) RandomizedGenesisState(simulationState *module.SimulationState) {
-	var Data data.Data
+	var propertiesData data.Data
 
 	simulationState.AppParams.GetOrGenerate(
 		simulationState.Cdc,
-		dummy.ID.String(),
-		&Data,
+		properties.ID.String(),
+		&propertiesData,
 		simulationState.Rand,
-		func(rand *rand.Rand) { Data = base.NewDecData(sdkTypes.NewDecWithPrec(int64(rand.Intn(99)), 2)) },
+		func(rand *rand.Rand) {
+			propertiesData = base.NewDecData(sdkTypes.NewDec(int64(minSimulatedMaxPropertyCount + rand.Intn(maxSimulatedMaxPropertyCount-minSimulatedMaxPropertyCount+1))))
+		},
 	)
 
 	mappableList := make([]helpers.Mappable, simulationState.Rand.Intn(99))
@@ -42,7 +44,7 @@ func (simulator) RandomizedGenesisState(simulationState *module.SimulationState)
 		mappableList[i] = mappable.NewClassification(key.NewClassificationID(baseSimulation.GenerateRandomID(simulationState.Rand), immutableProperties, mutableProperties), immutableProperties, mutableProperties)
 	}
 
-	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{dummy.Parameter.Mutate(Data)})
+	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{properties.Parameter.Mutate(propertiesData)})
 
 	simulationState.GenState[classificationsModule.Name] = common.Codec.MustMarshalJSON(genesisState)
 }
@@ -14,15 +14,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"github.com/AssetMantle/modules/modules/classifications/internal/module"
-	"github.com/AssetMantle/modules/modules/classifications/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/classifications/internal/parameters/properties"
 )
 
 func (simulator) ParamChangeList(_ *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
 		simulation.NewSimParamChange(module.Name,
-			dummy.ID.String(),
+			properties.ID.String(),
 			func(r *rand.Rand) string {
-				bytes, err := common.Codec.MarshalJSON(dummy.Parameter.Mutate(base.NewDecData(sdk.NewDecWithPrec(int64(r.Intn(99)), 2))).GetData())
+				bytes, err := common.Codec.MarshalJSON(properties.Parameter.Mutate(base.NewDecData(sdk.NewDec(int64(minSimulatedMaxPropertyCount + r.Intn(maxSimulatedMaxPropertyCount-minSimulatedMaxPropertyCount+1))))).GetData())
 				if err != nil {
 					panic(err)
 				}
@@ -9,15 +9,24 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/AssetMantle/modules/modules/classifications/internal/common"
+	"github.com/AssetMantle/modules/modules/classifications/internal/parameters"
+	"github.com/AssetMantle/modules/utilities/governance"
 )
 
-func (simulator) WeightedProposalContentList() []simulation.WeightedProposalContent {
+func (simulator simulator) WeightedProposalContentList() []simulation.WeightedProposalContent {
 	return []simulation.WeightedProposalContent{
 		{
 			AppParamsKey:       OpWeightSubmitTextProposal,
 			DefaultWeight:      DefaultWeightTextProposal,
 			ContentSimulatorFn: simulateTextProposalContent,
 		},
+		{
+			AppParamsKey:       OpWeightSubmitParameterChangeProposal,
+			DefaultWeight:      DefaultWeightParameterChangeProposal,
+			ContentSimulatorFn: governance.SimulateParameterChangeProposalContent(common.Codec, parameters.Prototype(), simulator.ParamChangeList),
+		},
 	}
 }
 
@@ -7,7 +7,9 @@ import (
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries"
 	"github.com/AssetMantle/modules/modules/classifications/internal/block"
 	"github.com/AssetMantle/modules/modules/classifications/internal/genesis"
+	"github.com/AssetMantle/modules/modules/classifications/internal/invariants"
 	"github.com/AssetMantle/modules/modules/classifications/internal/mapper"
+	"github.com/AssetMantle/modules/modules/classifications/internal/migrations"
 	"github.com/AssetMantle/modules/modules/classifications/internal/module"
 	"github.com/AssetMantle/modules/modules/classifications/internal/parameters"
 	"github.com/AssetMantle/modules/modules/classifications/internal/queries"
@@ -28,5 +30,7 @@ func Prototype() helpers.Module {
 		simulator.Prototype,
 		transactions.Prototype,
 		block.Prototype,
+		invariants.Prototype,
+		migrations.Prototype,
 	)
 }
@@ -0,0 +1,16 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package addresses
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// ID is the parameter bounding the number of addresses that can be provisioned to an identity
+var ID = baseIDs.NewID("maxProvisionedAddressCount")
+
+var DefaultData = baseData.NewDecData(sdkTypes.NewDec(16))
@@ -0,0 +1,29 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package addresses
+
+import (
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/parameters"
+)
+
+func validator(i interface{}) error {
+	switch value := i.(type) {
+	case parameters.Parameter:
+		if value.GetID().Compare(ID) != 0 {
+			return errors.InvalidParameter
+		}
+
+		return validator(value.GetData())
+	case data.DecData:
+		if !value.Get().IsPositive() || !value.Get().IsInteger() {
+			return errors.InvalidParameter
+		}
+
+		return nil
+	default:
+		return errors.IncorrectFormat
+	}
+}
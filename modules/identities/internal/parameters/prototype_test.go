@@ -4,7 +4,7 @@
 package parameters
 
 import (
-	"github.com/AssetMantle/modules/modules/identities/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/identities/internal/parameters/addresses"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	"reflect"
 	"testing"
@@ -16,7 +16,7 @@ func TestPrototype(t *testing.T) {
 		want string
 	}{
 
-		{"+ve", baseHelpers.NewParameters(dummy.Parameter).String()},
+		{"+ve", baseHelpers.NewParameters(addresses.Parameter).String()},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -5,6 +5,7 @@ package queries
 
 import (
 	"github.com/AssetMantle/modules/modules/identities/internal/queries/identity"
+	"github.com/AssetMantle/modules/modules/identities/internal/queries/list"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	"reflect"
@@ -19,6 +20,7 @@ func TestPrototype(t *testing.T) {
 		// TODO: Getting same data, but i.e not equal
 		{"+ve", baseHelpers.NewQueries(
 			identity.Query,
+			list.Query,
 		)},
 	}
 	for _, tt := range tests {
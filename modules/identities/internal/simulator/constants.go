@@ -6,5 +6,38 @@ package simulator
 const OpWeightSubmitTextProposal = "op_weight_submit_text_proposal"
 const DefaultWeightTextProposal = 1
 
-const OpWeightMsg = "OpWeightMsg"
-const DefaultWeightMsg = 1
+const OpWeightSubmitParameterChangeProposal = "op_weight_submit_parameter_change_proposal"
+const DefaultWeightParameterChangeProposal = 1
+
+const (
+	OpWeightNubMsg         = "op_weight_nub_msg"
+	OpWeightDefineMsg      = "op_weight_define_msg"
+	OpWeightIssueMsg       = "op_weight_issue_msg"
+	OpWeightProvisionMsg   = "op_weight_provision_msg"
+	OpWeightUnprovisionMsg = "op_weight_unprovision_msg"
+	OpWeightMutateMsg      = "op_weight_mutate_msg"
+	OpWeightQuashMsg       = "op_weight_quash_msg"
+	OpWeightDeputizeMsg    = "op_weight_deputize_msg"
+	OpWeightRevokeMsg      = "op_weight_revoke_msg"
+)
+
+const (
+	DefaultWeightNubMsg         = 40
+	DefaultWeightDefineMsg      = 20
+	DefaultWeightIssueMsg       = 20
+	DefaultWeightProvisionMsg   = 10
+	DefaultWeightUnprovisionMsg = 5
+	DefaultWeightMutateMsg      = 10
+	DefaultWeightQuashMsg       = 2
+	DefaultWeightDeputizeMsg    = 5
+	DefaultWeightRevokeMsg      = 5
+)
+
+// maxSimulatedPropertyCount bounds the number of immutable and of mutable properties of simulated classifications
+const maxSimulatedPropertyCount = 3
+
+// minSimulatedMaxProvisionedAddressCount and maxSimulatedMaxProvisionedAddressCount bound the max provisioned address count of simulations
+const (
+	minSimulatedMaxProvisionedAddressCount = 2
+	maxSimulatedMaxProvisionedAddressCount = 10
+)
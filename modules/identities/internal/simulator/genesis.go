@@ -14,34 +14,31 @@ import (
 	"github.com/AssetMantle/modules/modules/identities/internal/mappable"
 	identitiesModule "github.com/AssetMantle/modules/modules/identities/internal/module"
 	"github.com/AssetMantle/modules/modules/identities/internal/parameters"
-	"github.com/AssetMantle/modules/modules/identities/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/identities/internal/parameters/addresses"
 	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	parameters2 "github.com/AssetMantle/modules/schema/parameters"
-	baseSimulation "github.com/AssetMantle/modules/simulation/schema/types/base"
 )
 
 func (simulator) RandomizedGenesisState(simulationState *module.SimulationState) {
-	var Data data.Data
+	var addressesData data.Data
 
 	simulationState.AppParams.GetOrGenerate(
 		simulationState.Cdc,
-		dummy.ID.String(),
-		&Data,
+		addresses.ID.String(),
+		&addressesData,
 		simulationState.Rand,
-		func(rand *rand.Rand) { Data = base.NewDecData(sdkTypes.NewDecWithPrec(int64(rand.Intn(99)), 2)) },
+		func(rand *rand.Rand) {
+			addressesData = base.NewDecData(sdkTypes.NewDec(int64(minSimulatedMaxProvisionedAddressCount + rand.Intn(maxSimulatedMaxProvisionedAddressCount-minSimulatedMaxProvisionedAddressCount+1))))
+		},
 	)
 
-	mappableList := make([]helpers.Mappable, len(simulationState.Accounts))
+	// documents referencing other modules are created by the simulated transactions so that genesis satisfies the invariants
+	var mappableList []helpers.Mappable
 
-	for i := range mappableList {
-		immutableProperties := baseSimulation.GenerateRandomProperties(simulationState.Rand)
-		mappableList[i] = mappable.NewIdentity(key.NewIdentityID(baseSimulation.GenerateRandomID(simulationState.Rand), immutableProperties), immutableProperties, baseSimulation.GenerateRandomProperties(simulationState.Rand))
-	}
-
-	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{dummy.Parameter.Mutate(Data)})
+	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{addresses.Parameter.Mutate(addressesData)})
 
 	simulationState.GenState[identitiesModule.Name] = common.Codec.MustMarshalJSON(genesisState)
 }
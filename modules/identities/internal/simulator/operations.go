@@ -10,27 +10,329 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/AssetMantle/modules/modules/classifications"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/identities/internal/key"
+	"github.com/AssetMantle/modules/modules/identities/internal/module"
+	"github.com/AssetMantle/modules/modules/identities/internal/parameters/addresses"
+	"github.com/AssetMantle/modules/modules/identities/internal/transactions/define"
+	"github.com/AssetMantle/modules/modules/identities/internal/transactions/deputize"
+	"github.com/AssetMantle/modules/modules/identities/internal/transactions/issue"
+	"github.com/AssetMantle/modules/modules/identities/internal/transactions/mutate"
+	"github.com/AssetMantle/modules/modules/identities/internal/transactions/nub"
+	"github.com/AssetMantle/modules/modules/identities/internal/transactions/provision"
+	"github.com/AssetMantle/modules/modules/identities/internal/transactions/quash"
+	"github.com/AssetMantle/modules/modules/identities/internal/transactions/revoke"
+	"github.com/AssetMantle/modules/modules/identities/internal/transactions/unprovision"
+	"github.com/AssetMantle/modules/modules/maintainers"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/properties"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+	simulationUtilities "github.com/AssetMantle/modules/simulation"
 )
 
-func (simulator) WeightedOperations(appParams simulation.AppParams, codec *codec.Codec) simulation.WeightedOperations {
-	var weightMsg int
+func (simulator simulator) WeightedOperations(appParams simulation.AppParams, codec *codec.Codec) simulation.WeightedOperations {
+	weightedOperations := simulation.WeightedOperations{}
+
+	for _, operation := range []struct {
+		appParamsKey  string
+		defaultWeight int
+		operation     simulation.Operation
+	}{
+		{OpWeightNubMsg, DefaultWeightNubMsg, simulator.simulateNubMsg(codec)},
+		{OpWeightDefineMsg, DefaultWeightDefineMsg, simulator.simulateDefineMsg(codec)},
+		{OpWeightIssueMsg, DefaultWeightIssueMsg, simulator.simulateIssueMsg(codec)},
+		{OpWeightProvisionMsg, DefaultWeightProvisionMsg, simulator.simulateProvisionMsg(codec)},
+		{OpWeightUnprovisionMsg, DefaultWeightUnprovisionMsg, simulator.simulateUnprovisionMsg(codec)},
+		{OpWeightMutateMsg, DefaultWeightMutateMsg, simulator.simulateMutateMsg(codec)},
+		{OpWeightQuashMsg, DefaultWeightQuashMsg, simulator.simulateQuashMsg(codec)},
+		{OpWeightDeputizeMsg, DefaultWeightDeputizeMsg, simulator.simulateDeputizeMsg(codec)},
+		{OpWeightRevokeMsg, DefaultWeightRevokeMsg, simulator.simulateRevokeMsg(codec)},
+	} {
+		var weight int
 
-	appParams.GetOrGenerate(codec, OpWeightMsg, &weightMsg, nil,
-		func(_ *rand.Rand) {
-			weightMsg = DefaultWeightMsg
-		},
-	)
+		appParams.GetOrGenerate(codec, operation.appParamsKey, &weight, nil,
+			func(_ *rand.Rand) {
+				weight = operation.defaultWeight
+			},
+		)
 
-	return simulation.WeightedOperations{
-		simulation.NewWeightedOperation(
-			weightMsg,
-			simulateMsg(),
-		),
+		weightedOperations = append(weightedOperations, simulation.NewWeightedOperation(weight, operation.operation))
 	}
+
+	return weightedOperations
 }
 
-func simulateMsg() simulation.Operation {
+// simulateNubMsg nubs a new identity and schedules a classification definition for the next block, so that identities get issued from it
+func (simulator simulator) simulateNubMsg(codec *codec.Codec) simulation.Operation {
 	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
-		return simulation.NewOperationMsg(nil, true, ""), nil, nil
+		simulationAccount, found := simulationUtilities.RandomAccount(rand, simulationAccountList)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, nub.NewSimulationMessage(simulationAccount.Address, baseIDs.NewID(simulation.RandStringOfLength(rand, 16))))
+		if err != nil {
+			return operationMsg, nil, err
+		}
+
+		return operationMsg, []simulation.FutureOperation{{BlockHeight: int(context.BlockHeight()) + 1, Op: simulator.simulateDefineMsg(codec)}}, nil
+	}
+}
+
+func (simulator simulator) simulateDefineMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		fromID, simulationAccount, found := simulationUtilities.RandomControlledIdentity(rand, context, simulator.authenticateAuxiliary, simulator.getIdentityIDList(context), simulationAccountList)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, define.NewSimulationMessage(
+			simulationAccount.Address,
+			fromID,
+			baseLists.NewMetaPropertyList(),
+			simulationUtilities.GenerateRandomPropertyDefinitionList(rand, maxSimulatedPropertyCount),
+			baseLists.NewMetaPropertyList(),
+			simulationUtilities.GenerateRandomPropertyDefinitionList(rand, maxSimulatedPropertyCount),
+		))
+
+		return operationMsg, nil, err
 	}
 }
+
+func (simulator simulator) simulateIssueMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		toAccount, found := simulationUtilities.RandomAccount(rand, simulationAccountList)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		classificationID := baseIDs.NewID(classification.GetID().String())
+		immutableMetaProperties := simulationUtilities.GenerateRandomMetaPropertyList(rand, classification.GetImmutablePropertyList())
+
+		if identityID := key.NewIdentityID(classificationID, immutableMetaProperties.ToPropertyList()); simulator.mapper.NewCollection(context).Fetch(key.FromID(identityID)).Get(key.FromID(identityID)) != nil {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, issue.NewSimulationMessage(
+			simulationAccount.Address,
+			toAccount.Address,
+			baseIDs.NewID(maintainer.GetIdentityID().String()),
+			classificationID,
+			immutableMetaProperties,
+			baseLists.NewPropertyList(),
+			simulationUtilities.GenerateRandomMetaPropertyList(rand, classification.GetMutablePropertyList(), constants.AuthenticationProperty),
+			baseLists.NewPropertyList(),
+		))
+
+		return operationMsg, nil, err
+	}
+}
+
+func (simulator simulator) simulateProvisionMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		identityID, simulationAccount, found := simulationUtilities.RandomControlledIdentity(rand, context, simulator.authenticateAuxiliary, simulator.getIdentityIDList(context), simulationAccountList)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		toAccount, found := simulationUtilities.RandomAccount(rand, simulationAccountList)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+		if simulator.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(toAccount.Address, identityID)).IsSuccessful() {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		// only simulation accounts are ever provisioned, so counting those provisioned to the identity counts its addresses
+		provisionedAccountCount := 0
+
+		for _, account := range simulationAccountList {
+			if simulator.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(account.Address, identityID)).IsSuccessful() {
+				provisionedAccountCount++
+			}
+		}
+
+		if provisionedAccountCount >= int(simulator.parameters.Fetch(context, addresses.ID).Get(addresses.ID).GetData().(data.DecData).Get().TruncateInt64()) {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, provision.NewSimulationMessage(simulationAccount.Address, toAccount.Address, identityID))
+
+		return operationMsg, nil, err
+	}
+}
+
+// simulateUnprovisionMsg removes another provisioned address of an identity, so that no identity loses its last address
+func (simulator simulator) simulateUnprovisionMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		identityID, simulationAccount, found := simulationUtilities.RandomControlledIdentity(rand, context, simulator.authenticateAuxiliary, simulator.getIdentityIDList(context), simulationAccountList)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		for _, toAccount := range simulationAccountList {
+			if !toAccount.Equals(simulationAccount) && simulator.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(toAccount.Address, identityID)).IsSuccessful() {
+				operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, unprovision.NewSimulationMessage(simulationAccount.Address, toAccount.Address, identityID))
+				return operationMsg, nil, err
+			}
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+func (simulator simulator) simulateMutateMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		var identityIDList []ids.ID
+
+		for _, identityID := range simulator.getIdentityIDList(context) {
+			if key.ReadClassificationID(identityID).String() == classification.GetID().String() {
+				identityIDList = append(identityIDList, identityID)
+			}
+		}
+
+		if len(identityIDList) == 0 {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		var maintainedPropertyList []properties.Property
+
+		for _, property := range classification.GetMutablePropertyList().GetList() {
+			if maintainer.MaintainsProperty(property.GetID()) {
+				maintainedPropertyList = append(maintainedPropertyList, property)
+			}
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, mutate.NewSimulationMessage(
+			simulationAccount.Address,
+			baseIDs.NewID(maintainer.GetIdentityID().String()),
+			baseIDs.NewID(identityIDList[rand.Intn(len(identityIDList))].String()),
+			simulationUtilities.GenerateRandomMetaPropertyList(rand, baseLists.NewPropertyList(maintainedPropertyList...), constants.AuthenticationProperty),
+			baseLists.NewPropertyList(),
+		))
+
+		return operationMsg, nil, err
+	}
+}
+
+// simulateQuashMsg quashes an identity through itself, identities are not created with an expiry so they can always be quashed
+func (simulator simulator) simulateQuashMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		identityID, simulationAccount, found := simulationUtilities.RandomControlledIdentity(rand, context, simulator.authenticateAuxiliary, simulator.getIdentityIDList(context), simulationAccountList)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, quash.NewSimulationMessage(simulationAccount.Address, identityID, identityID))
+
+		return operationMsg, nil, err
+	}
+}
+
+func (simulator simulator) simulateDeputizeMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		identityIDList := simulator.getIdentityIDList(context)
+
+		var maintainedPropertyList []properties.Property
+
+		for _, property := range maintainer.GetMutablePropertyList().GetList() {
+			if simulationUtilities.RandomBool(rand) {
+				maintainedPropertyList = append(maintainedPropertyList, property)
+			}
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, deputize.NewSimulationMessage(
+			simulationAccount.Address,
+			baseIDs.NewID(maintainer.GetIdentityID().String()),
+			baseIDs.NewID(identityIDList[rand.Intn(len(identityIDList))].String()),
+			baseIDs.NewID(classification.GetID().String()),
+			baseLists.NewPropertyList(maintainedPropertyList...),
+			simulationUtilities.RandomBool(rand),
+			simulationUtilities.RandomBool(rand),
+			simulationUtilities.RandomBool(rand),
+		))
+
+		return operationMsg, nil, err
+	}
+}
+
+func (simulator simulator) simulateRevokeMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		maintainerList, err := simulationUtilities.GetMaintainerList(context, baseApp, codec, maintainers.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		for _, i := range rand.Perm(len(maintainerList)) {
+			if maintainerList[i].GetMaintainedClassificationID().String() == classification.GetID().String() && maintainerList[i].GetIdentityID().String() != maintainer.GetIdentityID().String() {
+				operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, revoke.NewSimulationMessage(
+					simulationAccount.Address,
+					baseIDs.NewID(maintainer.GetIdentityID().String()),
+					baseIDs.NewID(maintainerList[i].GetIdentityID().String()),
+					baseIDs.NewID(classification.GetID().String()),
+				))
+
+				return operationMsg, nil, err
+			}
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+func (simulator simulator) getIdentityIDList(context sdkTypes.Context) []ids.ID {
+	var identityIDList []ids.ID
+
+	simulator.mapper.Iterate(context, key.FromID(baseIDs.NewID("")), func(mappable helpers.Mappable) bool {
+		identityIDList = append(identityIDList, mappable.(mappables.Identity).GetID())
+		return false
+	})
+
+	return identityIDList
+}
+
+// randomControlledMaintainer picks a maintainer of a classification defined through the identities module, identified by its authentication property
+func (simulator simulator) randomControlledMaintainer(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, codec *codec.Codec, simulationAccountList []simulation.Account) (mappables.Maintainer, mappables.Classification, simulation.Account, bool, error) {
+	maintainerList, err := simulationUtilities.GetMaintainerList(context, baseApp, codec, maintainers.Prototype().Name())
+	if err != nil {
+		return nil, nil, simulation.Account{}, false, err
+	}
+
+	classificationMap, err := simulationUtilities.GetClassificationMap(context, baseApp, codec, classifications.Prototype().Name())
+	if err != nil {
+		return nil, nil, simulation.Account{}, false, err
+	}
+
+	maintainer, classification, simulationAccount, found := simulationUtilities.RandomControlledMaintainer(rand, context, simulator.authenticateAuxiliary, maintainerList, classificationMap, func(classification mappables.Classification) bool {
+		return classification.GetMutablePropertyList().GetProperty(constants.AuthenticationProperty) != nil && classification.GetImmutablePropertyList().GetProperty(constants.NubIDProperty) == nil
+	}, simulationAccountList)
+
+	return maintainer, classification, simulationAccount, found, nil
+}
@@ -11,16 +11,16 @@ import (
 
 	"github.com/AssetMantle/modules/modules/identities/internal/common"
 	"github.com/AssetMantle/modules/modules/identities/internal/module"
-	"github.com/AssetMantle/modules/modules/identities/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/identities/internal/parameters/addresses"
 	"github.com/AssetMantle/modules/schema/data/base"
 )
 
 func (simulator) ParamChangeList(_ *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
 		simulation.NewSimParamChange(module.Name,
-			dummy.ID.String(),
+			addresses.ID.String(),
 			func(r *rand.Rand) string {
-				bytes, err := common.Codec.MarshalJSON(dummy.Parameter.Mutate(base.NewDecData(sdk.NewDecWithPrec(int64(r.Intn(99)), 2))).GetData())
+				bytes, err := common.Codec.MarshalJSON(addresses.Parameter.Mutate(base.NewDecData(sdk.NewDec(int64(minSimulatedMaxProvisionedAddressCount + r.Intn(maxSimulatedMaxProvisionedAddressCount-minSimulatedMaxProvisionedAddressCount+1))))).GetData())
 				if err != nil {
 					panic(err)
 				}
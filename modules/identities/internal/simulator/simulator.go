@@ -3,12 +3,38 @@
 
 package simulator
 
-import "github.com/AssetMantle/modules/schema/helpers"
+import (
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
 
-type simulator struct{}
+type simulator struct {
+	mapper                helpers.Mapper
+	parameters            helpers.Parameters
+	authenticateAuxiliary helpers.Auxiliary
+}
 
 var _ helpers.Simulator = (*simulator)(nil)
 
+func (simulator simulator) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaries ...interface{}) helpers.Simulator {
+	simulator.mapper, simulator.parameters = mapper, parameters
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case authenticate.Auxiliary.GetName():
+				simulator.authenticateAuxiliary = value
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return simulator
+}
+
 func newSimulator() helpers.Simulator {
 	return simulator{}
 }
@@ -7,6 +7,7 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/identities/internal/key"
@@ -72,6 +73,15 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	identities.Add(mappable.NewIdentity(identityID, immutableProperties, mutableProperties))
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.IdentityIssued,
+			sdkTypes.NewAttribute(events.AttributeKeyIdentityID, identityID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyClassificationID, message.ClassificationID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyAddress, message.To.String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
@@ -86,3 +86,8 @@ func newMessage(from sdkTypes.AccAddress, to sdkTypes.AccAddress, fromID ids.ID,
 		MutableProperties:       mutableProperties,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, to sdkTypes.AccAddress, fromID ids.ID, classificationID ids.ID, immutableMetaProperties lists.MetaPropertyList, immutableProperties lists.PropertyList, mutableMetaProperties lists.MetaPropertyList, mutableProperties lists.PropertyList) sdkTypes.Msg {
+	return newMessage(from, to, fromID, classificationID, immutableMetaProperties, immutableProperties, mutableMetaProperties, mutableProperties)
+}
@@ -7,6 +7,7 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/identities/internal/key"
@@ -59,6 +60,13 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	identities.Mutate(mappable.NewIdentity(identity.GetID(), identity.GetImmutablePropertyList(), identity.GetMutablePropertyList().Mutate(mutableProperties.GetList()...)))
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.IdentityMutated,
+			sdkTypes.NewAttribute(events.AttributeKeyIdentityID, identity.GetID().String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
@@ -7,6 +7,7 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/define"
 	"github.com/AssetMantle/modules/modules/identities/internal/key"
 	"github.com/AssetMantle/modules/modules/identities/internal/mappable"
@@ -57,6 +58,14 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	identities.Add(mappable.NewIdentity(identityID, immutableProperties, mutableProperties))
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.IdentityNubbed,
+			sdkTypes.NewAttribute(events.AttributeKeyIdentityID, identityID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyAddress, message.From.String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
@@ -60,3 +60,8 @@ func newMessage(from sdkTypes.AccAddress, nubID ids.ID) sdkTypes.Msg {
 		NubID: nubID,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, nubID ids.ID) sdkTypes.Msg {
+	return newMessage(from, nubID)
+}
@@ -7,16 +7,20 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/identities/internal/key"
+	"github.com/AssetMantle/modules/modules/identities/internal/parameters/addresses"
 	"github.com/AssetMantle/modules/modules/identities/internal/utilities"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/helpers"
 	"github.com/AssetMantle/modules/schema/mappables"
 )
 
 type transactionKeeper struct {
 	mapper              helpers.Mapper
+	parameters          helpers.Parameters
 	scrubAuxiliary      helpers.Auxiliary
 	supplementAuxiliary helpers.Auxiliary
 }
@@ -46,17 +50,31 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		return newTransactionResponse(errors.EntityAlreadyExists)
 	}
 
+	if count, err := utilities.GetProvisionedAddressCount(context, transactionKeeper.supplementAuxiliary, identity); err != nil {
+		return newTransactionResponse(err)
+	} else if count >= int(transactionKeeper.parameters.Fetch(context, addresses.ID).Get(addresses.ID).GetData().(data.DecData).Get().TruncateInt64()) {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
 	if identity, err := utilities.ProvisionAddress(context, transactionKeeper.supplementAuxiliary, transactionKeeper.scrubAuxiliary, identity, message.To); err != nil {
 		return newTransactionResponse(err)
 	} else {
 		identities.Mutate(identity)
 	}
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.IdentityProvisioned,
+			sdkTypes.NewAttribute(events.AttributeKeyIdentityID, identityID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyAddress, message.To.String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
-func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
-	transactionKeeper.mapper = mapper
+func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	transactionKeeper.mapper, transactionKeeper.parameters = mapper, parameters
 
 	for _, auxiliary := range auxiliaries {
 		switch value := auxiliary.(type) {
@@ -7,6 +7,7 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/identities/internal/key"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
@@ -54,6 +55,13 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	identities.Remove(identity)
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.IdentityQuashed,
+			sdkTypes.NewAttribute(events.AttributeKeyIdentityID, message.IdentityID.String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
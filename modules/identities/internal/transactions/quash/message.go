@@ -62,3 +62,8 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, identityID ids.ID) sdkT
 		IdentityID: identityID,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, identityID ids.ID) sdkTypes.Msg {
+	return newMessage(from, fromID, identityID)
+}
@@ -7,6 +7,7 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/identities/internal/key"
 	"github.com/AssetMantle/modules/modules/identities/internal/utilities"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
@@ -50,6 +51,14 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		identities.Mutate(identity)
 	}
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.IdentityUnprovisioned,
+			sdkTypes.NewAttribute(events.AttributeKeyIdentityID, identityID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyAddress, message.To.String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
@@ -62,3 +62,8 @@ func newMessage(from sdkTypes.AccAddress, to sdkTypes.AccAddress, identityID ids
 		IdentityID: identityID,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, to sdkTypes.AccAddress, identityID ids.ID) sdkTypes.Msg {
+	return newMessage(from, to, identityID)
+}
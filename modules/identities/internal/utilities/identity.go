@@ -26,6 +26,16 @@ func IsProvisioned(context sdkTypes.Context, supplementAuxiliary helpers.Auxilia
 	}
 }
 
+func GetProvisionedAddressCount(context sdkTypes.Context, supplementAuxiliary helpers.Auxiliary, identity mappables.Identity) (int, error) {
+	if metaPropertyList, err := supplement.GetMetaPropertiesFromResponse(supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(identity.GetAuthentication()))); err != nil {
+		return 0, err
+	} else if authenticationProperty := metaPropertyList.GetMetaProperty(constants.AuthenticationProperty); authenticationProperty == nil {
+		return 0, nil
+	} else {
+		return len(authenticationProperty.GetData().(data.ListData).Get()), nil
+	}
+}
+
 func ProvisionAddress(context sdkTypes.Context, supplementAuxiliary helpers.Auxiliary, scrubAuxiliary helpers.Auxiliary, identity mappables.Identity, accAddress sdkTypes.AccAddress) (mappables.Identity, error) {
 
 	if metaPropertyList, err := supplement.GetMetaPropertiesFromResponse(supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(identity.GetAuthentication()))); err != nil {
@@ -7,7 +7,9 @@ import (
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries"
 	"github.com/AssetMantle/modules/modules/identities/internal/block"
 	"github.com/AssetMantle/modules/modules/identities/internal/genesis"
+	"github.com/AssetMantle/modules/modules/identities/internal/invariants"
 	"github.com/AssetMantle/modules/modules/identities/internal/mapper"
+	"github.com/AssetMantle/modules/modules/identities/internal/migrations"
 	"github.com/AssetMantle/modules/modules/identities/internal/module"
 	"github.com/AssetMantle/modules/modules/identities/internal/parameters"
 	"github.com/AssetMantle/modules/modules/identities/internal/queries"
@@ -28,5 +30,7 @@ func Prototype() helpers.Module {
 		simulator.Prototype,
 		transactions.Prototype,
 		block.Prototype,
+		invariants.Prototype,
+		migrations.Prototype,
 	)
 }
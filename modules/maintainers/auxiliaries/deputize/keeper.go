@@ -7,16 +7,22 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/key"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/mappable"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/parameters/deputies"
+	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
 	baseLists "github.com/AssetMantle/modules/schema/lists/base"
 	"github.com/AssetMantle/modules/schema/mappables"
 )
 
 type auxiliaryKeeper struct {
 	mapper          helpers.Mapper
+	parameters      helpers.Parameters
 	memberAuxiliary helpers.Auxiliary
 }
 
@@ -60,6 +66,10 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 			return newAuxiliaryResponse(errors.NotAuthorized)
 		}
 
+		if countMaintainers(maintainers, auxiliaryRequest.ClassificationID) >= int(auxiliaryKeeper.parameters.Fetch(context, deputies.ID).Get(deputies.ID).GetData().(data.DecData).Get().TruncateInt64()) {
+			return newAuxiliaryResponse(errors.InvalidRequest)
+		}
+
 		maintainers.Add(mappable.NewMaintainer(toMaintainerID, baseLists.NewPropertyList(), auxiliaryRequest.MaintainedProperties))
 	} else {
 		if !fromMaintainer.CanMutateMaintainer() {
@@ -69,11 +79,20 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 		maintainers.Mutate(mappable.NewMaintainer(toMaintainerID, baseLists.NewPropertyList(), maintainedProperties))
 	}
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.MaintainerDeputized,
+			sdkTypes.NewAttribute(events.AttributeKeyClassificationID, auxiliaryRequest.ClassificationID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, auxiliaryRequest.FromID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyToID, auxiliaryRequest.ToID.String()),
+		),
+	)
+
 	return newAuxiliaryResponse(nil)
 }
 
-func (auxiliaryKeeper auxiliaryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
-	auxiliaryKeeper.mapper = mapper
+func (auxiliaryKeeper auxiliaryKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	auxiliaryKeeper.mapper, auxiliaryKeeper.parameters = mapper, parameters
 
 	for _, auxiliary := range auxiliaries {
 		switch value := auxiliary.(type) {
@@ -92,6 +111,18 @@ func (auxiliaryKeeper auxiliaryKeeper) Initialize(mapper helpers.Mapper, _ helpe
 	return auxiliaryKeeper
 }
 
+// countMaintainers returns the number of maintainers of the classification, walking its maintainers by their key prefix
+func countMaintainers(maintainers helpers.Collection, classificationID ids.ID) int {
+	count := 0
+
+	maintainers.Iterate(key.FromID(key.NewMaintainerID(classificationID, baseIDs.NewID(""))), func(helpers.Mappable) bool {
+		count++
+		return false
+	})
+
+	return count
+}
+
 func keeperPrototype() helpers.AuxiliaryKeeper {
 	return auxiliaryKeeper{}
 }
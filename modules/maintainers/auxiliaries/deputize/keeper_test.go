@@ -17,10 +17,14 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tendermintDB "github.com/tendermint/tm-db"
 
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/key"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/mappable"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/parameters"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/parameters/deputies"
 	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
@@ -49,7 +53,7 @@ func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
 		paramsStoreKey,
 		paramsTransientStoreKeys,
 	)
-	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test"))
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test").WithKeyTable(parameters.Prototype().GetKeyTable()))
 
 	memDB := tendermintDB.NewMemDB()
 	commitMultiStore := store.NewCommitMultiStore(memDB)
@@ -63,6 +67,8 @@ func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
 		ChainID: "test",
 	}, false, log.NewNopLogger())
 
+	Parameters.Mutate(context, deputies.Parameter.Mutate(baseData.NewDecData(sdkTypes.NewDec(2))))
+
 	keepers := TestKeepers{
 		MaintainersKeeper: keeperPrototype().Initialize(Mapper, Parameters, []interface{}{}).(helpers.AuxiliaryKeeper),
 	}
@@ -90,6 +96,29 @@ func Test_Auxiliary_Keeper_Help(t *testing.T) {
 		})
 	})
 
+	t.Run("NegativeCase-Maintainer Cap Reached", func(t *testing.T) {
+		deputizeKeeper := keeperPrototype().Initialize(keepers.MaintainersKeeper.(auxiliaryKeeper).mapper, keepers.MaintainersKeeper.(auxiliaryKeeper).parameters, []interface{}{member.AuxiliaryMock.Initialize(nil, nil)}).(helpers.AuxiliaryKeeper)
+		context, _ := context.CacheContext()
+
+		want := newAuxiliaryResponse(nil)
+		if got := deputizeKeeper.Help(context, NewAuxiliaryRequest(identityID, toID, classificationID, baseLists.NewPropertyList(), false, false, false)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Help() = %v, want %v", got, want)
+		}
+
+		want = newAuxiliaryResponse(errors.InvalidRequest)
+		if got := deputizeKeeper.Help(context, NewAuxiliaryRequest(identityID, baseIDs.NewID("otherID"), classificationID, baseLists.NewPropertyList(), false, false, false)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Help() = %v, want %v", got, want)
+		}
+
+		// maintainers of other classifications are not counted
+		keepers.MaintainersKeeper.(auxiliaryKeeper).mapper.NewCollection(context).Add(mappable.NewMaintainer(key.NewMaintainerID(baseIDs.NewID("otherClassificationID"), identityID), immutableProperties, mutableProperties))
+
+		want = newAuxiliaryResponse(nil)
+		if got := deputizeKeeper.Help(context, NewAuxiliaryRequest(identityID, baseIDs.NewID("otherID"), baseIDs.NewID("otherClassificationID"), baseLists.NewPropertyList(), false, false, false)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Help() = %v, want %v", got, want)
+		}
+	})
+
 	t.Run("NegativeCase-Maintainer not present", func(t *testing.T) {
 		t.Parallel()
 		want := newAuxiliaryResponse(nil)
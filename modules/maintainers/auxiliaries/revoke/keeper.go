@@ -7,6 +7,7 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/key"
 	"github.com/AssetMantle/modules/schema/helpers"
 	"github.com/AssetMantle/modules/schema/mappables"
@@ -40,6 +41,15 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 
 	maintainers.Remove(toMaintainer)
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.MaintainerRevoked,
+			sdkTypes.NewAttribute(events.AttributeKeyClassificationID, auxiliaryRequest.ClassificationID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, auxiliaryRequest.FromID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyToID, auxiliaryRequest.ToID.String()),
+		),
+	)
+
 	return newAuxiliaryResponse(nil)
 }
 
@@ -7,6 +7,7 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/key"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/mappable"
 	"github.com/AssetMantle/modules/schema/helpers"
@@ -30,6 +31,14 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 
 	maintainers.Add(mappable.NewMaintainer(maintainerID, baseLists.NewPropertyList(), auxiliaryRequest.MutableProperties))
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.MaintainerCreated,
+			sdkTypes.NewAttribute(events.AttributeKeyClassificationID, auxiliaryRequest.ClassificationID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyIdentityID, auxiliaryRequest.IdentityID.String()),
+		),
+	)
+
 	return newAuxiliaryResponse(nil)
 }
 
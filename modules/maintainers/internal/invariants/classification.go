@@ -0,0 +1,64 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// classificationInvariant checks that every maintainer references an existing classification
+type classificationInvariant struct {
+	mapper          helpers.Mapper
+	memberAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.Invariant = (*classificationInvariant)(nil)
+
+func (classificationInvariant) GetName() string {
+	return "classification"
+}
+func (classificationInvariant classificationInvariant) Check(context sdkTypes.Context) (string, bool) {
+	var message string
+
+	count := 0
+
+	classificationInvariant.mapper.NewCollection(context).Iterate(
+		key.FromID(baseIDs.NewID("")),
+		func(mappable helpers.Mappable) bool {
+			maintainer := mappable.(mappables.Maintainer)
+
+			if auxiliaryResponse := classificationInvariant.memberAuxiliary.GetKeeper().Help(context, member.NewAuxiliaryRequest(maintainer.GetMaintainedClassificationID(), nil, nil)); !auxiliaryResponse.IsSuccessful() {
+				count++
+				message += fmt.Sprintf("\tmaintainer %s references classification %s: %s\n", maintainer.GetID().String(), maintainer.GetMaintainedClassificationID().String(), auxiliaryResponse.GetError())
+			}
+
+			return false
+		},
+	)
+
+	return fmt.Sprintf("found %d maintainers without a classification\n%s", count, message), count != 0
+}
+func (classificationInvariant classificationInvariant) Initialize(mapper helpers.Mapper, _ helpers.Parameters, auxiliaries ...interface{}) helpers.Invariant {
+	classificationInvariant.mapper = mapper
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case member.Auxiliary.GetName():
+				classificationInvariant.memberAuxiliary = value
+			}
+		}
+	}
+
+	return classificationInvariant
+}
@@ -0,0 +1,19 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mapper
+
+import (
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// IdentityIDIndex indexes maintainers by the identity they are granted to
+var IdentityIDIndex = baseHelpers.NewIndex("identityID", func(mappable helpers.Mappable) []byte {
+	if maintainer, ok := mappable.(mappables.Maintainer); ok {
+		return maintainer.GetIdentityID().Bytes()
+	}
+
+	return nil
+})
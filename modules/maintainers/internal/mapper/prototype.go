@@ -11,5 +11,5 @@ import (
 )
 
 func Prototype() helpers.Mapper {
-	return baseHelpers.NewMapper(key.Prototype, mappable.Prototype)
+	return baseHelpers.NewMapper(key.Prototype, mappable.Prototype, IdentityIDIndex)
 }
@@ -0,0 +1,18 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/maintainers/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+)
+
+// rebuildVersion2 writes the entries of the maintainers in the identity ID index added at version 2
+func rebuildVersion2(context sdkTypes.Context, mapper helpers.Mapper, _ []interface{}) error {
+	baseHelpers.RebuildIndexes(context, mapper, key.Prototype())
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/maintainers/internal/key"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/mappable"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/mapper"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	baseTestUtilities "github.com/AssetMantle/modules/utilities/test/schema/helpers/base"
+)
+
+func Test_rebuildVersion2(t *testing.T) {
+	context, storeKey, _ := baseTestUtilities.SetupTest(t)
+	identityID := baseIDs.NewID("identityID")
+	maintainer := mappable.NewMaintainer(key.NewMaintainerID(baseIDs.NewID("classificationID"), identityID), baseLists.NewPropertyList(), baseLists.NewPropertyList())
+
+	// a maintainer as written at version 1, without index entries
+	baseHelpers.NewMapper(key.Prototype, mappable.Prototype).Initialize(storeKey).Create(context, maintainer)
+
+	Mapper := mapper.Prototype().Initialize(storeKey)
+
+	getIndexedKeys := func() []helpers.Key {
+		var keyList []helpers.Key
+
+		Mapper.IterateIndex(context, mapper.IdentityIDIndex, identityID.Bytes(), func(mappable helpers.Mappable) bool {
+			keyList = append(keyList, mappable.GetKey())
+			return false
+		})
+
+		return keyList
+	}
+
+	require.Nil(t, getIndexedKeys())
+
+	require.Nil(t, Prototype().Migrate(context, Mapper, nil, 1))
+	require.Equal(t, []helpers.Key{maintainer.GetKey()}, getIndexedKeys())
+}
@@ -0,0 +1,17 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package deputies
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// ID is the parameter bounding the number of maintainers a classification can be deputized, which is only checked when adding a maintainer
+// so that lowering it leaves existing maintainers in place
+var ID = baseIDs.NewID("maxMaintainers")
+
+var DefaultData = baseData.NewDecData(sdkTypes.NewDec(64))
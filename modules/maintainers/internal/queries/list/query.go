@@ -0,0 +1,13 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"github.com/AssetMantle/modules/modules/maintainers/internal/common"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/key"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/module"
+	"github.com/AssetMantle/modules/utilities/pagination"
+)
+
+var Query = pagination.NewQuery(module.Name, common.Codec, key.FromID)
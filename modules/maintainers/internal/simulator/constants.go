@@ -6,5 +6,11 @@ package simulator
 const OpWeightSubmitTextProposal = "op_weight_submit_text_proposal"
 const DefaultWeightTextProposal = 1
 
-const OpWeightMsg = "OpWeightMsg"
-const DefaultWeightMsg = 1
+const OpWeightSubmitParameterChangeProposal = "op_weight_submit_parameter_change_proposal"
+const DefaultWeightParameterChangeProposal = 1
+
+// minSimulatedMaxMaintainers and maxSimulatedMaxMaintainers bound the max number of maintainers per classification of simulations
+const (
+	minSimulatedMaxMaintainers = 8
+	maxSimulatedMaxMaintainers = 64
+)
@@ -14,33 +14,31 @@ import (
 	"github.com/AssetMantle/modules/modules/maintainers/internal/mappable"
 	maintainersModule "github.com/AssetMantle/modules/modules/maintainers/internal/module"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/parameters"
-	"github.com/AssetMantle/modules/modules/maintainers/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/parameters/deputies"
 	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	parameters2 "github.com/AssetMantle/modules/schema/parameters"
-	baseSimulation "github.com/AssetMantle/modules/simulation/schema/types/base"
 )
 
 func (simulator) RandomizedGenesisState(simulationState *module.SimulationState) {
-	var Data data.Data
+	var deputiesData data.Data
 
 	simulationState.AppParams.GetOrGenerate(
 		simulationState.Cdc,
-		dummy.ID.String(),
-		&Data,
+		deputies.ID.String(),
+		&deputiesData,
 		simulationState.Rand,
-		func(rand *rand.Rand) { Data = base.NewDecData(sdkTypes.NewDecWithPrec(int64(rand.Intn(99)), 2)) },
+		func(rand *rand.Rand) {
+			deputiesData = base.NewDecData(sdkTypes.NewDec(int64(minSimulatedMaxMaintainers + rand.Intn(maxSimulatedMaxMaintainers-minSimulatedMaxMaintainers+1))))
+		},
 	)
 
-	mappableList := make([]helpers.Mappable, simulationState.Rand.Intn(99))
+	// documents referencing other modules are created by the simulated transactions so that genesis satisfies the invariants
+	var mappableList []helpers.Mappable
 
-	for i := range mappableList {
-		mappableList[i] = mappable.NewMaintainer(key.NewMaintainerID(baseSimulation.GenerateRandomID(simulationState.Rand), baseSimulation.GenerateRandomID(simulationState.Rand)), baseSimulation.GenerateRandomProperties(simulationState.Rand), baseSimulation.GenerateRandomProperties(simulationState.Rand))
-	}
-
-	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{dummy.Parameter.Mutate(Data)})
+	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{deputies.Parameter.Mutate(deputiesData)})
 
 	simulationState.GenState[maintainersModule.Name] = common.Codec.MustMarshalJSON(genesisState)
 }
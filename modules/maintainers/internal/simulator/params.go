@@ -11,16 +11,16 @@ import (
 
 	"github.com/AssetMantle/modules/modules/maintainers/internal/common"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/module"
-	"github.com/AssetMantle/modules/modules/maintainers/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/parameters/deputies"
 	"github.com/AssetMantle/modules/schema/data/base"
 )
 
 func (simulator) ParamChangeList(_ *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
 		simulation.NewSimParamChange(module.Name,
-			dummy.ID.String(),
+			deputies.ID.String(),
 			func(r *rand.Rand) string {
-				bytes, err := common.Codec.MarshalJSON(dummy.Parameter.Mutate(base.NewDecData(sdkTypes.NewDecWithPrec(int64(r.Intn(99)), 2))).GetData())
+				bytes, err := common.Codec.MarshalJSON(deputies.Parameter.Mutate(base.NewDecData(sdkTypes.NewDec(int64(minSimulatedMaxMaintainers + r.Intn(maxSimulatedMaxMaintainers-minSimulatedMaxMaintainers+1))))).GetData())
 				if err != nil {
 					panic(err)
 				}
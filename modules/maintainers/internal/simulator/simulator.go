@@ -9,6 +9,10 @@ type simulator struct{}
 
 var _ helpers.Simulator = (*simulator)(nil)
 
+func (simulator simulator) Initialize(_ helpers.Mapper, _ helpers.Parameters, _ ...interface{}) helpers.Simulator {
+	return simulator
+}
+
 func newSimulator() helpers.Simulator {
 	return simulator{}
 }
@@ -7,7 +7,9 @@ import (
 	"github.com/AssetMantle/modules/modules/maintainers/auxiliaries"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/block"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/genesis"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/invariants"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/mapper"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/migrations"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/module"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/parameters"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/queries"
@@ -28,5 +30,7 @@ func Prototype() helpers.Module {
 		simulator.Prototype,
 		transactions.Prototype,
 		block.Prototype,
+		invariants.Prototype,
+		migrations.Prototype,
 	)
 }
@@ -11,7 +11,9 @@ import (
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/block"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/genesis"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/invariants"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/mapper"
+	"github.com/AssetMantle/modules/modules/maintainers/internal/migrations"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/module"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/parameters"
 	"github.com/AssetMantle/modules/modules/maintainers/internal/queries"
@@ -31,5 +33,7 @@ func TestPrototype(t *testing.T) {
 		simulator.Prototype,
 		transactions.Prototype,
 		block.Prototype,
+		invariants.Prototype,
+		migrations.Prototype,
 	).Name())
 }
@@ -6,7 +6,12 @@ package scrub
 import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/metas/internal/key"
 	"github.com/AssetMantle/modules/modules/metas/internal/mappable"
+	"github.com/AssetMantle/modules/modules/metas/internal/parameters/size"
+	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
 	baseLists "github.com/AssetMantle/modules/schema/lists/base"
@@ -14,7 +19,8 @@ import (
 )
 
 type auxiliaryKeeper struct {
-	mapper helpers.Mapper
+	mapper     helpers.Mapper
+	parameters helpers.Parameters
 }
 
 var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeper)(nil)
@@ -24,10 +30,22 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 
 	scrubbedPropertyList := make([]properties.Property, len(auxiliaryRequest.MetaPropertyList))
 	metas := auxiliaryKeeper.mapper.NewCollection(context)
+	maxDataSize := int(auxiliaryKeeper.parameters.Fetch(context, size.ID).Get(size.ID).GetData().(data.DecData).Get().TruncateInt64())
 
 	for i, metaProperty := range auxiliaryRequest.MetaPropertyList {
 		if metaProperty.GetHash().Compare(baseIDs.NewID("")) != 0 {
+			if len(metaProperty.GetData().String()) > maxDataSize {
+				return newAuxiliaryResponse(nil, errors.InvalidRequest)
+			}
+
 			metas.Add(mappable.NewMeta(metaProperty.GetData()))
+
+			context.EventManager().EmitEvent(
+				sdkTypes.NewEvent(
+					events.MetaRevealed,
+					sdkTypes.NewAttribute(events.AttributeKeyMetaID, key.GenerateMetaID(metaProperty.GetData()).String()),
+				),
+			)
 		}
 
 		scrubbedPropertyList[i] = metaProperty.RemoveData()
@@ -36,8 +54,8 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 	return newAuxiliaryResponse(baseLists.NewPropertyList(scrubbedPropertyList...), nil)
 }
 
-func (auxiliaryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
-	return auxiliaryKeeper{mapper: mapper}
+func (auxiliaryKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, _ []interface{}) helpers.Keeper {
+	return auxiliaryKeeper{mapper: mapper, parameters: parameters}
 }
 
 func keeperPrototype() helpers.AuxiliaryKeeper {
@@ -0,0 +1,51 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/metas/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// metaIDInvariant checks that every meta is stored under the ID generated from its data
+type metaIDInvariant struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.Invariant = (*metaIDInvariant)(nil)
+
+func (metaIDInvariant) GetName() string {
+	return "meta-id"
+}
+func (metaIDInvariant metaIDInvariant) Check(context sdkTypes.Context) (string, bool) {
+	var message string
+
+	count := 0
+
+	metaIDInvariant.mapper.NewCollection(context).Iterate(
+		key.FromID(baseIDs.NewID("")),
+		func(mappable helpers.Mappable) bool {
+			meta := mappable.(mappables.Meta)
+
+			if metaID := key.GenerateMetaID(meta.GetData()); !key.FromID(metaID).Equals(meta.GetKey()) {
+				count++
+				message += fmt.Sprintf("\tmeta %s is stored for data with ID %s\n", meta.GetKey(), metaID.String())
+			}
+
+			return false
+		},
+	)
+
+	return fmt.Sprintf("found %d metas not matching their data hash\n%s", count, message), count != 0
+}
+func (metaIDInvariant metaIDInvariant) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ ...interface{}) helpers.Invariant {
+	metaIDInvariant.mapper = mapper
+	return metaIDInvariant
+}
@@ -0,0 +1,56 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/modules/metas/internal/mappable"
+	"github.com/AssetMantle/modules/modules/metas/internal/mapper"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_MetaID_Invariant(t *testing.T) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	require.Nil(t, commitMultiStore.LoadLatestVersion())
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	Mapper.NewCollection(context).Add(mappable.NewMeta(baseData.NewStringData("data")))
+	Mapper.NewCollection(context).Add(mappable.NewMeta(baseData.NewIDData(baseIDs.NewID("id"))))
+
+	invariant := metaIDInvariant{}.Initialize(Mapper, nil)
+
+	require.Equal(t, "meta-id", invariant.GetName())
+
+	message, broken := invariant.Check(context)
+	require.False(t, broken)
+	require.Contains(t, message, "found 0 metas")
+}
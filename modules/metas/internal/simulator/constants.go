@@ -6,5 +6,15 @@ package simulator
 const OpWeightSubmitTextProposal = "op_weight_submit_text_proposal"
 const DefaultWeightTextProposal = 1
 
-const OpWeightMsg = "OpWeightMsg"
-const DefaultWeightMsg = 1
+const OpWeightSubmitParameterChangeProposal = "op_weight_submit_parameter_change_proposal"
+const DefaultWeightParameterChangeProposal = 1
+
+const OpWeightRevealMsg = "op_weight_reveal_msg"
+const DefaultWeightRevealMsg = 20
+
+// minSimulatedMaxDataSize and maxSimulatedMaxDataSize bound the max data size of simulations, which has to leave room for the
+// authentication lists of identities
+const (
+	minSimulatedMaxDataSize = 1024
+	maxSimulatedMaxDataSize = 4096
+)
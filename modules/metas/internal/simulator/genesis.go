@@ -14,7 +14,7 @@ import (
 	"github.com/AssetMantle/modules/modules/metas/internal/mappable"
 	metasModule "github.com/AssetMantle/modules/modules/metas/internal/module"
 	"github.com/AssetMantle/modules/modules/metas/internal/parameters"
-	"github.com/AssetMantle/modules/modules/metas/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/metas/internal/parameters/size"
 	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
@@ -24,14 +24,16 @@ import (
 )
 
 func (simulator) RandomizedGenesisState(simulationState *module.SimulationState) {
-	var Data data.Data
+	var sizeData data.Data
 
 	simulationState.AppParams.GetOrGenerate(
 		simulationState.Cdc,
-		dummy.ID.String(),
-		&Data,
+		size.ID.String(),
+		&sizeData,
 		simulationState.Rand,
-		func(rand *rand.Rand) { Data = base.NewDecData(sdkTypes.NewDecWithPrec(int64(rand.Intn(99)), 2)) },
+		func(rand *rand.Rand) {
+			sizeData = base.NewDecData(sdkTypes.NewDec(int64(minSimulatedMaxDataSize + rand.Intn(maxSimulatedMaxDataSize-minSimulatedMaxDataSize+1))))
+		},
 	)
 
 	mappableList := make([]helpers.Mappable, simulationState.Rand.Intn(99))
@@ -40,7 +42,7 @@ func (simulator) RandomizedGenesisState(simulationState *module.SimulationState)
 		mappableList[i] = mappable.NewMeta(baseSimulation.GenerateRandomData(simulationState.Rand))
 	}
 
-	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{dummy.Parameter.Mutate(Data)})
+	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{size.Parameter.Mutate(sizeData)})
 
 	simulationState.GenState[metasModule.Name] = common.Codec.MustMarshalJSON(genesisState)
 }
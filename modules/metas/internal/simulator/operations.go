@@ -10,27 +10,51 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/AssetMantle/modules/modules/metas/internal/key"
+	"github.com/AssetMantle/modules/modules/metas/internal/module"
+	"github.com/AssetMantle/modules/modules/metas/internal/transactions/reveal"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	simulationUtilities "github.com/AssetMantle/modules/simulation"
+	baseSimulation "github.com/AssetMantle/modules/simulation/schema/types/base"
 )
 
-func (simulator) WeightedOperations(appParams simulation.AppParams, codec *codec.Codec) simulation.WeightedOperations {
-	var weightMsg int
+func (simulator simulator) WeightedOperations(appParams simulation.AppParams, codec *codec.Codec) simulation.WeightedOperations {
+	var weightRevealMsg int
 
-	appParams.GetOrGenerate(codec, OpWeightMsg, &weightMsg, nil,
+	appParams.GetOrGenerate(codec, OpWeightRevealMsg, &weightRevealMsg, nil,
 		func(_ *rand.Rand) {
-			weightMsg = DefaultWeightMsg
+			weightRevealMsg = DefaultWeightRevealMsg
 		},
 	)
 
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
-			weightMsg,
-			simulateMsg(),
+			weightRevealMsg,
+			simulator.simulateRevealMsg(codec),
 		),
 	}
 }
 
-func simulateMsg() simulation.Operation {
+func (simulator simulator) simulateRevealMsg(codec *codec.Codec) simulation.Operation {
 	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
-		return simulation.NewOperationMsg(nil, true, ""), nil, nil
+		simulationAccount, found := simulationUtilities.RandomAccount(rand, simulationAccountList)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		data := baseSimulation.GenerateRandomData(rand)
+		if data.GenerateHash().Compare(baseIDs.NewID("")) == 0 {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		metaID := key.GenerateMetaID(data)
+		if simulator.mapper.NewCollection(context).Fetch(key.FromID(metaID)).Get(key.FromID(metaID)) != nil {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, reveal.NewSimulationMessage(simulationAccount.Address, data))
+
+		return operationMsg, nil, err
 	}
 }
@@ -11,16 +11,16 @@ import (
 
 	"github.com/AssetMantle/modules/modules/metas/internal/common"
 	"github.com/AssetMantle/modules/modules/metas/internal/module"
-	"github.com/AssetMantle/modules/modules/metas/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/metas/internal/parameters/size"
 	"github.com/AssetMantle/modules/schema/data/base"
 )
 
 func (simulator) ParamChangeList(_ *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
 		simulation.NewSimParamChange(module.Name,
-			dummy.ID.String(),
+			size.ID.String(),
 			func(r *rand.Rand) string {
-				bytes, err := common.Codec.MarshalJSON(dummy.Parameter.Mutate(base.NewDecData(sdk.NewDecWithPrec(int64(r.Intn(99)), 2))).GetData())
+				bytes, err := common.Codec.MarshalJSON(size.Parameter.Mutate(base.NewDecData(sdk.NewDec(int64(minSimulatedMaxDataSize + r.Intn(maxSimulatedMaxDataSize-minSimulatedMaxDataSize+1))))).GetData())
 				if err != nil {
 					panic(err)
 				}
@@ -5,10 +5,17 @@ package simulator
 
 import "github.com/AssetMantle/modules/schema/helpers"
 
-type simulator struct{}
+type simulator struct {
+	mapper helpers.Mapper
+}
 
 var _ helpers.Simulator = (*simulator)(nil)
 
+func (simulator simulator) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ ...interface{}) helpers.Simulator {
+	simulator.mapper = mapper
+	return simulator
+}
+
 func newSimulator() helpers.Simulator {
 	return simulator{}
 }
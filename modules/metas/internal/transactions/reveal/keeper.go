@@ -7,8 +7,11 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/metas/internal/key"
 	"github.com/AssetMantle/modules/modules/metas/internal/mappable"
+	"github.com/AssetMantle/modules/modules/metas/internal/parameters/size"
+	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
 )
@@ -22,6 +25,10 @@ var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
 
 func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
 	message := messageFromInterface(msg)
+	if len(message.Data.String()) > int(transactionKeeper.parameters.Fetch(context, size.ID).Get(size.ID).GetData().(data.DecData).Get().TruncateInt64()) {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
 	metaID := key.GenerateMetaID(message.Data)
 	metas := transactionKeeper.mapper.NewCollection(context).Fetch(key.FromID(metaID))
 
@@ -32,6 +39,13 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	if message.Data.GenerateHash().Compare(baseIDs.NewID("")) != 0 {
 		metas.Add(mappable.NewMeta(message.Data))
+
+		context.EventManager().EmitEvent(
+			sdkTypes.NewEvent(
+				events.MetaRevealed,
+				sdkTypes.NewAttribute(events.AttributeKeyMetaID, metaID.String()),
+			),
+		)
 	}
 
 	return newTransactionResponse(nil)
@@ -5,6 +5,7 @@ package reveal
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -21,7 +22,10 @@ import (
 	"github.com/AssetMantle/modules/modules/metas/internal/key"
 	"github.com/AssetMantle/modules/modules/metas/internal/mappable"
 	"github.com/AssetMantle/modules/modules/metas/internal/parameters"
+	"github.com/AssetMantle/modules/modules/metas/internal/parameters/size"
 	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/data"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/data/utilities"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
@@ -49,7 +53,7 @@ func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
 		paramsStoreKey,
 		paramsTransientStoreKeys,
 	)
-	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test"))
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test").WithKeyTable(parameters.Prototype().GetKeyTable()))
 
 	memDB := tendermintDB.NewMemDB()
 	commitMultiStore := store.NewCommitMultiStore(memDB)
@@ -62,6 +66,7 @@ func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
 	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
 		ChainID: "test",
 	}, false, log.NewNopLogger())
+	Parameters.Mutate(context, size.Parameter)
 
 	keepers := TestKeepers{
 		MetasKeeper: keeperPrototype().Initialize(Mapper, Parameters, []interface{}{}).(helpers.TransactionKeeper),
@@ -93,4 +98,12 @@ func Test_transactionKeeper_Transact(t *testing.T) {
 		}
 	})
 
+	t.Run("NegativeCase-Data larger than the max data size", func(t *testing.T) {
+		t.Parallel()
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.MetasKeeper.Transact(context, newMessage(defaultAddr, baseData.NewStringData(strings.Repeat("a", int(size.DefaultData.(data.DecData).Get().TruncateInt64())+1)))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
 }
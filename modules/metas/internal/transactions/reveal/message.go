@@ -61,3 +61,8 @@ func newMessage(from sdkTypes.AccAddress, data data.Data) sdkTypes.Msg {
 		Data: data,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, data data.Data) sdkTypes.Msg {
+	return newMessage(from, data)
+}
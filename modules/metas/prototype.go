@@ -7,7 +7,9 @@ import (
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries"
 	"github.com/AssetMantle/modules/modules/metas/internal/block"
 	"github.com/AssetMantle/modules/modules/metas/internal/genesis"
+	"github.com/AssetMantle/modules/modules/metas/internal/invariants"
 	"github.com/AssetMantle/modules/modules/metas/internal/mapper"
+	"github.com/AssetMantle/modules/modules/metas/internal/migrations"
 	"github.com/AssetMantle/modules/modules/metas/internal/module"
 	"github.com/AssetMantle/modules/modules/metas/internal/parameters"
 	"github.com/AssetMantle/modules/modules/metas/internal/queries"
@@ -28,5 +30,7 @@ func Prototype() helpers.Module {
 		simulator.Prototype,
 		transactions.Prototype,
 		block.Prototype,
+		invariants.Prototype,
+		migrations.Prototype,
 	)
 }
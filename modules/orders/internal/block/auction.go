@@ -0,0 +1,324 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package block
+
+import (
+	"sort"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/settle"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// auctionOrder is an order taking part in a batch auction along with the maker ownable split left in it
+type auctionOrder struct {
+	order             mappables.Order
+	makerOwnableSplit sdkTypes.Dec
+}
+
+func (auctionOrder auctionOrder) getExchangeRate() sdkTypes.Dec {
+	return auctionOrder.order.GetExchangeRate().GetData().(data.DecData).Get()
+}
+
+// getAuctionInterval returns the number of blocks between the batch auctions clearing the book, which is zero for books matched continuously
+func (block block) getAuctionInterval(context sdkTypes.Context, Book book) (int64, error) {
+	var order mappables.Order
+
+	block.mapper.IterateIndex(context, mapper.BookIndex, Book.getIndexKeyBytes(), func(mappable helpers.Mappable) bool {
+		order = mappable.(mappables.Order)
+		return true
+	})
+
+	if order == nil {
+		return 0, nil
+	}
+
+	return utilities.GetAuctionInterval(context, block.parameters, block.memberAuxiliary, block.supplementAuxiliary, order)
+}
+
+// clearAuction fills the orders of the book and of its opposite book that cross at the exchange rate maximizing the maker ownable split
+// exchanged, only as many orders are taken from the two books by priority as there are matches left so that the auction fills no more orders
+// than the match limit allows, orders left out wait for the next auction, a failing auction is logged and left to the next one, it returns
+// the number of matches left to attempt in the block after counting each filled order as a match
+func (block block) clearAuction(context sdkTypes.Context, Book book, remainingMatches int64) int64 {
+	if remainingMatches < 2 {
+		return remainingMatches
+	}
+
+	orderList, oppositeOrderList := block.getAuctionOrderList(context, Book, remainingMatches-1), block.getAuctionOrderList(context, getOppositeBook(Book), remainingMatches-1)
+
+	// the orders last in priority of the longer list are left out until both lists together fit in the matches left
+	for int64(len(orderList)+len(oppositeOrderList)) > remainingMatches {
+		if len(orderList) > len(oppositeOrderList) {
+			orderList = orderList[:len(orderList)-1]
+		} else {
+			oppositeOrderList = oppositeOrderList[:len(oppositeOrderList)-1]
+		}
+	}
+
+	exchangeRate, makerOwnableSplit, found := getClearingRate(orderList, oppositeOrderList)
+	if !found {
+		return remainingMatches
+	}
+
+	var filledOrders int64
+
+	if err := applyCached(context, func(cacheContext sdkTypes.Context) error {
+		var err error
+		filledOrders, err = block.settleAuction(cacheContext, Book, exchangeRate, makerOwnableSplit, orderList, oppositeOrderList)
+
+		return err
+	}); err != nil {
+		context.Logger().Error("failed to clear auction", "module", module.Name, "book", Book.String(), "error", err.Error())
+		return remainingMatches
+	}
+
+	return remainingMatches - filledOrders
+}
+
+// getAuctionOrderList returns up to the limit of the orders of the book by priority, lowest exchange rate first and then earliest created,
+// orders whose maker ownable split cannot be read are logged and left out of the auction
+func (block block) getAuctionOrderList(context sdkTypes.Context, Book book, limit int64) []auctionOrder {
+	var orderList []auctionOrder
+
+	block.mapper.IterateIndex(context, mapper.BookIndex, Book.getIndexKeyBytes(), func(mappable helpers.Mappable) bool {
+		order := mappable.(mappables.Order)
+
+		makerOwnableSplit, err := utilities.GetMakerOwnableSplit(context, block.supplementAuxiliary, order)
+		if err != nil {
+			context.Logger().Error("failed to read order maker ownable split", "module", module.Name, "order", order.GetID().String(), "error", err.Error())
+			return false
+		}
+
+		orderList = append(orderList, auctionOrder{order: order, makerOwnableSplit: makerOwnableSplit})
+
+		return int64(len(orderList)) >= limit
+	})
+
+	sort.SliceStable(orderList, func(i, j int) bool {
+		if comparison := orderList[i].getExchangeRate().Sub(orderList[j].getExchangeRate()); !comparison.IsZero() {
+			return comparison.IsNegative()
+		}
+
+		return isPrior(orderList[i].order, orderList[j].order)
+	})
+
+	return orderList
+}
+
+// settleAuction fills the orders of the book selling the maker ownable split at the exchange rate and the orders of the opposite book buying
+// it, remainders left by truncation go to the orders first in priority so that each ownable is paid out exactly as much as it is sold, it
+// returns the number of orders filled
+func (block block) settleAuction(context sdkTypes.Context, Book book, exchangeRate sdkTypes.Dec, makerOwnableSplit sdkTypes.Dec, orderList []auctionOrder, oppositeOrderList []auctionOrder) (int64, error) {
+	soldList := allocate(orderList, makerOwnableSplit)
+	receivedList := make([]sdkTypes.Dec, len(soldList))
+	takerOwnableSplit := sdkTypes.ZeroDec()
+
+	for i, sold := range soldList {
+		receivedList[i] = sold.MulTruncate(exchangeRate).MulTruncate(sdkTypes.SmallestDec())
+		takerOwnableSplit = takerOwnableSplit.Add(receivedList[i])
+	}
+
+	if !takerOwnableSplit.IsPositive() {
+		return 0, nil
+	}
+
+	oppositeSoldList := allocate(oppositeOrderList, takerOwnableSplit)
+	oppositeReceivedList := make([]sdkTypes.Dec, len(oppositeSoldList))
+	remainder := makerOwnableSplit
+
+	for i, sold := range oppositeSoldList {
+		oppositeReceivedList[i] = sold.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(exchangeRate)
+		remainder = remainder.Sub(oppositeReceivedList[i])
+	}
+
+	for i, sold := range oppositeSoldList {
+		if sold.IsPositive() {
+			oppositeReceivedList[i] = oppositeReceivedList[i].Add(remainder)
+			break
+		}
+	}
+
+	// what is sold is settled out of the escrows before anything is paid out of the pool
+	if err := block.collectAuctionSales(context, orderList, soldList); err != nil {
+		return 0, err
+	}
+
+	if err := block.collectAuctionSales(context, oppositeOrderList, oppositeSoldList); err != nil {
+		return 0, err
+	}
+
+	filledOrders, err := block.fillAuctionOrders(context, orderList, soldList, receivedList)
+	if err != nil {
+		return 0, err
+	}
+
+	oppositeFilledOrders, err := block.fillAuctionOrders(context, oppositeOrderList, oppositeSoldList, oppositeReceivedList)
+	if err != nil {
+		return 0, err
+	}
+
+	utilities.EmitAuctionClearedEvent(context, Book.classificationID, Book.makerOwnableID, Book.takerOwnableID, exchangeRate, makerOwnableSplit, takerOwnableSplit)
+
+	return filledOrders + oppositeFilledOrders, nil
+}
+
+// collectAuctionSales settles the maker ownable split each order sold in an auction out of its escrow into the pool of the module
+func (block block) collectAuctionSales(context sdkTypes.Context, orderList []auctionOrder, soldList []sdkTypes.Dec) error {
+	for i, auctionOrder := range orderList {
+		if !soldList[i].IsPositive() {
+			continue
+		}
+
+		if auxiliaryResponse := block.settleAuxiliary.GetKeeper().Help(context, settle.NewAuxiliaryRequest(baseIDs.NewID(module.Name), auctionOrder.order.GetID(), baseIDs.NewID(module.Name), soldList[i])); !auxiliaryResponse.IsSuccessful() {
+			return auxiliaryResponse.GetError()
+		}
+	}
+
+	return nil
+}
+
+// fillAuctionOrders fills the orders that sold or received anything in an auction, it returns the number of orders filled
+func (block block) fillAuctionOrders(context sdkTypes.Context, orderList []auctionOrder, soldList []sdkTypes.Dec, receivedList []sdkTypes.Dec) (int64, error) {
+	var filledOrders int64
+
+	for i, auctionOrder := range orderList {
+		if !soldList[i].IsPositive() && !receivedList[i].IsPositive() {
+			continue
+		}
+
+		if err := block.fillAuctionOrder(context, auctionOrder, soldList[i], receivedList[i]); err != nil {
+			return 0, err
+		}
+
+		filledOrders++
+	}
+
+	return filledOrders, nil
+}
+
+// fillAuctionOrder pays the order the taker ownable split received for the maker ownable split sold in an auction, charging its maker fee
+func (block block) fillAuctionOrder(context sdkTypes.Context, auctionOrder auctionOrder, sold sdkTypes.Dec, received sdkTypes.Dec) error {
+	order := auctionOrder.order
+
+	fee, royalty := sdkTypes.ZeroDec(), sdkTypes.ZeroDec()
+
+	if received.IsPositive() {
+		makerFeeRate, _, err := utilities.GetFeeRates(context, block.parameters, block.memberAuxiliary, block.supplementAuxiliary, order)
+		if err != nil {
+			return err
+		}
+
+		if fee, royalty, err = utilities.TransferProceeds(context, block.parameters, block.transferAuxiliary, block.royaltyAuxiliary, baseIDs.NewID(module.Name), order.GetMakerID(), order.GetTakerOwnableID(), order.GetMakerOwnableID(), received, makerFeeRate); err != nil {
+			return err
+		}
+	}
+
+	if err := block.getMatcher().SetMakerOwnableSplit(context, order, auctionOrder.makerOwnableSplit.Sub(sold)); err != nil {
+		return err
+	}
+
+	utilities.EmitOrderExecutedEvent(context, order, baseIDs.NewID(module.Name), sold, received, fee, royalty)
+	utilities.RecordTrade(context, block.mapper, order, baseIDs.NewID(module.Name), sold, received)
+
+	return nil
+}
+
+// getClearingRate returns the exchange rate, in terms of the orders of the list, at which the orders of the list and of the opposite list
+// exchange the largest maker ownable split of the list along with that split, ties going to the exchange rate leaving the smallest imbalance
+// between what is offered and what is demanded and then to the lowest exchange rate, both lists being sorted by priority
+func getClearingRate(orderList []auctionOrder, oppositeOrderList []auctionOrder) (sdkTypes.Dec, sdkTypes.Dec, bool) {
+	var candidateList []sdkTypes.Dec
+
+	for _, auctionOrder := range orderList {
+		candidateList = append(candidateList, auctionOrder.getExchangeRate())
+	}
+
+	oppositeMakerOwnableSplit := sdkTypes.ZeroDec()
+
+	for _, auctionOrder := range oppositeOrderList {
+		if oppositeExchangeRate := auctionOrder.getExchangeRate(); oppositeExchangeRate.IsPositive() {
+			candidateList = append(candidateList, sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(oppositeExchangeRate))
+		}
+
+		oppositeMakerOwnableSplit = oppositeMakerOwnableSplit.Add(auctionOrder.makerOwnableSplit)
+	}
+
+	sort.Slice(candidateList, func(i, j int) bool { return candidateList[i].LT(candidateList[j]) })
+
+	clearingRate, clearedSplit, clearingImbalance := sdkTypes.Dec{}, sdkTypes.ZeroDec(), sdkTypes.Dec{}
+	offered, i, j := sdkTypes.ZeroDec(), 0, len(oppositeOrderList)
+
+	for k, candidate := range candidateList {
+		if !candidate.IsPositive() || k > 0 && candidate.Equal(candidateList[k-1]) {
+			continue
+		}
+
+		for ; i < len(orderList) && !orderList[i].getExchangeRate().GT(candidate); i++ {
+			offered = offered.Add(orderList[i].makerOwnableSplit)
+		}
+
+		for ; j > 0 && !utilities.Crosses(candidate, oppositeOrderList[j-1].getExchangeRate()); j-- {
+			oppositeMakerOwnableSplit = oppositeMakerOwnableSplit.Sub(oppositeOrderList[j-1].makerOwnableSplit)
+		}
+
+		demanded := oppositeMakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(candidate)
+		split, imbalance := sdkTypes.MinDec(offered, demanded), offered.Sub(demanded).Abs()
+
+		if split.GT(clearedSplit) || split.Equal(clearedSplit) && split.IsPositive() && imbalance.LT(clearingImbalance) {
+			clearingRate, clearedSplit, clearingImbalance = candidate, split, imbalance
+		}
+	}
+
+	return clearingRate, clearedSplit, clearedSplit.IsPositive()
+}
+
+// allocate shares the total among the orders in their priority order, orders at the exchange rate at which the total runs out share what is
+// left of it in proportion to their maker ownable splits, with the remainder of the truncation going to them in priority order
+func allocate(orderList []auctionOrder, total sdkTypes.Dec) []sdkTypes.Dec {
+	allocationList := make([]sdkTypes.Dec, len(orderList))
+	for i := range allocationList {
+		allocationList[i] = sdkTypes.ZeroDec()
+	}
+
+	for start := 0; start < len(orderList) && total.IsPositive(); {
+		end, levelSplit := start, sdkTypes.ZeroDec()
+		for ; end < len(orderList) && orderList[end].getExchangeRate().Equal(orderList[start].getExchangeRate()); end++ {
+			levelSplit = levelSplit.Add(orderList[end].makerOwnableSplit)
+		}
+
+		if !total.LT(levelSplit) {
+			for i := start; i < end; i++ {
+				allocationList[i] = orderList[i].makerOwnableSplit
+			}
+
+			total, start = total.Sub(levelSplit), end
+
+			continue
+		}
+
+		remainder := total
+
+		for i := start; i < end; i++ {
+			allocationList[i] = orderList[i].makerOwnableSplit.MulTruncate(total).QuoTruncate(levelSplit)
+			remainder = remainder.Sub(allocationList[i])
+		}
+
+		for i := start; i < end && remainder.IsPositive(); i++ {
+			share := sdkTypes.MinDec(remainder, orderList[i].makerOwnableSplit.Sub(allocationList[i]))
+			allocationList[i], remainder = allocationList[i].Add(share), remainder.Sub(share)
+		}
+
+		break
+	}
+
+	return allocationList
+}
@@ -0,0 +1,44 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package block
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAuctionOrder(price sdkTypes.Dec, makerID string, makerOwnableSplit int64) auctionOrder {
+	return auctionOrder{order: newTestOrder(price.QuoTruncate(sdkTypes.SmallestDec()).String(), "1", makerID), makerOwnableSplit: sdkTypes.NewDec(makerOwnableSplit)}
+}
+
+func Test_getClearingRate(t *testing.T) {
+	orderList := []auctionOrder{newTestAuctionOrder(sdkTypes.NewDec(1), "a", 10), newTestAuctionOrder(sdkTypes.NewDec(2), "b", 10), newTestAuctionOrder(sdkTypes.NewDec(3), "c", 10)}
+	oppositeOrderList := []auctionOrder{newTestAuctionOrder(sdkTypes.NewDecWithPrec(5, 1), "d", 30), newTestAuctionOrder(sdkTypes.NewDec(1), "e", 10)}
+
+	exchangeRate, makerOwnableSplit, found := getClearingRate(orderList, oppositeOrderList)
+	require.Equal(t, true, found)
+	require.Equal(t, sdkTypes.NewDec(2).QuoTruncate(sdkTypes.SmallestDec()), exchangeRate)
+	require.Equal(t, sdkTypes.NewDec(15), makerOwnableSplit)
+
+	_, _, found = getClearingRate(orderList[2:], oppositeOrderList[1:])
+	require.Equal(t, false, found)
+
+	_, _, found = getClearingRate(orderList, nil)
+	require.Equal(t, false, found)
+}
+
+func Test_allocate(t *testing.T) {
+	orderList := []auctionOrder{newTestAuctionOrder(sdkTypes.NewDec(1), "a", 10), newTestAuctionOrder(sdkTypes.NewDec(2), "b", 10), newTestAuctionOrder(sdkTypes.NewDec(2), "c", 30), newTestAuctionOrder(sdkTypes.NewDec(3), "d", 10)}
+
+	require.Equal(t, []sdkTypes.Dec{sdkTypes.NewDec(10), sdkTypes.NewDecWithPrec(125, 2), sdkTypes.NewDecWithPrec(375, 2), sdkTypes.ZeroDec()}, allocate(orderList, sdkTypes.NewDec(15)))
+	require.Equal(t, []sdkTypes.Dec{sdkTypes.NewDec(10), sdkTypes.NewDec(10), sdkTypes.NewDec(30), sdkTypes.NewDec(10)}, allocate(orderList, sdkTypes.NewDec(70)))
+
+	evenOrderList := []auctionOrder{newTestAuctionOrder(sdkTypes.NewDec(1), "a", 1), newTestAuctionOrder(sdkTypes.NewDec(1), "b", 1), newTestAuctionOrder(sdkTypes.NewDec(1), "c", 1)}
+	allocationList := allocate(evenOrderList, sdkTypes.OneDec())
+	require.Equal(t, sdkTypes.MustNewDecFromStr("0.333333333333333334"), allocationList[0])
+	require.Equal(t, sdkTypes.MustNewDecFromStr("0.333333333333333333"), allocationList[1])
+	require.Equal(t, sdkTypes.OneDec(), allocationList[0].Add(allocationList[1]).Add(allocationList[2]))
+}
@@ -5,23 +5,28 @@ package block
 
 import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
 	"github.com/AssetMantle/modules/modules/orders/internal/key"
-	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
 	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/matches"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/retention"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/settle"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
 	"github.com/AssetMantle/modules/schema/data"
-	baseData "github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
-	ids2 "github.com/AssetMantle/modules/schema/ids"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
-	"github.com/AssetMantle/modules/schema/lists/base"
 	"github.com/AssetMantle/modules/schema/mappables"
-	base2 "github.com/AssetMantle/modules/schema/properties/base"
 	"github.com/AssetMantle/modules/schema/properties/constants"
 	baseTypes "github.com/AssetMantle/modules/schema/types/base"
 )
@@ -29,6 +34,10 @@ import (
 type block struct {
 	mapper              helpers.Mapper
 	parameters          helpers.Parameters
+	memberAuxiliary     helpers.Auxiliary
+	royaltyAuxiliary    helpers.Auxiliary
+	releaseAuxiliary    helpers.Auxiliary
+	settleAuxiliary     helpers.Auxiliary
 	supplementAuxiliary helpers.Auxiliary
 	transferAuxiliary   helpers.Auxiliary
 	scrubAuxiliary      helpers.Auxiliary
@@ -40,158 +49,251 @@ func (block block) Begin(_ sdkTypes.Context, _ abciTypes.RequestBeginBlock) {
 
 }
 
+// End prunes trade records past their retention, refunds expired orders, activates triggered stop orders and then matches the order books,
+// starting from a book that rotates with the block height so that every book gets matched when the match limit runs out before all of them
+// are, books in batch auction mode are left to accumulate orders and only cleared at heights that are multiples of their auction interval
 func (block block) End(context sdkTypes.Context, _ abciTypes.RequestEndBlock) {
-	executeOrders := make(map[ids2.ID]bool)
+	block.pruneTrades(context)
+	block.refundExpiredOrders(context)
+
+	remainingMatches := block.activateStops(context, block.parameters.Fetch(context, matches.ID).Get(matches.ID).GetData().(data.DecData).Get().TruncateInt64())
+
+	bookList := block.getBookList(context)
+	if len(bookList) == 0 {
+		return
+	}
+
+	for i := range bookList {
+		if remainingMatches <= 0 {
+			return
+		}
+
+		Book := bookList[(int(context.BlockHeight()%int64(len(bookList)))+i)%len(bookList)]
+
+		auctionInterval, err := block.getAuctionInterval(context, Book)
+		if err != nil {
+			context.Logger().Error("failed to read book auction interval", "module", module.Name, "book", Book.String(), "error", err.Error())
+			continue
+		}
+
+		switch {
+		case auctionInterval == 0:
+			remainingMatches = block.matchBook(context, Book, remainingMatches)
+		case context.BlockHeight()%auctionInterval == 0:
+			remainingMatches = block.clearAuction(context, Book, remainingMatches)
+		}
+	}
+}
+
+// pruneTrades removes the trade records made more than the trade retention blocks before the block height, oldest first
+func (block block) pruneTrades(context sdkTypes.Context) {
+	tradeRetention := block.parameters.Fetch(context, retention.ID).Get(retention.ID).GetData().(data.DecData).Get().TruncateInt64()
+	if tradeRetention == 0 {
+		return
+	}
+
+	var prunedTradeList []helpers.Mappable
+
+	block.mapper.Iterate(context, key.TradePrototype(), func(mappable helpers.Mappable) bool {
+		if mappable.(mappables.Trade).GetHeight().Get() > context.BlockHeight()-tradeRetention {
+			return true
+		}
+
+		prunedTradeList = append(prunedTradeList, mappable)
+
+		return false
+	})
+
+	trades := block.mapper.NewCollection(context)
+	for _, trade := range prunedTradeList {
+		trades.Remove(trade)
+	}
+}
+
+// refundExpiredOrders pops the expiries falling due by the block height, lowest height first, expiries of orders that cannot be
+// refunded are logged and kept so that the refund is retried in the next block
+func (block block) refundExpiredOrders(context sdkTypes.Context) {
+	var dueExpiryList []mappables.Expiry
+
+	block.mapper.Iterate(context, key.ExpiryPrototype(), func(mappable helpers.Mappable) bool {
+		expiry := mappable.(mappables.Expiry)
+		if expiry.GetHeight().Compare(baseTypes.NewHeight(context.BlockHeight())) > 0 {
+			return true
+		}
+
+		dueExpiryList = append(dueExpiryList, expiry)
+
+		return false
+	})
+
+	for _, expiry := range dueExpiryList {
+		dueExpiry := expiry
+
+		if err := applyCached(context, func(cacheContext sdkTypes.Context) error {
+			return block.popExpiry(cacheContext, dueExpiry)
+		}); err != nil {
+			context.Logger().Error("failed to refund expired order", "module", module.Name, "order", expiry.GetOrderID().String(), "error", err.Error())
+		}
+	}
+}
+
+// popExpiry removes the expiry and refunds its order, or the stop holding it until triggered, unless the order was closed or given a later
+// expiry since the expiry was written
+func (block block) popExpiry(context sdkTypes.Context, expiry mappables.Expiry) error {
 	orders := block.mapper.NewCollection(context)
+	orders.Remove(expiry)
 
-	orders.Iterate(
-		key.FromID(baseIDs.NewID("")),
-		func(order helpers.Mappable) bool {
-			metaProperties, Error := supplement.GetMetaPropertiesFromResponse(block.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(order.(mappables.Order).GetExpiry(), order.(mappables.Order).GetMakerOwnableSplit())))
-			if Error != nil {
-				panic(Error)
-			}
-			if expiryProperty := metaProperties.GetMetaProperty(constants.ExpiryProperty); expiryProperty != nil {
-				expiry := expiryProperty.GetData().(data.HeightData).Get()
-
-				if expiry.Compare(baseTypes.NewHeight(context.BlockHeight())) <= 0 {
-					makerOwnableSplitProperty := metaProperties.GetMetaProperty(constants.MakerOwnableSplitProperty)
-					if makerOwnableSplitProperty == nil {
-						panic(errors.MetaDataError)
-					}
-					makerOwnableSplit := makerOwnableSplitProperty.GetData().(data.DecData).Get()
-					if auxiliaryResponse := block.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.(mappables.Order).GetMakerID(), order.(mappables.Order).GetMakerOwnableID(), makerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-						panic(auxiliaryResponse.GetError())
-					}
-					orders.Remove(order)
-				} else {
-					id1 := key.NewOrderID(order.(mappables.Order).GetClassificationID(), order.(mappables.Order).GetMakerOwnableID(), order.(mappables.Order).GetTakerOwnableID(), baseIDs.NewID(""), baseIDs.NewID(""), baseIDs.NewID(""), base.NewPropertyList())
-					id2 := key.NewOrderID(order.(mappables.Order).GetClassificationID(), order.(mappables.Order).GetTakerOwnableID(), order.(mappables.Order).GetMakerOwnableID(), baseIDs.NewID(""), baseIDs.NewID(""), baseIDs.NewID(""), base.NewPropertyList())
-					if !executeOrders[id1] && !executeOrders[id2] {
-						executeOrders[id1] = true
-					}
-				}
+	orderKey := key.FromID(expiry.GetOrderID())
+
+	var holder helpers.Mappable
+
+	order, ok := orders.Fetch(orderKey).Get(orderKey).(mappables.Order)
+	if ok {
+		holder = order
+	} else if stop, ok := utilities.GetStop(context, block.mapper, expiry.GetOrderID()); ok {
+		order, holder = stop.GetOrder(), stop
+	} else {
+		return nil
+	}
+
+	metaProperties, err := supplement.GetMetaPropertiesFromResponse(block.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(order.GetExpiry())))
+	if err != nil {
+		return err
+	}
+
+	if expiryProperty := metaProperties.GetMetaProperty(constants.ExpiryProperty); expiryProperty == nil || expiryProperty.GetData().(data.HeightData).Get().Compare(baseTypes.NewHeight(context.BlockHeight())) > 0 {
+		return nil
+	}
+
+	return block.refund(context, order, holder, events.OrderExpired)
+}
+
+// getBookList returns the books holding open orders, each along with its opposite book, walking the book index one entry per book
+func (block block) getBookList(context sdkTypes.Context) []book {
+	var bookList []book
+
+	bookMap := make(map[string]bool)
+
+	block.mapper.IterateIndexKeys(context, mapper.BookIndex, func(mappable helpers.Mappable) bool {
+		if Book := newBook(mappable.(mappables.Order)); !bookMap[Book.String()] && !bookMap[getOppositeBook(Book).String()] {
+			bookMap[Book.String()] = true
+			bookList = append(bookList, Book)
+		}
+
+		return false
+	})
+
+	return bookList
+}
+
+// refundOrder refunds the remaining maker ownable split of the order to its maker and removes it, emitting the event type given
+func (block block) refundOrder(context sdkTypes.Context, order mappables.Order, eventType string) error {
+	return block.refund(context, order, order, eventType)
+}
+
+// refund refunds the remaining maker ownable split of the order to its maker and removes the mappable holding the order, emitting the event
+// type given
+func (block block) refund(context sdkTypes.Context, order mappables.Order, holder helpers.Mappable, eventType string) error {
+	makerOwnableSplit, err := utilities.GetMakerOwnableSplit(context, block.supplementAuxiliary, order)
+	if err != nil {
+		return err
+	}
+
+	if auxiliaryResponse := block.releaseAuxiliary.GetKeeper().Help(context, release.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.GetID(), makerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+		return auxiliaryResponse.GetError()
+	}
+
+	block.mapper.NewCollection(context).Remove(holder)
+
+	utilities.EmitOrderClosedEvent(context, eventType, order, makerOwnableSplit)
+
+	return nil
+}
+
+// matchBook matches the best orders of the book against the best orders of its opposite book for as long as their exchange rates
+// cross, the order created earlier sets the price, a failing match is logged and both its orders are left out of the book until the
+// next block, a post only order that would take from the book is cancelled instead, it returns the number of matches left to attempt
+// in the block
+func (block block) matchBook(context sdkTypes.Context, Book book, remainingMatches int64) int64 {
+	skippedOrders := make(map[string]bool)
+	matcher := block.getMatcher()
+
+	for ; remainingMatches > 0; remainingMatches-- {
+		order, makerOwnableSplit, found := matcher.GetBestOrder(context, Book.classificationID, Book.makerOwnableID, Book.takerOwnableID, skippedOrders)
+		if !found {
+			return remainingMatches
+		}
+
+		oppositeOrder, oppositeMakerOwnableSplit, found := matcher.GetBestOrder(context, Book.classificationID, Book.takerOwnableID, Book.makerOwnableID, skippedOrders)
+		if !found {
+			return remainingMatches
+		}
+
+		if !utilities.Crosses(order.GetExchangeRate().GetData().(data.DecData).Get(), oppositeOrder.GetExchangeRate().GetData().(data.DecData).Get()) {
+			return remainingMatches
+		}
+
+		restingOrder, restingMakerOwnableSplit, incomingOrder, incomingMakerOwnableSplit := order, makerOwnableSplit, oppositeOrder, oppositeMakerOwnableSplit
+		if !isPrior(order, oppositeOrder) {
+			restingOrder, restingMakerOwnableSplit, incomingOrder, incomingMakerOwnableSplit = oppositeOrder, oppositeMakerOwnableSplit, order, makerOwnableSplit
+		}
+
+		timeInForce, err := utilities.GetTimeInForce(context, block.supplementAuxiliary, incomingOrder)
+		if err != nil {
+			context.Logger().Error("failed to read order time in force", "module", module.Name, "order", incomingOrder.GetID().String(), "error", err.Error())
+			skippedOrders[incomingOrder.GetID().String()] = true
+
+			continue
+		}
+
+		if timeInForce.Compare(utilities.PostOnly) == 0 {
+			if err := applyCached(context, func(cacheContext sdkTypes.Context) error {
+				return block.refundOrder(cacheContext, incomingOrder, events.OrderCancelled)
+			}); err != nil {
+				context.Logger().Error("failed to cancel post only order", "module", module.Name, "order", incomingOrder.GetID().String(), "error", err.Error())
+				skippedOrders[incomingOrder.GetID().String()] = true
 			}
-			return false
-		},
-	)
-
-	for partialOrderID := range executeOrders {
-		nextPartialOrderID := false
-
-		orders.Iterate(key.FromID(partialOrderID), func(orderMappable helpers.Mappable) bool {
-			orders.Iterate(
-				key.FromID(key.NewOrderID(orderMappable.(mappables.Order).GetClassificationID(), orderMappable.(mappables.Order).GetTakerOwnableID(), orderMappable.(mappables.Order).GetMakerOwnableID(), baseIDs.NewID(""), baseIDs.NewID(""), baseIDs.NewID(""), base.NewPropertyList())),
-				func(executableMappableOrder helpers.Mappable) bool {
-
-					var leftOrder mappables.Order
-					var rightOrder mappables.Order
-
-					orderHeight := orderMappable.(mappables.Order).GetCreation().GetData().(data.HeightData).Get()
-
-					executableOrderHeight := executableMappableOrder.(mappables.Order).GetCreation().GetData().(data.HeightData).Get()
-
-					switch {
-					case orderHeight.Compare(executableOrderHeight) > 0:
-						leftOrder = orderMappable.(mappables.Order)
-						rightOrder = executableMappableOrder.(mappables.Order)
-					case executableOrderHeight.Compare(orderHeight) > 0:
-						leftOrder = executableMappableOrder.(mappables.Order)
-						rightOrder = orderMappable.(mappables.Order)
-					default:
-						// TODO
-						leftOrder = orderMappable.(mappables.Order)
-						rightOrder = executableMappableOrder.(mappables.Order)
-					}
-
-					leftOrderExchangeRate := leftOrder.GetExchangeRate().GetData().(data.DecData).Get()
-
-					leftOrderMetaProperties, Error := supplement.GetMetaPropertiesFromResponse(block.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(leftOrder.GetMakerOwnableSplit())))
-					if Error != nil {
-						panic(Error)
-					}
-
-					leftOrderMakerOwnableSplit := leftOrderMetaProperties.GetMetaProperty(constants.MakerOwnableSplitProperty).GetData().(data.DecData).Get()
-
-					rightOrderExchangeRate := rightOrder.GetExchangeRate().GetData().(data.DecData).Get()
-
-					rightOrderMetaProperties, Error := supplement.GetMetaPropertiesFromResponse(block.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(rightOrder.GetMakerOwnableSplit())))
-					if Error != nil {
-						panic(Error)
-					}
-
-					rightOrderMakerOwnableSplit := rightOrderMetaProperties.GetMetaProperty(constants.MakerOwnableSplitProperty).GetData().(data.DecData).Get()
-
-					rightOrderTakerOwnableSplitDemanded := rightOrderExchangeRate.MulTruncate(rightOrderMakerOwnableSplit).MulTruncate(sdkTypes.SmallestDec())
-
-					if leftOrderExchangeRate.MulTruncate(rightOrderExchangeRate).MulTruncate(sdkTypes.SmallestDec()).MulTruncate(sdkTypes.SmallestDec()).LTE(sdkTypes.OneDec()) {
-						switch {
-						case leftOrderMakerOwnableSplit.GT(rightOrderTakerOwnableSplitDemanded):
-							if auxiliaryResponse := block.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), leftOrder.GetMakerID(), leftOrder.GetTakerOwnableID(), rightOrderMakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-								panic(auxiliaryResponse.GetError())
-							}
-							if auxiliaryResponse := block.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), rightOrder.GetMakerID(), leftOrder.GetMakerOwnableID(), rightOrderTakerOwnableSplitDemanded)); !auxiliaryResponse.IsSuccessful() {
-								panic(auxiliaryResponse.GetError())
-							}
-
-							mutableProperties, Error := scrub.GetPropertiesFromResponse(block.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(base2.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(leftOrderMakerOwnableSplit.Sub(rightOrderTakerOwnableSplitDemanded))))))
-							if Error != nil {
-								panic(Error)
-							}
-
-							orders.Mutate(mappable.NewOrder(leftOrder.GetID(), leftOrder.GetImmutablePropertyList(), leftOrder.Mutate(mutableProperties.GetList()...).GetMutablePropertyList()))
-							orders.Remove(rightOrder)
-
-							if executableOrderHeight.Compare(orderHeight) > 0 {
-								return true
-							}
-						case leftOrderMakerOwnableSplit.LT(rightOrderTakerOwnableSplitDemanded):
-							sendToLeftOrder := leftOrderMakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(rightOrderExchangeRate)
-							if auxiliaryResponse := block.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), leftOrder.GetMakerID(), leftOrder.GetTakerOwnableID(), sendToLeftOrder)); !auxiliaryResponse.IsSuccessful() {
-								panic(auxiliaryResponse.GetError())
-							}
-							if auxiliaryResponse := block.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), rightOrder.GetMakerID(), leftOrder.GetMakerOwnableID(), leftOrderMakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-								panic(auxiliaryResponse.GetError())
-							}
-
-							mutableProperties, Error := scrub.GetPropertiesFromResponse(block.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(base2.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(rightOrderMakerOwnableSplit.Sub(sendToLeftOrder))))))
-							if Error != nil {
-								panic(Error)
-							}
-
-							orders.Mutate(mappable.NewOrder(rightOrder.GetID(), rightOrder.GetImmutablePropertyList(), rightOrder.GetMutablePropertyList().Mutate(mutableProperties.GetList()...)))
-							orders.Remove(leftOrder)
-
-							if orderHeight.Compare(executableOrderHeight) >= 0 {
-								return true
-							}
-						default:
-							// case leftOrderMakerOwnableSplit.Equal(rightOrderTakerOwnableSplitDemanded):
-							if auxiliaryResponse := block.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), leftOrder.GetMakerID(), leftOrder.GetTakerOwnableID(), rightOrderMakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-								panic(auxiliaryResponse.GetError())
-							}
-							if auxiliaryResponse := block.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), rightOrder.GetMakerID(), leftOrder.GetMakerOwnableID(), leftOrderMakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-								panic(auxiliaryResponse.GetError())
-							}
-
-							orders.Remove(rightOrder)
-							orders.Remove(leftOrder)
-							return true
-						}
-					} else {
-						nextPartialOrderID = true
-						return true
-					}
-					return false
-				},
-			)
-			return nextPartialOrderID
-		})
-
-		if nextPartialOrderID {
+
 			continue
 		}
+
+		if err := applyCached(context, func(cacheContext sdkTypes.Context) error {
+			return matcher.MatchOrders(cacheContext, restingOrder, restingMakerOwnableSplit, incomingOrder, incomingMakerOwnableSplit)
+		}); err != nil {
+			context.Logger().Error("failed to match orders", "module", module.Name, "order", order.GetID().String(), "oppositeOrder", oppositeOrder.GetID().String(), "error", err.Error())
+			skippedOrders[order.GetID().String()] = true
+			skippedOrders[oppositeOrder.GetID().String()] = true
+		}
 	}
+
+	return remainingMatches
+}
+
+// getMatcher returns the matcher filling orders against the books with the auxiliaries of the block
+func (block block) getMatcher() utilities.Matcher {
+	return utilities.NewMatcher(block.mapper, block.parameters, block.memberAuxiliary, block.royaltyAuxiliary, block.scrubAuxiliary, block.settleAuxiliary, block.supplementAuxiliary)
+}
+
+// applyCached applies the state change on a cache of the context, writing its state and emitting its events only when it succeeds, a state
+// change that panics, as decimal arithmetic does on overflow, fails like one returning an error so that it cannot halt the block
+func applyCached(context sdkTypes.Context, stateChange func(sdkTypes.Context) error) (err error) {
+	cacheContext, writeCache := context.CacheContext()
+	cacheContext = cacheContext.WithEventManager(sdkTypes.NewEventManager())
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = sdkErrors.Wrapf(errors.InvalidRequest, "%v", recovered)
+		}
+	}()
+
+	if err := stateChange(cacheContext); err != nil {
+		return err
+	}
+
+	writeCache()
+	context.EventManager().EmitEvents(cacheContext.EventManager().Events())
+
+	return nil
 }
 
 func (block block) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaryKeepers ...interface{}) helpers.Block {
@@ -201,6 +303,14 @@ func (block block) Initialize(mapper helpers.Mapper, parameters helpers.Paramete
 		switch value := auxiliaryKeeper.(type) {
 		case helpers.Auxiliary:
 			switch value.GetName() {
+			case member.Auxiliary.GetName():
+				block.memberAuxiliary = value
+			case royalty.Auxiliary.GetName():
+				block.royaltyAuxiliary = value
+			case release.Auxiliary.GetName():
+				block.releaseAuxiliary = value
+			case settle.Auxiliary.GetName():
+				block.settleAuxiliary = value
 			case supplement.Auxiliary.GetName():
 				block.supplementAuxiliary = value
 			case transfer.Auxiliary.GetName():
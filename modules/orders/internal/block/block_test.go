@@ -0,0 +1,308 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package block
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/auction"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/fees"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/matches"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/balance"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/mint"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/settle"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type testKeepers struct {
+	block        block
+	metasModule  helpers.Module
+	splitsModule helpers.Module
+}
+
+func createTestInput(t *testing.T) (sdkTypes.Context, testKeepers) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	metasStoreKey := sdkTypes.NewKVStoreKey("testMetas")
+	splitsStoreKey := sdkTypes.NewKVStoreKey("testSplits")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test").WithKeyTable(parameters.Prototype().GetKeyTable()))
+	metasModule := metas.Prototype().Initialize(metasStoreKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+	splitsModule := splits.Prototype().Initialize(splitsStoreKey, paramsKeeper.Subspace(splits.Prototype().Name()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(metasStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(splitsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	for _, parameter := range Parameters.GetList() {
+		Parameters.Mutate(context, parameter)
+	}
+
+	for _, module := range []helpers.Module{metasModule, splitsModule} {
+		for _, parameter := range module.GetParameters().GetList() {
+			module.GetParameters().Mutate(context, parameter)
+		}
+	}
+
+	keepers := testKeepers{
+		block: Prototype().Initialize(Mapper, Parameters,
+			member.AuxiliaryMock.Initialize(Mapper, nil),
+			royalty.AuxiliaryMock.Initialize(Mapper, nil),
+			metasModule.GetAuxiliary(scrub.Auxiliary.GetName()),
+			metasModule.GetAuxiliary(supplement.Auxiliary.GetName()),
+			splitsModule.GetAuxiliary(release.Auxiliary.GetName()),
+			splitsModule.GetAuxiliary(settle.Auxiliary.GetName()),
+			splitsModule.GetAuxiliary(transfer.Auxiliary.GetName()),
+		).(block),
+		metasModule:  metasModule,
+		splitsModule: splitsModule,
+	}
+
+	return context, keepers
+}
+
+// newTestMappableOrder returns an order of the maker selling the maker ownable split for the taker ownable at the exchange rate, made at the
+// height and held in escrow for the maker, who is minted the split
+func newTestMappableOrder(t *testing.T, context sdkTypes.Context, keepers testKeepers, makerID string, makerOwnableID string, takerOwnableID string, exchangeRate sdkTypes.Dec, height int64, makerOwnableSplit sdkTypes.Dec, timeInForce ids.ID) mappables.Order {
+	mutableProperties, err := scrub.GetPropertiesFromResponse(keepers.metasModule.GetAuxiliary(scrub.Auxiliary.GetName()).GetKeeper().Help(context, scrub.NewAuxiliaryRequest(
+		baseProperties.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(baseTypes.NewHeight(1000))),
+		baseProperties.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(makerOwnableSplit)),
+		baseProperties.NewMetaProperty(constants.TimeInForceProperty.GetKey(), baseData.NewIDData(timeInForce)),
+	)))
+	require.Nil(t, err)
+
+	orderID := key.NewOrderID(baseIDs.NewID("classificationID"), baseIDs.NewID(makerOwnableID), baseIDs.NewID(takerOwnableID), baseIDs.NewID(exchangeRate.QuoTruncate(sdkTypes.SmallestDec()).String()), baseIDs.NewID(strconv.FormatInt(height, 10)), baseIDs.NewID(makerID), baseLists.NewPropertyList())
+
+	require.Equal(t, true, keepers.splitsModule.GetAuxiliary(mint.Auxiliary.GetName()).GetKeeper().Help(context, mint.NewAuxiliaryRequest(baseIDs.NewID(makerID), baseIDs.NewID(makerOwnableID), makerOwnableSplit)).IsSuccessful())
+	require.Equal(t, true, keepers.splitsModule.GetAuxiliary(lock.Auxiliary.GetName()).GetKeeper().Help(context, lock.NewAuxiliaryRequest(baseIDs.NewID(module.Name), orderID, baseIDs.NewID(makerID), baseIDs.NewID(makerOwnableID), makerOwnableSplit)).IsSuccessful())
+
+	return mappable.NewOrder(orderID, baseLists.NewPropertyList(), mutableProperties)
+}
+
+func getTestBalance(t *testing.T, context sdkTypes.Context, keepers testKeepers, ownerID string, ownableID string) sdkTypes.Dec {
+	value, err := balance.GetValueFromResponse(keepers.splitsModule.GetAuxiliary(balance.Auxiliary.GetName()).GetKeeper().Help(context, balance.NewAuxiliaryRequest(baseIDs.NewID(ownerID), baseIDs.NewID(ownableID))))
+	require.Nil(t, err)
+
+	return value
+}
+
+// testLogger records the messages of the errors logged
+type testLogger struct {
+	errorList *[]string
+}
+
+var _ log.Logger = (*testLogger)(nil)
+
+func (testLogger) Debug(string, ...interface{}) {}
+func (testLogger) Info(string, ...interface{})  {}
+func (testLogger testLogger) Error(message string, _ ...interface{}) {
+	*testLogger.errorList = append(*testLogger.errorList, message)
+}
+func (testLogger testLogger) With(...interface{}) log.Logger { return testLogger }
+
+func Test_block_End(t *testing.T) {
+	// newEndBlockTestInput returns a context at the height with fees waived, so that fills move whole splits, and matches capped as given
+	newEndBlockTestInput := func(t *testing.T, remainingMatches int64) (sdkTypes.Context, testKeepers, *[]string) {
+		context, keepers := createTestInput(t)
+		errorList := &[]string{}
+		context = context.WithBlockHeight(10).WithLogger(testLogger{errorList: errorList})
+
+		keepers.block.parameters.Mutate(context, fees.Parameter.Mutate(baseData.NewDecData(sdkTypes.ZeroDec())))
+		keepers.block.parameters.Mutate(context, matches.Parameter.Mutate(baseData.NewDecData(sdkTypes.NewDec(remainingMatches))))
+
+		return context, keepers, errorList
+	}
+
+	addOrder := func(t *testing.T, context sdkTypes.Context, keepers testKeepers, makerID string, makerOwnableID string, takerOwnableID string, exchangeRate sdkTypes.Dec, height int64, makerOwnableSplit int64) mappables.Order {
+		order := newTestMappableOrder(t, context, keepers, makerID, makerOwnableID, takerOwnableID, exchangeRate, height, sdkTypes.NewDec(makerOwnableSplit), utilities.GoodTillCancelled)
+		keepers.block.mapper.NewCollection(context).Add(order)
+
+		return order
+	}
+
+	hasOrder := func(context sdkTypes.Context, keepers testKeepers, order mappables.Order) bool {
+		return keepers.block.mapper.NewCollection(context).Fetch(order.GetKey()).Get(order.GetKey()) != nil
+	}
+
+	t.Run("price time priority", func(t *testing.T) {
+		context, keepers, errorList := newEndBlockTestInput(t, 100)
+
+		worsePricedOrder := addOrder(t, context, keepers, "a", "maker", "taker", sdkTypes.NewDec(2), 1, 10)
+		laterOrder := addOrder(t, context, keepers, "b", "maker", "taker", sdkTypes.OneDec(), 3, 10)
+		earlierOrder := addOrder(t, context, keepers, "c", "maker", "taker", sdkTypes.OneDec(), 2, 10)
+		incomingOrder := addOrder(t, context, keepers, "d", "taker", "maker", sdkTypes.OneDec(), 4, 10)
+
+		keepers.block.End(context, abciTypes.RequestEndBlock{})
+
+		require.Empty(t, *errorList)
+		require.Equal(t, false, hasOrder(context, keepers, earlierOrder))
+		require.Equal(t, false, hasOrder(context, keepers, incomingOrder))
+		require.Equal(t, true, hasOrder(context, keepers, laterOrder))
+		require.Equal(t, true, hasOrder(context, keepers, worsePricedOrder))
+		require.Equal(t, sdkTypes.NewDec(10), getTestBalance(t, context, keepers, "c", "taker"))
+		require.Equal(t, sdkTypes.NewDec(10), getTestBalance(t, context, keepers, "d", "maker"))
+		require.Equal(t, sdkTypes.ZeroDec(), getTestBalance(t, context, keepers, "b", "taker"))
+		require.Equal(t, sdkTypes.ZeroDec(), getTestBalance(t, context, keepers, "a", "taker"))
+	})
+
+	t.Run("match cap", func(t *testing.T) {
+		context, keepers, errorList := newEndBlockTestInput(t, 1)
+
+		firstOrder := addOrder(t, context, keepers, "a", "maker", "taker", sdkTypes.OneDec(), 1, 10)
+		secondOrder := addOrder(t, context, keepers, "b", "maker", "taker", sdkTypes.OneDec(), 2, 10)
+		incomingOrder := addOrder(t, context, keepers, "c", "taker", "maker", sdkTypes.OneDec(), 3, 20)
+
+		keepers.block.End(context, abciTypes.RequestEndBlock{})
+
+		require.Empty(t, *errorList)
+		require.Equal(t, false, hasOrder(context, keepers, firstOrder))
+		require.Equal(t, true, hasOrder(context, keepers, secondOrder))
+		require.Equal(t, true, hasOrder(context, keepers, incomingOrder))
+		require.Equal(t, sdkTypes.NewDec(10), getTestBalance(t, context, keepers, "c", "maker"))
+
+		// the matches left over are attempted in the next block
+		keepers.block.End(context.WithBlockHeight(11), abciTypes.RequestEndBlock{})
+
+		require.Equal(t, false, hasOrder(context, keepers, secondOrder))
+		require.Equal(t, false, hasOrder(context, keepers, incomingOrder))
+		require.Equal(t, sdkTypes.NewDec(20), getTestBalance(t, context, keepers, "c", "maker"))
+	})
+
+	t.Run("auction match cap", func(t *testing.T) {
+		context, keepers, errorList := newEndBlockTestInput(t, 3)
+		keepers.block.parameters.Mutate(context, auction.Parameter.Mutate(baseData.NewDecData(sdkTypes.NewDec(5))))
+
+		var orderList []mappables.Order
+		for i, makerID := range []string{"a", "b", "c"} {
+			orderList = append(orderList, addOrder(t, context, keepers, makerID, "maker", "taker", sdkTypes.OneDec(), int64(i+1), 10))
+		}
+
+		for i, makerID := range []string{"d", "e", "f"} {
+			orderList = append(orderList, addOrder(t, context, keepers, makerID, "taker", "maker", sdkTypes.OneDec(), int64(i+4), 10))
+		}
+
+		countOrders := func(context sdkTypes.Context) int {
+			var count int
+
+			for _, order := range orderList {
+				if hasOrder(context, keepers, order) {
+					count++
+				}
+			}
+
+			return count
+		}
+
+		keepers.block.End(context, abciTypes.RequestEndBlock{})
+
+		// two orders of one book share what the one order taken from the other book sells, which alone is filled in full
+		require.Empty(t, *errorList)
+		require.Equal(t, 5, countOrders(context))
+		require.Equal(t, sdkTypes.NewDec(10), getTestBalance(t, context, keepers, "a", "taker").Add(getTestBalance(t, context, keepers, "b", "taker")).Add(getTestBalance(t, context, keepers, "c", "taker")))
+		require.Equal(t, sdkTypes.NewDec(10), getTestBalance(t, context, keepers, "d", "maker").Add(getTestBalance(t, context, keepers, "e", "maker")).Add(getTestBalance(t, context, keepers, "f", "maker")))
+
+		// the orders left out wait for the next auction
+		keepers.block.End(context.WithBlockHeight(12), abciTypes.RequestEndBlock{})
+		require.Equal(t, 5, countOrders(context))
+
+		keepers.block.End(context.WithBlockHeight(15), abciTypes.RequestEndBlock{})
+		require.Less(t, countOrders(context), 5)
+	})
+
+	t.Run("maximal exchange rate", func(t *testing.T) {
+		context, keepers, errorList := newEndBlockTestInput(t, 100)
+
+		// the order demands the maximal taker ownable split per maker ownable split, which the opposite order offers at its smallest exchange rate
+		restingOrder := addOrder(t, context, keepers, "a", "maker", "taker", utilities.MaxExchangeRate.Mul(sdkTypes.SmallestDec()), 1, 10)
+		incomingOrder := newTestMappableOrder(t, context, keepers, "b", "taker", "maker", sdkTypes.SmallestDec(), 2, sdkTypes.NewDecFromInt(sdkTypes.NewIntWithDecimal(1, 19)), utilities.GoodTillCancelled)
+		keepers.block.mapper.NewCollection(context).Add(incomingOrder)
+
+		require.NotPanics(t, func() { keepers.block.End(context, abciTypes.RequestEndBlock{}) })
+
+		require.Empty(t, *errorList)
+		require.Equal(t, false, hasOrder(context, keepers, restingOrder))
+		require.Equal(t, false, hasOrder(context, keepers, incomingOrder))
+		require.Equal(t, sdkTypes.NewDecFromInt(sdkTypes.NewIntWithDecimal(1, 19)), getTestBalance(t, context, keepers, "a", "taker"))
+		require.Equal(t, sdkTypes.NewDec(10), getTestBalance(t, context, keepers, "b", "maker"))
+	})
+
+	t.Run("failed pair skipped and logged", func(t *testing.T) {
+		context, keepers, errorList := newEndBlockTestInput(t, 100)
+
+		// the order is left without its escrow, so that settling what it sells fails
+		unescrowedOrder := addOrder(t, context, keepers, "a", "maker", "taker", sdkTypes.OneDec(), 1, 10)
+		require.Equal(t, true, keepers.splitsModule.GetAuxiliary(release.Auxiliary.GetName()).GetKeeper().Help(context, release.NewAuxiliaryRequest(baseIDs.NewID(module.Name), unescrowedOrder.GetID(), sdkTypes.NewDec(10))).IsSuccessful())
+
+		order := addOrder(t, context, keepers, "b", "maker", "taker", sdkTypes.OneDec(), 2, 10)
+		skippedOrder := addOrder(t, context, keepers, "c", "taker", "maker", sdkTypes.OneDec(), 3, 10)
+		incomingOrder := addOrder(t, context, keepers, "d", "taker", "maker", sdkTypes.OneDec(), 4, 10)
+
+		keepers.block.End(context, abciTypes.RequestEndBlock{})
+
+		require.Equal(t, []string{"failed to match orders"}, *errorList)
+		require.Equal(t, true, hasOrder(context, keepers, unescrowedOrder))
+		require.Equal(t, true, hasOrder(context, keepers, skippedOrder))
+		require.Equal(t, sdkTypes.ZeroDec(), getTestBalance(t, context, keepers, "c", "maker"))
+		require.Equal(t, false, hasOrder(context, keepers, order))
+		require.Equal(t, false, hasOrder(context, keepers, incomingOrder))
+		require.Equal(t, sdkTypes.NewDec(10), getTestBalance(t, context, keepers, "b", "taker"))
+		require.Equal(t, sdkTypes.NewDec(10), getTestBalance(t, context, keepers, "d", "maker"))
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package block
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// book identifies the orders of a classification offering the maker ownable in exchange for the taker ownable
+type book struct {
+	classificationID ids.ID
+	makerOwnableID   ids.ID
+	takerOwnableID   ids.ID
+}
+
+func (book book) String() string {
+	return strings.Join([]string{book.classificationID.String(), book.makerOwnableID.String(), book.takerOwnableID.String()}, constants.SecondOrderCompositeIDSeparator)
+}
+func (book book) getIndexKeyBytes() []byte {
+	return mapper.GenerateBookKeyBytes(book.classificationID, book.makerOwnableID, book.takerOwnableID)
+}
+
+// getOppositeBook returns the book of the orders that take what the orders of the book make
+func getOppositeBook(Book book) book {
+	return book{
+		classificationID: Book.classificationID,
+		makerOwnableID:   Book.takerOwnableID,
+		takerOwnableID:   Book.makerOwnableID,
+	}
+}
+
+func newBook(order mappables.Order) book {
+	return book{
+		classificationID: order.GetClassificationID(),
+		makerOwnableID:   order.GetMakerOwnableID(),
+		takerOwnableID:   order.GetTakerOwnableID(),
+	}
+}
+
+// isPrior tells if the order was created before the other order, orders created at the same height are ordered by their keys
+func isPrior(order mappables.Order, otherOrder mappables.Order) bool {
+	if comparison := order.GetCreation().GetData().(data.HeightData).Get().Compare(otherOrder.GetCreation().GetData().(data.HeightData).Get()); comparison != 0 {
+		return comparison < 0
+	}
+
+	return bytes.Compare(order.GetKey().GenerateStoreKeyBytes(), otherOrder.GetKey().GenerateStoreKeyBytes()) < 0
+}
@@ -0,0 +1,49 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package block
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+func newTestOrder(rate string, creation string, makerID string) mappables.Order {
+	return mappable.NewOrder(key.NewOrderID(baseIDs.NewID("classification"), baseIDs.NewID("maker"), baseIDs.NewID("taker"), baseIDs.NewID(rate), baseIDs.NewID(creation), baseIDs.NewID(makerID), baseLists.NewPropertyList()), baseLists.NewPropertyList(), baseLists.NewPropertyList())
+}
+
+func Test_isPrior(t *testing.T) {
+	tests := []struct {
+		name       string
+		order      mappables.Order
+		otherOrder mappables.Order
+		want       bool
+	}{
+		{"earlier", newTestOrder("1.000000000000000000", "9", "a"), newTestOrder("1.000000000000000000", "10", "a"), true},
+		{"later", newTestOrder("1.000000000000000000", "10", "a"), newTestOrder("1.000000000000000000", "9", "a"), false},
+		{"same height", newTestOrder("1.000000000000000000", "10", "a"), newTestOrder("1.000000000000000000", "10", "b"), true},
+		{"same height reversed", newTestOrder("1.000000000000000000", "10", "b"), newTestOrder("1.000000000000000000", "10", "a"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isPrior(tt.order, tt.otherOrder))
+		})
+	}
+}
+
+func Test_getOppositeBook(t *testing.T) {
+	Book := newBook(newTestOrder("1.000000000000000000", "1", "a"))
+	oppositeBook := getOppositeBook(Book)
+
+	require.Equal(t, Book.makerOwnableID.String(), oppositeBook.takerOwnableID.String())
+	require.Equal(t, Book.takerOwnableID.String(), oppositeBook.makerOwnableID.String())
+	require.Equal(t, Book.String(), getOppositeBook(oppositeBook).String())
+	require.NotEqual(t, Book.getIndexKeyBytes(), oppositeBook.getIndexKeyBytes())
+}
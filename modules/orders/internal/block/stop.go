@@ -0,0 +1,145 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package block
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// activateStops places the orders of the stops that the last exchange rate of their ownable pair has fallen to the trigger rate of, visiting
+// only the pairs whose last price the stops have not been checked against, from their highest trigger rate down to the last price, every
+// activation is charged at least one match, a failing activation is logged and retried in the next block, as are the stops left once the
+// matches run out, it returns the number of matches left to attempt in the block
+//
+// the exchange rate of a pair falling is the maker ownable losing value against the taker ownable, so that the same trigger serves stop losses
+// selling the maker ownable and stop buys of the taker ownable
+func (block block) activateStops(context sdkTypes.Context, remainingMatches int64) int64 {
+	var lastPriceList []mappables.LastPrice
+
+	block.mapper.IterateIndex(context, mapper.UncheckedIndex, nil, func(mappable helpers.Mappable) bool {
+		lastPriceList = append(lastPriceList, mappable.(mappables.LastPrice))
+		return false
+	})
+
+	for _, lastPrice := range lastPriceList {
+		if remainingMatches <= 0 {
+			return remainingMatches
+		}
+
+		var triggeredStopList []mappables.Stop
+
+		// stops of a pair are keyed by trigger rate so those triggered come first in reverse, and no more are loaded than can be activated
+		block.mapper.ReverseIterate(context, key.FromStopID(key.NewStopPairID(lastPrice.GetMakerOwnableID(), lastPrice.GetTakerOwnableID())), func(mappable helpers.Mappable) bool {
+			stop := mappable.(mappables.Stop)
+			if stop.GetTriggerRate().LT(lastPrice.GetExchangeRate()) {
+				return true
+			}
+
+			triggeredStopList = append(triggeredStopList, stop)
+
+			return int64(len(triggeredStopList)) > remainingMatches
+		})
+
+		checked := int64(len(triggeredStopList)) <= remainingMatches
+
+		for _, stop := range triggeredStopList {
+			if remainingMatches <= 0 {
+				checked = false
+				break
+			}
+
+			matchesLeft := remainingMatches
+
+			if err := applyCached(context, func(cacheContext sdkTypes.Context) error {
+				var err error
+				matchesLeft, err = block.activateStop(cacheContext, stop, lastPrice.GetExchangeRate(), remainingMatches)
+
+				return err
+			}); err != nil {
+				context.Logger().Error("failed to activate stop order", "module", module.Name, "order", stop.GetOrder().GetID().String(), "error", err.Error())
+
+				checked = false
+			}
+
+			if matchesLeft == remainingMatches {
+				matchesLeft--
+			}
+
+			remainingMatches = matchesLeft
+		}
+
+		// fills of the activated orders may have set a new last price, which the stops are yet to be checked against
+		if currentLastPrice, found := utilities.GetLastPrice(context, block.mapper, lastPrice.GetMakerOwnableID(), lastPrice.GetTakerOwnableID()); checked && found && currentLastPrice.GetHeight().Compare(lastPrice.GetHeight()) == 0 && currentLastPrice.GetExchangeRate().Equal(lastPrice.GetExchangeRate()) {
+			block.mapper.NewCollection(context).Mutate(mappable.NewCheckedLastPrice(lastPrice.GetMakerOwnableID(), lastPrice.GetTakerOwnableID(), lastPrice.GetExchangeRate(), lastPrice.GetHeight()))
+		}
+	}
+
+	return remainingMatches
+}
+
+// activateStop replaces the stop with its order, an immediate or cancel order is filled against its opposite book and what is left of it
+// refunded, it returns the number of matches left to attempt in the block
+func (block block) activateStop(context sdkTypes.Context, stop mappables.Stop, exchangeRate sdkTypes.Dec, remainingMatches int64) (int64, error) {
+	order := stop.GetOrder()
+	orders := block.mapper.NewCollection(context)
+
+	// an order made at the height of the stop holds the ID of its order
+	if orders.Fetch(order.GetKey()).Get(order.GetKey()) != nil {
+		return remainingMatches, block.refundStop(context, stop, events.OrderCancelled)
+	}
+
+	makerOwnableSplit, err := utilities.GetMakerOwnableSplit(context, block.supplementAuxiliary, order)
+	if err != nil {
+		return remainingMatches, err
+	}
+
+	timeInForce, err := utilities.GetTimeInForce(context, block.supplementAuxiliary, order)
+	if err != nil {
+		return remainingMatches, err
+	}
+
+	orders.Remove(stop)
+	orders.Add(order)
+
+	utilities.EmitStopTriggeredEvent(context, stop, exchangeRate)
+	utilities.EmitOrderMadeEvent(context, order, makerOwnableSplit)
+
+	if timeInForce.Compare(utilities.ImmediateOrCancel) != 0 {
+		return remainingMatches, nil
+	}
+
+	return block.fillImmediately(context, order, makerOwnableSplit, remainingMatches)
+}
+
+// fillImmediately fills the order against the best orders of its opposite book at their exchange rates for as long as they cross and matches
+// are left, and then refunds what is left of it, orders of books cleared by batch auctions are refunded whole, it returns the number of matches
+// left to attempt in the block
+func (block block) fillImmediately(context sdkTypes.Context, order mappables.Order, makerOwnableSplit sdkTypes.Dec, remainingMatches int64) (int64, error) {
+	auctionInterval, err := utilities.GetAuctionInterval(context, block.parameters, block.memberAuxiliary, block.supplementAuxiliary, order)
+	if err != nil {
+		return remainingMatches, err
+	}
+
+	if auctionInterval == 0 {
+		if order, makerOwnableSplit, remainingMatches, err = block.getMatcher().FillOrder(context, order, makerOwnableSplit, remainingMatches); err != nil || order == nil {
+			return remainingMatches, err
+		}
+	}
+
+	return remainingMatches, block.refundOrder(context, order, events.OrderCancelled)
+}
+
+// refundStop refunds the maker ownable split of the order of the stop to its maker and removes the stop, emitting the event type given
+func (block block) refundStop(context sdkTypes.Context, stop mappables.Stop, eventType string) error {
+	return block.refund(context, stop.GetOrder(), stop, eventType)
+}
@@ -0,0 +1,117 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package block
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func Test_activateStops(t *testing.T) {
+	context, keepers := createTestInput(t)
+	context = context.WithBlockHeight(10)
+	collection := keepers.block.mapper.NewCollection(context)
+
+	// the last exchange rate of the pair is one taker ownable for each maker ownable
+	collection.Add(mappable.NewLastPrice(baseIDs.NewID("maker"), baseIDs.NewID("taker"), sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec()), baseTypes.NewHeight(9)))
+
+	triggeredOrder := newTestMappableOrder(t, context, keepers, "a", "maker", "taker", sdkTypes.NewDec(2), 5, sdkTypes.NewDec(10), utilities.GoodTillCancelled)
+	untriggeredOrder := newTestMappableOrder(t, context, keepers, "b", "maker", "taker", sdkTypes.NewDec(2), 5, sdkTypes.NewDec(10), utilities.GoodTillCancelled)
+	unpricedOrder := newTestMappableOrder(t, context, keepers, "c", "other", "taker", sdkTypes.NewDec(2), 5, sdkTypes.NewDec(10), utilities.GoodTillCancelled)
+	immediateOrder := newTestMappableOrder(t, context, keepers, "d", "maker", "taker", sdkTypes.NewDec(2), 5, sdkTypes.NewDec(10), utilities.ImmediateOrCancel)
+
+	collection.Add(mappable.NewStop(triggeredOrder, sdkTypes.NewDecWithPrec(15, 1).QuoTruncate(sdkTypes.SmallestDec())))
+	collection.Add(mappable.NewStop(untriggeredOrder, sdkTypes.NewDecWithPrec(5, 1).QuoTruncate(sdkTypes.SmallestDec())))
+	collection.Add(mappable.NewStop(unpricedOrder, sdkTypes.NewDec(5).QuoTruncate(sdkTypes.SmallestDec())))
+	collection.Add(mappable.NewStop(immediateOrder, sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec())))
+
+	hasStop := func(orderID string) bool {
+		_, found := utilities.GetStop(context, keepers.block.mapper, baseIDs.NewID(orderID))
+		return found
+	}
+
+	isChecked := func(context sdkTypes.Context) bool {
+		lastPrice, found := utilities.GetLastPrice(context, keepers.block.mapper, baseIDs.NewID("maker"), baseIDs.NewID("taker"))
+		require.Equal(t, true, found)
+
+		return lastPrice.IsChecked()
+	}
+
+	hasOrder := func(orderID string) bool {
+		return keepers.block.mapper.NewCollection(context).Fetch(key.FromID(baseIDs.NewID(orderID))).Get(key.FromID(baseIDs.NewID(orderID))) != nil
+	}
+
+	t.Run("no matches left", func(t *testing.T) {
+		require.Equal(t, int64(0), keepers.block.activateStops(context, 0))
+		require.Equal(t, true, hasStop(triggeredOrder.GetID().String()))
+		require.Equal(t, true, hasStop(immediateOrder.GetID().String()))
+	})
+
+	t.Run("match limit", func(t *testing.T) {
+		cacheContext, _ := context.CacheContext()
+
+		// the highest trigger rate is activated first and a stop limit order resting unfilled is still charged a match
+		require.Equal(t, int64(0), keepers.block.activateStops(cacheContext, 1))
+		_, found := utilities.GetStop(cacheContext, keepers.block.mapper, triggeredOrder.GetID())
+		require.Equal(t, false, found)
+		_, found = utilities.GetStop(cacheContext, keepers.block.mapper, immediateOrder.GetID())
+		require.Equal(t, true, found)
+		require.Equal(t, false, isChecked(cacheContext))
+
+		// the stops left are activated in the next block
+		require.Equal(t, int64(9), keepers.block.activateStops(cacheContext, 10))
+		_, found = utilities.GetStop(cacheContext, keepers.block.mapper, immediateOrder.GetID())
+		require.Equal(t, false, found)
+		require.Equal(t, true, isChecked(cacheContext))
+	})
+
+	t.Run("trigger rate reached", func(t *testing.T) {
+		require.Equal(t, int64(8), keepers.block.activateStops(context, 10))
+		require.Equal(t, true, isChecked(context))
+
+		// a stop limit order rests in its book once triggered
+		require.Equal(t, false, hasStop(triggeredOrder.GetID().String()))
+		require.Equal(t, true, hasOrder(triggeredOrder.GetID().String()))
+		require.Equal(t, sdkTypes.ZeroDec(), getTestBalance(t, context, keepers, "a", "maker"))
+
+		// a stop order finding no opposite order is refunded
+		require.Equal(t, false, hasStop(immediateOrder.GetID().String()))
+		require.Equal(t, false, hasOrder(immediateOrder.GetID().String()))
+		require.Equal(t, sdkTypes.NewDec(10), getTestBalance(t, context, keepers, "d", "maker"))
+	})
+
+	t.Run("trigger rate not reached", func(t *testing.T) {
+		require.Equal(t, true, hasStop(untriggeredOrder.GetID().String()))
+		require.Equal(t, false, hasOrder(untriggeredOrder.GetID().String()))
+		require.Equal(t, sdkTypes.ZeroDec(), getTestBalance(t, context, keepers, "b", "maker"))
+	})
+
+	t.Run("checked last price", func(t *testing.T) {
+		laterOrder := newTestMappableOrder(t, context, keepers, "e", "maker", "taker", sdkTypes.NewDec(2), 6, sdkTypes.NewDec(10), utilities.GoodTillCancelled)
+		keepers.block.mapper.NewCollection(context).Add(mappable.NewStop(laterOrder, sdkTypes.NewDec(2).QuoTruncate(sdkTypes.SmallestDec())))
+
+		// pairs whose last price the stops were checked against are not visited
+		require.Equal(t, int64(10), keepers.block.activateStops(context, 10))
+		require.Equal(t, true, hasStop(laterOrder.GetID().String()))
+
+		// until a trade sets the last price again
+		keepers.block.mapper.NewCollection(context).Mutate(mappable.NewLastPrice(baseIDs.NewID("maker"), baseIDs.NewID("taker"), sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec()), baseTypes.NewHeight(10)))
+		require.Equal(t, int64(9), keepers.block.activateStops(context, 10))
+		require.Equal(t, false, hasStop(laterOrder.GetID().String()))
+		require.Equal(t, true, hasStop(untriggeredOrder.GetID().String()))
+	})
+
+	t.Run("no last price", func(t *testing.T) {
+		require.Equal(t, true, hasStop(unpricedOrder.GetID().String()))
+		require.Equal(t, false, hasOrder(unpricedOrder.GetID().String()))
+	})
+}
@@ -12,5 +12,5 @@ import (
 )
 
 func Prototype() helpers.Genesis {
-	return baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, []helpers.Mappable{}, parameters.Prototype().GetList())
+	return baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, []helpers.Mappable{}, parameters.Prototype().GetList(), key.ExpiryPrototype, key.TradePrototype, key.StopPrototype, key.LastPricePrototype)
 }
@@ -0,0 +1,122 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"fmt"
+	"sort"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/balance"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+// escrowInvariant checks that the maker splits of all open orders, and of stop orders not yet triggered, are held by the orders module identity
+type escrowInvariant struct {
+	mapper              helpers.Mapper
+	balanceAuxiliary    helpers.Auxiliary
+	supplementAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.Invariant = (*escrowInvariant)(nil)
+
+func (escrowInvariant) GetName() string {
+	return "escrow"
+}
+func (escrowInvariant escrowInvariant) Check(context sdkTypes.Context) (string, bool) {
+	var message string
+
+	count := 0
+	escrowedValues := make(map[string]sdkTypes.Dec)
+
+	accumulate := func(order mappables.Order) {
+		metaProperties, err := supplement.GetMetaPropertiesFromResponse(escrowInvariant.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(order.GetMakerOwnableSplit())))
+		if err != nil {
+			count++
+			message += fmt.Sprintf("\torder %s maker split not revealed: %s\n", order.GetID().String(), err)
+
+			return
+		}
+
+		makerOwnableSplitProperty := metaProperties.GetMetaProperty(constants.MakerOwnableSplitProperty)
+		if makerOwnableSplitProperty == nil {
+			count++
+			message += fmt.Sprintf("\torder %s maker split not revealed\n", order.GetID().String())
+
+			return
+		}
+
+		value, ok := escrowedValues[order.GetMakerOwnableID().String()]
+		if !ok {
+			value = sdkTypes.ZeroDec()
+		}
+
+		escrowedValues[order.GetMakerOwnableID().String()] = value.Add(makerOwnableSplitProperty.GetData().(data.DecData).Get())
+	}
+
+	escrowInvariant.mapper.NewCollection(context).Iterate(
+		key.FromID(baseIDs.NewID("")),
+		func(mappable helpers.Mappable) bool {
+			accumulate(mappable.(mappables.Order))
+			return false
+		},
+	)
+
+	escrowInvariant.mapper.NewCollection(context).Iterate(
+		key.StopPrototype(),
+		func(mappable helpers.Mappable) bool {
+			accumulate(mappable.(mappables.Stop).GetOrder())
+			return false
+		},
+	)
+
+	makerOwnableIDList := make([]string, 0, len(escrowedValues))
+	for makerOwnableID := range escrowedValues {
+		makerOwnableIDList = append(makerOwnableIDList, makerOwnableID)
+	}
+
+	sort.Strings(makerOwnableIDList)
+
+	for _, makerOwnableID := range makerOwnableIDList {
+		heldValue, err := balance.GetValueFromResponse(escrowInvariant.balanceAuxiliary.GetKeeper().Help(context, balance.NewAuxiliaryRequest(baseIDs.NewID(module.Name), baseIDs.NewID(makerOwnableID))))
+		if err != nil {
+			count++
+			message += fmt.Sprintf("\townable %s escrow not readable: %s\n", makerOwnableID, err)
+
+			continue
+		}
+
+		if heldValue.LT(escrowedValues[makerOwnableID]) {
+			count++
+			message += fmt.Sprintf("\townable %s orders total %s, escrowed %s\n", makerOwnableID, escrowedValues[makerOwnableID].String(), heldValue.String())
+		}
+	}
+
+	return fmt.Sprintf("found %d ownables with orders not covered by escrow\n%s", count, message), count != 0
+}
+func (escrowInvariant escrowInvariant) Initialize(mapper helpers.Mapper, _ helpers.Parameters, auxiliaries ...interface{}) helpers.Invariant {
+	escrowInvariant.mapper = mapper
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case balance.Auxiliary.GetName():
+				escrowInvariant.balanceAuxiliary = value
+			case supplement.Auxiliary.GetName():
+				escrowInvariant.supplementAuxiliary = value
+			}
+		}
+	}
+
+	return escrowInvariant
+}
@@ -0,0 +1,64 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/traits"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// expiryID keys the expiry of an order by its height first so that expiries are iterated in the order they fall due
+type expiryID struct {
+	Height  int64  `json:"height"`
+	OrderID ids.ID `json:"orderID"`
+}
+
+var _ ids.ID = (*expiryID)(nil)
+var _ helpers.Key = (*expiryID)(nil)
+
+// Bytes leaves out a height that is not positive so that the prototype prefixes the expiries at every height
+func (expiryID expiryID) Bytes() []byte {
+	var Bytes []byte
+
+	if expiryID.Height > 0 {
+		Bytes = append(Bytes, int64Bytes(expiryID.Height)...)
+	}
+
+	return append(Bytes, orderIDFromInterface(expiryID.OrderID).Bytes()...)
+}
+func (expiryID expiryID) String() string {
+	return strings.Join([]string{strconv.FormatInt(expiryID.Height, 10), expiryID.OrderID.String()}, constants.FirstOrderCompositeIDSeparator)
+}
+func (expiryID expiryID) Compare(listable traits.Listable) int {
+	return bytes.Compare(expiryID.Bytes(), expiryIDFromInterface(listable).Bytes())
+}
+func (expiryID expiryID) GenerateStoreKeyBytes() []byte {
+	return module.ExpiryStoreKeyPrefix.GenerateStoreKey(expiryID.Bytes())
+}
+func (expiryID) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, expiryID{})
+}
+func (expiryID expiryID) IsPartial() bool {
+	return orderIDFromInterface(expiryID.OrderID).IsPartial()
+}
+func (expiryID expiryID) Equals(key helpers.Key) bool {
+	return expiryID.Compare(expiryIDFromInterface(key)) == 0
+}
+
+func NewExpiryID(height int64, orderID ids.ID) ids.ID {
+	return expiryID{
+		Height:  height,
+		OrderID: orderID,
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/lists/base"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+)
+
+func Test_ExpiryID_Methods(t *testing.T) {
+	testOrderID := NewOrderID(baseIDs.NewID("classificationID"), baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), baseIDs.NewID(sdkTypes.OneDec().String()), baseIDs.NewID("100"), baseIDs.NewID("makerID"), base.NewPropertyList(baseProperties.NewProperty(baseIDs.NewID("ID1"), baseData.NewStringData("ImmutableData"))))
+
+	testExpiryID := NewExpiryID(10, testOrderID).(expiryID)
+	testExpiryID2 := NewExpiryID(9, testOrderID).(expiryID)
+	require.NotPanics(t, func() {
+		require.Equal(t, "10"+constants.FirstOrderCompositeIDSeparator+testOrderID.String(), testExpiryID.String())
+		require.Equal(t, true, testExpiryID.Equals(testExpiryID))
+		require.Equal(t, false, testExpiryID.Equals(testExpiryID2))
+		require.Equal(t, 1, testExpiryID.Compare(testExpiryID2))
+		require.Equal(t, false, testExpiryID.IsPartial())
+		require.Equal(t, true, ExpiryPrototype().IsPartial())
+		require.Equal(t, module.ExpiryStoreKeyPrefix.GenerateStoreKey(testExpiryID.Bytes()), testExpiryID.GenerateStoreKeyBytes())
+		require.Equal(t, testExpiryID, FromExpiryID(testExpiryID))
+		require.Equal(t, testExpiryID, FromExpiryID(baseIDs.NewID(testExpiryID.String())))
+		require.Equal(t, int64(10), ReadExpiryHeight(testExpiryID))
+		require.Equal(t, testOrderID, ReadExpiryOrderID(testExpiryID))
+	})
+}
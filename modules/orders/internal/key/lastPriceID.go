@@ -0,0 +1,60 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/traits"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// lastPriceID keys the last exchange rate at which the maker ownable was traded for the taker ownable, each direction of a pair having its own
+type lastPriceID struct {
+	MakerOwnableID ids.ID `json:"makerOwnableID"`
+	TakerOwnableID ids.ID `json:"takerOwnableID"`
+}
+
+var _ ids.ID = (*lastPriceID)(nil)
+var _ helpers.Key = (*lastPriceID)(nil)
+
+func (lastPriceID lastPriceID) Bytes() []byte {
+	var Bytes []byte
+	Bytes = append(Bytes, lastPriceID.MakerOwnableID.Bytes()...)
+	Bytes = append(Bytes, lastPriceID.TakerOwnableID.Bytes()...)
+
+	return Bytes
+}
+func (lastPriceID lastPriceID) String() string {
+	return strings.Join([]string{lastPriceID.MakerOwnableID.String(), lastPriceID.TakerOwnableID.String()}, constants.SecondOrderCompositeIDSeparator)
+}
+func (lastPriceID lastPriceID) Compare(listable traits.Listable) int {
+	return bytes.Compare(lastPriceID.Bytes(), lastPriceIDFromInterface(listable).Bytes())
+}
+func (lastPriceID lastPriceID) GenerateStoreKeyBytes() []byte {
+	return module.LastPriceStoreKeyPrefix.GenerateStoreKey(lastPriceID.Bytes())
+}
+func (lastPriceID) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, lastPriceID{})
+}
+func (lastPriceID lastPriceID) IsPartial() bool {
+	return len(lastPriceID.MakerOwnableID.Bytes()) == 0 || len(lastPriceID.TakerOwnableID.Bytes()) == 0
+}
+func (lastPriceID lastPriceID) Equals(key helpers.Key) bool {
+	return lastPriceID.Compare(lastPriceIDFromInterface(key)) == 0
+}
+
+func NewLastPriceID(makerOwnableID ids.ID, takerOwnableID ids.ID) ids.ID {
+	return lastPriceID{
+		MakerOwnableID: makerOwnableID,
+		TakerOwnableID: takerOwnableID,
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_LastPriceID_Methods(t *testing.T) {
+	testLastPriceID := NewLastPriceID(baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID")).(lastPriceID)
+	testLastPriceID2 := NewLastPriceID(baseIDs.NewID("takerOwnableID"), baseIDs.NewID("makerOwnableID")).(lastPriceID)
+	require.NotPanics(t, func() {
+		require.Equal(t, "makerOwnableID"+constants.SecondOrderCompositeIDSeparator+"takerOwnableID", testLastPriceID.String())
+		require.Equal(t, true, testLastPriceID.Equals(testLastPriceID))
+		require.Equal(t, false, testLastPriceID.Equals(testLastPriceID2))
+		require.Equal(t, -1, testLastPriceID.Compare(testLastPriceID2))
+		require.Equal(t, false, testLastPriceID.IsPartial())
+		require.Equal(t, true, LastPricePrototype().IsPartial())
+		require.Equal(t, module.LastPriceStoreKeyPrefix.GenerateStoreKey(testLastPriceID.Bytes()), testLastPriceID.GenerateStoreKeyBytes())
+		require.Equal(t, testLastPriceID, FromLastPriceID(testLastPriceID))
+		require.Equal(t, testLastPriceID, FromLastPriceID(baseIDs.NewID(testLastPriceID.String())))
+		require.Equal(t, baseIDs.NewID("makerOwnableID"), ReadLastPriceMakerOwnableID(testLastPriceID))
+		require.Equal(t, baseIDs.NewID("takerOwnableID"), ReadLastPriceTakerOwnableID(testLastPriceID))
+	})
+}
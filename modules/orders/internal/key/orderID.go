@@ -9,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
-	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants"
 	"github.com/AssetMantle/modules/modules/orders/internal/module"
@@ -75,8 +74,14 @@ func (orderID orderID) Compare(listable traits.Listable) int {
 func (orderID orderID) GenerateStoreKeyBytes() []byte {
 	return module.StoreKeyPrefix.GenerateStoreKey(orderID.Bytes())
 }
+
+// RegisterCodec registers every key of the orders store, as expiries, trades, stops and last prices are kept alongside orders
 func (orderID) RegisterCodec(codec *codec.Codec) {
 	codecUtilities.RegisterModuleConcrete(codec, orderID{})
+	expiryID{}.RegisterCodec(codec)
+	tradeID{}.RegisterCodec(codec)
+	stopID{}.RegisterCodec(codec)
+	lastPriceID{}.RegisterCodec(codec)
 }
 func (orderID orderID) IsPartial() bool {
 	return len(orderID.HashID.Bytes()) == 0
@@ -86,21 +91,11 @@ func (orderID orderID) Equals(key helpers.Key) bool {
 }
 
 func (orderID orderID) getRateIDBytes() ([]byte, error) {
-	var Bytes []byte
-
 	if orderID.RateID.String() == "" {
-		return Bytes, nil
-	}
-
-	exchangeRate, err := sdkTypes.NewDecFromStr(orderID.RateID.String())
-	if err != nil {
-		return Bytes, err
+		return nil, nil
 	}
 
-	Bytes = append(Bytes, uint8(len(strings.Split(exchangeRate.String(), ".")[0])))
-	Bytes = append(Bytes, []byte(exchangeRate.String())...)
-
-	return Bytes, err
+	return rateIDBytes(orderID.RateID)
 }
 
 func (orderID orderID) getCreationHeightBytes() ([]byte, error) {
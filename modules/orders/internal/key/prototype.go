@@ -11,3 +11,19 @@ import (
 func Prototype() helpers.Key {
 	return orderIDFromInterface(baseIDs.NewID(""))
 }
+
+func ExpiryPrototype() helpers.Key {
+	return expiryIDFromInterface(baseIDs.NewID(""))
+}
+
+func TradePrototype() helpers.Key {
+	return tradeIDFromInterface(baseIDs.NewID(""))
+}
+
+func StopPrototype() helpers.Key {
+	return stopIDFromInterface(baseIDs.NewID(""))
+}
+
+func LastPricePrototype() helpers.Key {
+	return lastPriceIDFromInterface(baseIDs.NewID(""))
+}
@@ -0,0 +1,91 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/traits"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// stopID keys a stop order by the ownable pair of the order it places once triggered, then by its trigger rate and then by the ID of that
+// order, so that the stops of a pair are iterated by trigger rate
+type stopID struct {
+	TriggerRateID ids.ID `json:"triggerRateID"`
+	OrderID       ids.ID `json:"orderID"`
+}
+
+var _ ids.ID = (*stopID)(nil)
+var _ helpers.Key = (*stopID)(nil)
+
+// Bytes length prefixes the ownables of the pair so that the stops of a pair never prefix those of another, and leaves out a pair or a
+// trigger rate that is not set so that partial stop IDs prefix the stops of a pair or of every pair
+func (stopID stopID) Bytes() []byte {
+	var Bytes []byte
+
+	orderID := orderIDFromInterface(stopID.OrderID)
+	if len(orderID.MakerOwnableID.Bytes()) == 0 {
+		return Bytes
+	}
+
+	Bytes = append(Bytes, lengthPrefixedBytes(orderID.MakerOwnableID)...)
+	Bytes = append(Bytes, lengthPrefixedBytes(orderID.TakerOwnableID)...)
+
+	if stopID.TriggerRateID.String() == "" {
+		return Bytes
+	}
+
+	triggerRateBytes, err := rateIDBytes(stopID.TriggerRateID)
+	if err != nil {
+		return nil
+	}
+
+	Bytes = append(Bytes, triggerRateBytes...)
+	Bytes = append(Bytes, orderID.Bytes()...)
+
+	return Bytes
+}
+func (stopID stopID) String() string {
+	return strings.Join([]string{stopID.TriggerRateID.String(), stopID.OrderID.String()}, constants.FirstOrderCompositeIDSeparator)
+}
+func (stopID stopID) Compare(listable traits.Listable) int {
+	return bytes.Compare(stopID.Bytes(), stopIDFromInterface(listable).Bytes())
+}
+func (stopID stopID) GenerateStoreKeyBytes() []byte {
+	return module.StopStoreKeyPrefix.GenerateStoreKey(stopID.Bytes())
+}
+func (stopID) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, stopID{})
+}
+func (stopID stopID) IsPartial() bool {
+	return stopID.TriggerRateID.String() == "" || orderIDFromInterface(stopID.OrderID).IsPartial()
+}
+func (stopID stopID) Equals(key helpers.Key) bool {
+	return stopID.Compare(stopIDFromInterface(key)) == 0
+}
+
+// NewStopID creates the ID of the stop placing the order once the last exchange rate of its pair falls to the trigger rate
+func NewStopID(orderID ids.ID, triggerRateID ids.ID) ids.ID {
+	return stopID{
+		TriggerRateID: triggerRateID,
+		OrderID:       orderID,
+	}
+}
+
+// NewStopPairID creates the partial ID of the stops of orders offering the maker ownable for the taker ownable
+func NewStopPairID(makerOwnableID ids.ID, takerOwnableID ids.ID) ids.ID {
+	return stopID{
+		TriggerRateID: baseIDs.NewID(""),
+		OrderID:       orderID{ClassificationID: baseIDs.NewID(""), MakerOwnableID: makerOwnableID, TakerOwnableID: takerOwnableID, RateID: baseIDs.NewID(""), CreationID: baseIDs.NewID(""), MakerID: baseIDs.NewID(""), HashID: baseIDs.NewID("")},
+	}
+}
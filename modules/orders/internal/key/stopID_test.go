@@ -0,0 +1,51 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/lists/base"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+)
+
+func Test_StopID_Methods(t *testing.T) {
+	testOrderID := NewOrderID(baseIDs.NewID("classificationID"), baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), baseIDs.NewID(sdkTypes.OneDec().String()), baseIDs.NewID("100"), baseIDs.NewID("makerID"), base.NewPropertyList(baseProperties.NewProperty(baseIDs.NewID("ID1"), baseData.NewStringData("ImmutableData"))))
+	testOrderID2 := NewOrderID(baseIDs.NewID("classificationID"), baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), baseIDs.NewID(sdkTypes.OneDec().String()), baseIDs.NewID("99"), baseIDs.NewID("makerID"), base.NewPropertyList(baseProperties.NewProperty(baseIDs.NewID("ID1"), baseData.NewStringData("ImmutableData"))))
+
+	triggerRateID := baseIDs.NewID(sdkTypes.NewDec(2).String())
+
+	testStopID := NewStopID(testOrderID, triggerRateID).(stopID)
+	testStopID2 := NewStopID(testOrderID2, triggerRateID).(stopID)
+	require.NotPanics(t, func() {
+		require.Equal(t, triggerRateID.String()+"|"+testOrderID.String(), testStopID.String())
+		require.Equal(t, true, testStopID.Equals(testStopID))
+		require.Equal(t, false, testStopID.Equals(testStopID2))
+		require.Equal(t, testOrderID.Compare(testOrderID2), testStopID.Compare(testStopID2))
+		require.Equal(t, false, testStopID.IsPartial())
+		require.Equal(t, true, StopPrototype().IsPartial())
+		require.Equal(t, 0, len(StopPrototype().(stopID).Bytes()))
+		require.Equal(t, module.StopStoreKeyPrefix.GenerateStoreKey(testStopID.Bytes()), testStopID.GenerateStoreKeyBytes())
+		require.NotEqual(t, testOrderID.(orderID).GenerateStoreKeyBytes(), testStopID.GenerateStoreKeyBytes())
+		require.Equal(t, testStopID, FromStopID(testStopID))
+		require.Equal(t, testStopID, FromStopID(baseIDs.NewID(testStopID.String())))
+		require.Equal(t, testOrderID, ReadStopOrderID(testStopID))
+		require.Equal(t, triggerRateID, ReadStopTriggerRateID(testStopID))
+	})
+
+	// stops of a pair are prefixed by its partial ID and sorted by trigger rate, those of another pair whose ownable IDs concatenate to the
+	// same bytes are not
+	pairID := NewStopPairID(baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID")).(stopID)
+	require.Equal(t, true, pairID.IsPartial())
+	require.Equal(t, true, bytes.HasPrefix(testStopID.Bytes(), pairID.Bytes()))
+	require.Equal(t, false, bytes.HasPrefix(testStopID.Bytes(), NewStopPairID(baseIDs.NewID("makerOwnableIDtaker"), baseIDs.NewID("OwnableID")).Bytes()))
+	require.Equal(t, -1, NewStopID(testOrderID, baseIDs.NewID(sdkTypes.NewDec(9).String())).Compare(NewStopID(testOrderID2, baseIDs.NewID(sdkTypes.NewDec(10).String()))))
+}
@@ -0,0 +1,70 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/traits"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// tradeID keys the record of a fill by its height and its sequence among the fills of that height so that trades are iterated in the order
+// they were made
+type tradeID struct {
+	Height   int64 `json:"height"`
+	Sequence int64 `json:"sequence"`
+}
+
+var _ ids.ID = (*tradeID)(nil)
+var _ helpers.Key = (*tradeID)(nil)
+
+// Bytes leaves out a height or a sequence that is not positive so that partial trade IDs prefix the trades of a height or of every height
+func (tradeID tradeID) Bytes() []byte {
+	var Bytes []byte
+
+	if tradeID.Height > 0 {
+		Bytes = append(Bytes, int64Bytes(tradeID.Height)...)
+
+		if tradeID.Sequence > 0 {
+			Bytes = append(Bytes, int64Bytes(tradeID.Sequence)...)
+		}
+	}
+
+	return Bytes
+}
+func (tradeID tradeID) String() string {
+	return strings.Join([]string{strconv.FormatInt(tradeID.Height, 10), strconv.FormatInt(tradeID.Sequence, 10)}, constants.FirstOrderCompositeIDSeparator)
+}
+func (tradeID tradeID) Compare(listable traits.Listable) int {
+	return bytes.Compare(tradeID.Bytes(), tradeIDFromInterface(listable).Bytes())
+}
+func (tradeID tradeID) GenerateStoreKeyBytes() []byte {
+	return module.TradeStoreKeyPrefix.GenerateStoreKey(tradeID.Bytes())
+}
+func (tradeID) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, tradeID{})
+}
+func (tradeID tradeID) IsPartial() bool {
+	return tradeID.Height <= 0 || tradeID.Sequence <= 0
+}
+func (tradeID tradeID) Equals(key helpers.Key) bool {
+	return tradeID.Compare(tradeIDFromInterface(key)) == 0
+}
+
+// NewTradeID creates the ID of the trade made at the height with the sequence, a sequence of zero identifies all the trades of the height
+func NewTradeID(height int64, sequence int64) ids.ID {
+	return tradeID{
+		Height:   height,
+		Sequence: sequence,
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_TradeID_Methods(t *testing.T) {
+	testTradeID := NewTradeID(10, 2).(tradeID)
+	testTradeID2 := NewTradeID(9, 3).(tradeID)
+	require.NotPanics(t, func() {
+		require.Equal(t, "10"+constants.FirstOrderCompositeIDSeparator+"2", testTradeID.String())
+		require.Equal(t, true, testTradeID.Equals(testTradeID))
+		require.Equal(t, false, testTradeID.Equals(testTradeID2))
+		require.Equal(t, 1, testTradeID.Compare(testTradeID2))
+		require.Equal(t, false, testTradeID.IsPartial())
+		require.Equal(t, true, NewTradeID(10, 0).(tradeID).IsPartial())
+		require.Equal(t, true, TradePrototype().IsPartial())
+		require.Equal(t, 8, len(NewTradeID(10, 0).Bytes()))
+		require.Equal(t, 0, len(TradePrototype().(tradeID).Bytes()))
+		require.Equal(t, module.TradeStoreKeyPrefix.GenerateStoreKey(testTradeID.Bytes()), testTradeID.GenerateStoreKeyBytes())
+		require.Equal(t, testTradeID, FromTradeID(testTradeID))
+		require.Equal(t, testTradeID, FromTradeID(baseIDs.NewID(testTradeID.String())))
+		require.Equal(t, int64(10), ReadTradeHeight(testTradeID))
+		require.Equal(t, int64(2), ReadTradeSequence(testTradeID))
+	})
+}
@@ -4,6 +4,7 @@
 package key
 
 import (
+	"encoding/binary"
 	"strconv"
 	"strings"
 
@@ -19,22 +20,34 @@ func readOrderID(orderIDString string) ids.ID {
 	idList := strings.Split(orderIDString, constants.SecondOrderCompositeIDSeparator)
 
 	if len(idList) == 7 {
-		exchangeRate, err := sdkTypes.NewDecFromStr(idList[3])
-		if err != nil {
-			return orderID{ClassificationID: baseIDs.NewID(""), MakerOwnableID: baseIDs.NewID(""), TakerOwnableID: baseIDs.NewID(""), RateID: baseIDs.NewID(""), CreationID: baseIDs.NewID(""), MakerID: baseIDs.NewID(""), HashID: baseIDs.NewID("")}
+		rateID := baseIDs.NewID("")
+
+		if idList[3] != "" {
+			exchangeRate, err := sdkTypes.NewDecFromStr(idList[3])
+			if err != nil {
+				return orderID{ClassificationID: baseIDs.NewID(""), MakerOwnableID: baseIDs.NewID(""), TakerOwnableID: baseIDs.NewID(""), RateID: baseIDs.NewID(""), CreationID: baseIDs.NewID(""), MakerID: baseIDs.NewID(""), HashID: baseIDs.NewID("")}
+			}
+
+			rateID = baseIDs.NewID(exchangeRate.String())
 		}
 
-		height, err := strconv.ParseInt(idList[4], 10, 64)
-		if err != nil {
-			return orderID{ClassificationID: baseIDs.NewID(""), MakerOwnableID: baseIDs.NewID(""), TakerOwnableID: baseIDs.NewID(""), RateID: baseIDs.NewID(""), CreationID: baseIDs.NewID(""), MakerID: baseIDs.NewID(""), HashID: baseIDs.NewID("")}
+		creationID := baseIDs.NewID("")
+
+		if idList[4] != "" {
+			height, err := strconv.ParseInt(idList[4], 10, 64)
+			if err != nil {
+				return orderID{ClassificationID: baseIDs.NewID(""), MakerOwnableID: baseIDs.NewID(""), TakerOwnableID: baseIDs.NewID(""), RateID: baseIDs.NewID(""), CreationID: baseIDs.NewID(""), MakerID: baseIDs.NewID(""), HashID: baseIDs.NewID("")}
+			}
+
+			creationID = baseIDs.NewID(strconv.FormatInt(height, 10))
 		}
 
 		return orderID{
 			ClassificationID: baseIDs.NewID(idList[0]),
 			MakerOwnableID:   baseIDs.NewID(idList[1]),
 			TakerOwnableID:   baseIDs.NewID(idList[2]),
-			RateID:           baseIDs.NewID(exchangeRate.String()),
-			CreationID:       baseIDs.NewID(strconv.FormatInt(height, 10)),
+			RateID:           rateID,
+			CreationID:       creationID,
 			MakerID:          baseIDs.NewID(idList[5]),
 			HashID:           baseIDs.NewID(idList[6]),
 		}
@@ -80,3 +93,168 @@ func ReadMakerID(orderID ids.ID) ids.ID {
 func FromID(id ids.ID) helpers.Key {
 	return orderIDFromInterface(id)
 }
+
+func readExpiryID(expiryIDString string) ids.ID {
+	if idList := strings.SplitN(expiryIDString, constants.FirstOrderCompositeIDSeparator, 2); len(idList) == 2 {
+		if height, err := strconv.ParseInt(idList[0], 10, 64); err == nil {
+			return expiryID{Height: height, OrderID: readOrderID(idList[1])}
+		}
+	}
+
+	return expiryID{Height: 0, OrderID: readOrderID("")}
+}
+func expiryIDFromInterface(i interface{}) expiryID {
+	switch value := i.(type) {
+	case expiryID:
+		return value
+	case ids.ID:
+		return expiryIDFromInterface(readExpiryID(value.String()))
+	default:
+		panic(i)
+	}
+}
+
+func ReadExpiryHeight(expiryID ids.ID) int64 {
+	return expiryIDFromInterface(expiryID).Height
+}
+
+func ReadExpiryOrderID(expiryID ids.ID) ids.ID {
+	return expiryIDFromInterface(expiryID).OrderID
+}
+
+func FromExpiryID(id ids.ID) helpers.Key {
+	return expiryIDFromInterface(id)
+}
+
+func readTradeID(tradeIDString string) ids.ID {
+	if idList := strings.Split(tradeIDString, constants.FirstOrderCompositeIDSeparator); len(idList) == 2 {
+		height, heightErr := strconv.ParseInt(idList[0], 10, 64)
+		sequence, sequenceErr := strconv.ParseInt(idList[1], 10, 64)
+
+		if heightErr == nil && sequenceErr == nil {
+			return tradeID{Height: height, Sequence: sequence}
+		}
+	}
+
+	return tradeID{Height: 0, Sequence: 0}
+}
+func tradeIDFromInterface(i interface{}) tradeID {
+	switch value := i.(type) {
+	case tradeID:
+		return value
+	case ids.ID:
+		return tradeIDFromInterface(readTradeID(value.String()))
+	default:
+		panic(i)
+	}
+}
+
+func ReadTradeHeight(tradeID ids.ID) int64 {
+	return tradeIDFromInterface(tradeID).Height
+}
+
+func ReadTradeSequence(tradeID ids.ID) int64 {
+	return tradeIDFromInterface(tradeID).Sequence
+}
+
+func FromTradeID(id ids.ID) helpers.Key {
+	return tradeIDFromInterface(id)
+}
+
+func readStopID(stopIDString string) ids.ID {
+	// trigger rates hold no separator so the order ID is all that follows the first one
+	if idList := strings.SplitN(stopIDString, constants.FirstOrderCompositeIDSeparator, 2); len(idList) == 2 {
+		if idList[0] == "" {
+			return stopID{TriggerRateID: baseIDs.NewID(""), OrderID: readOrderID(idList[1])}
+		}
+
+		if triggerRate, err := sdkTypes.NewDecFromStr(idList[0]); err == nil {
+			return stopID{TriggerRateID: baseIDs.NewID(triggerRate.String()), OrderID: readOrderID(idList[1])}
+		}
+	}
+
+	return stopID{TriggerRateID: baseIDs.NewID(""), OrderID: readOrderID("")}
+}
+func stopIDFromInterface(i interface{}) stopID {
+	switch value := i.(type) {
+	case stopID:
+		return value
+	case ids.ID:
+		return stopIDFromInterface(readStopID(value.String()))
+	default:
+		panic(i)
+	}
+}
+
+func ReadStopOrderID(stopID ids.ID) ids.ID {
+	return stopIDFromInterface(stopID).OrderID
+}
+
+func ReadStopTriggerRateID(stopID ids.ID) ids.ID {
+	return stopIDFromInterface(stopID).TriggerRateID
+}
+
+func FromStopID(id ids.ID) helpers.Key {
+	return stopIDFromInterface(id)
+}
+
+func readLastPriceID(lastPriceIDString string) ids.ID {
+	if idList := strings.Split(lastPriceIDString, constants.SecondOrderCompositeIDSeparator); len(idList) == 2 {
+		return lastPriceID{MakerOwnableID: baseIDs.NewID(idList[0]), TakerOwnableID: baseIDs.NewID(idList[1])}
+	}
+
+	return lastPriceID{MakerOwnableID: baseIDs.NewID(""), TakerOwnableID: baseIDs.NewID("")}
+}
+func lastPriceIDFromInterface(i interface{}) lastPriceID {
+	switch value := i.(type) {
+	case lastPriceID:
+		return value
+	case ids.ID:
+		return lastPriceIDFromInterface(readLastPriceID(value.String()))
+	default:
+		panic(i)
+	}
+}
+
+func ReadLastPriceMakerOwnableID(lastPriceID ids.ID) ids.ID {
+	return lastPriceIDFromInterface(lastPriceID).MakerOwnableID
+}
+
+func ReadLastPriceTakerOwnableID(lastPriceID ids.ID) ids.ID {
+	return lastPriceIDFromInterface(lastPriceID).TakerOwnableID
+}
+
+func FromLastPriceID(id ids.ID) helpers.Key {
+	return lastPriceIDFromInterface(id)
+}
+
+// int64Bytes encodes the value in big endian so that keys holding it sort in its numerical order
+func int64Bytes(value int64) []byte {
+	Bytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(Bytes, uint64(value))
+
+	return Bytes
+}
+
+// lengthPrefixedBytes prefixes the bytes of the ID with their length so that keys holding it never prefix keys holding another ID
+func lengthPrefixedBytes(id ids.ID) []byte {
+	Bytes := make([]byte, 2)
+	binary.BigEndian.PutUint16(Bytes, uint16(len(id.Bytes())))
+
+	return append(Bytes, id.Bytes()...)
+}
+
+// rateIDBytes prefixes the exchange rate of the ID with the length of its integer part so that keys holding it sort in its numerical order
+func rateIDBytes(rateID ids.ID) ([]byte, error) {
+	var Bytes []byte
+
+	exchangeRate, err := sdkTypes.NewDecFromStr(rateID.String())
+	if err != nil {
+		return Bytes, err
+	}
+
+	Bytes = append(Bytes, uint8(len(strings.Split(exchangeRate.String(), ".")[0])))
+	Bytes = append(Bytes, []byte(exchangeRate.String())...)
+
+	return Bytes, nil
+}
@@ -0,0 +1,42 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/types"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+type expiry struct {
+	ID ids.ID `json:"id" valid:"required~required field id missing"`
+}
+
+var _ mappables.Expiry = (*expiry)(nil)
+
+func (expiry expiry) GetHeight() types.Height {
+	return baseTypes.NewHeight(key.ReadExpiryHeight(expiry.ID))
+}
+func (expiry expiry) GetOrderID() ids.ID {
+	return key.ReadExpiryOrderID(expiry.ID)
+}
+func (expiry expiry) GetKey() helpers.Key {
+	return key.FromExpiryID(expiry.ID)
+}
+func (expiry) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, expiry{})
+}
+
+// NewExpiry records that the order falls due at the height
+func NewExpiry(height types.Height, orderID ids.ID) mappables.Expiry {
+	return expiry{
+		ID: key.NewExpiryID(height.Get(), orderID),
+	}
+}
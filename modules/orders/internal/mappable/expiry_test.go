@@ -0,0 +1,29 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func Test_Expiry_Methods(t *testing.T) {
+	orderID := key.NewOrderID(baseIDs.NewID("classificationID"), baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), baseIDs.NewID(sdkTypes.OneDec().String()), baseIDs.NewID("100"), baseIDs.NewID("makerID"), baseLists.NewPropertyList(baseProperties.NewProperty(baseIDs.NewID("ID1"), baseData.NewStringData("ImmutableData"))))
+
+	testExpiry := NewExpiry(baseTypes.NewHeight(10), orderID).(expiry)
+
+	require.Equal(t, expiry{ID: key.NewExpiryID(10, orderID)}, testExpiry)
+	require.Equal(t, baseTypes.NewHeight(10), testExpiry.GetHeight())
+	require.Equal(t, orderID, testExpiry.GetOrderID())
+	require.Equal(t, key.NewExpiryID(10, orderID), testExpiry.GetKey())
+}
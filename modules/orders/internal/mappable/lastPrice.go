@@ -0,0 +1,70 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/types"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// lastPrice records the exchange rate of the latest trade of the maker ownable for the taker ownable, and whether the stops of the pair have
+// all been checked against it
+type lastPrice struct {
+	ID           ids.ID       `json:"id" valid:"required~required field id missing"`
+	ExchangeRate sdkTypes.Dec `json:"exchangeRate" valid:"required~required field exchangeRate missing"`
+	Height       int64        `json:"height" valid:"required~required field height missing"`
+	Checked      bool         `json:"checked"`
+}
+
+var _ mappables.LastPrice = (*lastPrice)(nil)
+
+func (lastPrice lastPrice) GetMakerOwnableID() ids.ID {
+	return key.ReadLastPriceMakerOwnableID(lastPrice.ID)
+}
+func (lastPrice lastPrice) GetTakerOwnableID() ids.ID {
+	return key.ReadLastPriceTakerOwnableID(lastPrice.ID)
+}
+func (lastPrice lastPrice) GetExchangeRate() sdkTypes.Dec {
+	return lastPrice.ExchangeRate
+}
+func (lastPrice lastPrice) GetHeight() types.Height {
+	return baseTypes.NewHeight(lastPrice.Height)
+}
+func (lastPrice lastPrice) IsChecked() bool {
+	return lastPrice.Checked
+}
+func (lastPrice lastPrice) GetKey() helpers.Key {
+	return key.FromLastPriceID(lastPrice.ID)
+}
+func (lastPrice) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, lastPrice{})
+}
+
+// NewLastPrice records that the maker ownable was last traded for the taker ownable at the exchange rate, scaled up by the inverse of the
+// smallest decimal as exchange rates are, at the height, the stops of the pair are yet to be checked against it
+func NewLastPrice(makerOwnableID ids.ID, takerOwnableID ids.ID, exchangeRate sdkTypes.Dec, height types.Height) mappables.LastPrice {
+	return lastPrice{
+		ID:           key.NewLastPriceID(makerOwnableID, takerOwnableID),
+		ExchangeRate: exchangeRate,
+		Height:       height.Get(),
+	}
+}
+
+// NewCheckedLastPrice records the last price once every stop of the pair it triggers has been activated
+func NewCheckedLastPrice(makerOwnableID ids.ID, takerOwnableID ids.ID, exchangeRate sdkTypes.Dec, height types.Height) mappables.LastPrice {
+	return lastPrice{
+		ID:           key.NewLastPriceID(makerOwnableID, takerOwnableID),
+		ExchangeRate: exchangeRate,
+		Height:       height.Get(),
+		Checked:      true,
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func Test_LastPrice_Methods(t *testing.T) {
+	exchangeRate := sdkTypes.NewDec(2).QuoTruncate(sdkTypes.SmallestDec())
+
+	testLastPrice := NewLastPrice(baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), exchangeRate, baseTypes.NewHeight(10)).(lastPrice)
+
+	require.Equal(t, lastPrice{ID: key.NewLastPriceID(baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID")), ExchangeRate: exchangeRate, Height: 10}, testLastPrice)
+	require.Equal(t, baseIDs.NewID("makerOwnableID"), testLastPrice.GetMakerOwnableID())
+	require.Equal(t, baseIDs.NewID("takerOwnableID"), testLastPrice.GetTakerOwnableID())
+	require.Equal(t, exchangeRate, testLastPrice.GetExchangeRate())
+	require.Equal(t, baseTypes.NewHeight(10), testLastPrice.GetHeight())
+	require.Equal(t, false, testLastPrice.IsChecked())
+	require.Equal(t, key.NewLastPriceID(baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID")), testLastPrice.GetKey())
+
+	checkedLastPrice := NewCheckedLastPrice(baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), exchangeRate, baseTypes.NewHeight(10))
+	require.Equal(t, true, checkedLastPrice.IsChecked())
+	require.Equal(t, testLastPrice.GetKey(), checkedLastPrice.GetKey())
+}
@@ -92,8 +92,14 @@ func (order order) GetMakerOwnableSplit() properties2.Property {
 func (order order) GetKey() helpers.Key {
 	return key.FromID(order.ID)
 }
+
+// RegisterCodec registers every mappable of the orders store, as expiries, trades, stops and last prices are kept alongside orders
 func (order) RegisterCodec(codec *codec.Codec) {
 	codecUtilities.RegisterModuleConcrete(codec, order{})
+	expiry{}.RegisterCodec(codec)
+	trade{}.RegisterCodec(codec)
+	stop{}.RegisterCodec(codec)
+	lastPrice{}.RegisterCodec(codec)
 }
 
 func NewOrder(orderID ids2.ID, immutableProperties lists.PropertyList, mutableProperties lists.PropertyList) mappables.Order {
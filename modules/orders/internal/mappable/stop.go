@@ -0,0 +1,50 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseQualified "github.com/AssetMantle/modules/schema/qualified/base"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// stop holds an order that is kept out of its book until the last exchange rate of its ownable pair falls to the trigger rate
+type stop struct {
+	baseQualified.Document              //nolint:govet
+	TriggerRate            sdkTypes.Dec `json:"triggerRate" valid:"required~required field triggerRate missing"`
+}
+
+var _ mappables.Stop = (*stop)(nil)
+
+func (stop stop) GetOrder() mappables.Order {
+	return order{Document: stop.Document}
+}
+func (stop stop) GetTriggerRate() sdkTypes.Dec {
+	return stop.TriggerRate
+}
+func (stop stop) GetKey() helpers.Key {
+	return key.FromStopID(key.NewStopID(stop.ID, baseIDs.NewID(stop.TriggerRate.String())))
+}
+func (stop) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, stop{})
+}
+
+// NewStop keeps the order inactive until the last exchange rate at which its maker ownable was traded for its taker ownable falls to the
+// trigger rate, scaled up by the inverse of the smallest decimal as exchange rates are
+func NewStop(order mappables.Order, triggerRate sdkTypes.Dec) mappables.Stop {
+	return stop{
+		Document: baseQualified.Document{
+			ID:         order.GetID(),
+			Immutables: baseQualified.Immutables{PropertyList: order.GetImmutablePropertyList()},
+			Mutables:   baseQualified.Mutables{PropertyList: order.GetMutablePropertyList()},
+		},
+		TriggerRate: triggerRate,
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+)
+
+func Test_Stop_Methods(t *testing.T) {
+	orderID := key.NewOrderID(baseIDs.NewID("classificationID"), baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), baseIDs.NewID(sdkTypes.OneDec().String()), baseIDs.NewID("100"), baseIDs.NewID("makerID"), baseLists.NewPropertyList(baseProperties.NewProperty(baseIDs.NewID("ID1"), baseData.NewStringData("ImmutableData"))))
+	order := NewOrder(orderID, baseLists.NewPropertyList(baseProperties.NewProperty(baseIDs.NewID("ID1"), baseData.NewStringData("ImmutableData"))), baseLists.NewPropertyList(baseProperties.NewProperty(baseIDs.NewID("ID2"), baseData.NewStringData("MutableData"))))
+	triggerRate := sdkTypes.NewDecWithPrec(5, 1).QuoTruncate(sdkTypes.SmallestDec())
+
+	testStop := NewStop(order, triggerRate).(stop)
+
+	require.Equal(t, order, testStop.GetOrder())
+	require.Equal(t, triggerRate, testStop.GetTriggerRate())
+	require.Equal(t, key.NewStopID(orderID, baseIDs.NewID(triggerRate.String())), testStop.GetKey())
+	require.NotEqual(t, order.GetKey().GenerateStoreKeyBytes(), testStop.GetKey().GenerateStoreKeyBytes())
+}
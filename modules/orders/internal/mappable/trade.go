@@ -0,0 +1,87 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/types"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// trade records a fill of an order, in which its maker gave the maker ownable split to the taker and received the taker ownable split
+type trade struct {
+	ID                ids.ID       `json:"id" valid:"required~required field id missing"`
+	OrderID           ids.ID       `json:"orderID" valid:"required~required field orderID missing"`
+	MakerID           ids.ID       `json:"makerID" valid:"required~required field makerID missing"`
+	TakerID           ids.ID       `json:"takerID" valid:"required~required field takerID missing"`
+	MakerOwnableID    ids.ID       `json:"makerOwnableID" valid:"required~required field makerOwnableID missing"`
+	TakerOwnableID    ids.ID       `json:"takerOwnableID" valid:"required~required field takerOwnableID missing"`
+	MakerOwnableSplit sdkTypes.Dec `json:"makerOwnableSplit" valid:"required~required field makerOwnableSplit missing"`
+	TakerOwnableSplit sdkTypes.Dec `json:"takerOwnableSplit" valid:"required~required field takerOwnableSplit missing"`
+}
+
+var _ mappables.Trade = (*trade)(nil)
+
+func (trade trade) GetHeight() types.Height {
+	return baseTypes.NewHeight(key.ReadTradeHeight(trade.ID))
+}
+func (trade trade) GetOrderID() ids.ID {
+	return trade.OrderID
+}
+func (trade trade) GetMakerID() ids.ID {
+	return trade.MakerID
+}
+func (trade trade) GetTakerID() ids.ID {
+	return trade.TakerID
+}
+func (trade trade) GetMakerOwnableID() ids.ID {
+	return trade.MakerOwnableID
+}
+func (trade trade) GetTakerOwnableID() ids.ID {
+	return trade.TakerOwnableID
+}
+func (trade trade) GetMakerOwnableSplit() sdkTypes.Dec {
+	return trade.MakerOwnableSplit
+}
+func (trade trade) GetTakerOwnableSplit() sdkTypes.Dec {
+	return trade.TakerOwnableSplit
+}
+
+// GetExchangeRate returns the taker ownable split received per maker ownable split given scaled up by the inverse of the smallest decimal,
+// as the exchange rates of orders are
+func (trade trade) GetExchangeRate() sdkTypes.Dec {
+	if !trade.MakerOwnableSplit.IsPositive() {
+		return sdkTypes.ZeroDec()
+	}
+
+	return trade.TakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(trade.MakerOwnableSplit)
+}
+func (trade trade) GetKey() helpers.Key {
+	return key.FromTradeID(trade.ID)
+}
+func (trade) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, trade{})
+}
+
+// NewTrade records the fill of the order made with the trade ID, in which its maker gave the maker ownable split to the taker and received
+// the taker ownable split
+func NewTrade(tradeID ids.ID, order mappables.Order, takerID ids.ID, makerOwnableSplit sdkTypes.Dec, takerOwnableSplit sdkTypes.Dec) mappables.Trade {
+	return trade{
+		ID:                tradeID,
+		OrderID:           order.GetID(),
+		MakerID:           order.GetMakerID(),
+		TakerID:           takerID,
+		MakerOwnableID:    order.GetMakerOwnableID(),
+		TakerOwnableID:    order.GetTakerOwnableID(),
+		MakerOwnableSplit: makerOwnableSplit,
+		TakerOwnableSplit: takerOwnableSplit,
+	}
+}
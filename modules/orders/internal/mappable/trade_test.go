@@ -0,0 +1,37 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func Test_Trade_Methods(t *testing.T) {
+	orderID := key.NewOrderID(baseIDs.NewID("classificationID"), baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), baseIDs.NewID(sdkTypes.OneDec().String()), baseIDs.NewID("100"), baseIDs.NewID("makerID"), baseLists.NewPropertyList())
+	order := NewOrder(orderID, baseLists.NewPropertyList(), baseLists.NewPropertyList())
+	tradeID := key.NewTradeID(10, 1)
+
+	testTrade := NewTrade(tradeID, order, baseIDs.NewID("takerID"), sdkTypes.NewDec(4), sdkTypes.NewDec(2)).(trade)
+
+	require.Equal(t, trade{ID: tradeID, OrderID: orderID, MakerID: baseIDs.NewID("makerID"), TakerID: baseIDs.NewID("takerID"), MakerOwnableID: baseIDs.NewID("makerOwnableID"), TakerOwnableID: baseIDs.NewID("takerOwnableID"), MakerOwnableSplit: sdkTypes.NewDec(4), TakerOwnableSplit: sdkTypes.NewDec(2)}, testTrade)
+	require.Equal(t, baseTypes.NewHeight(10), testTrade.GetHeight())
+	require.Equal(t, orderID, testTrade.GetOrderID())
+	require.Equal(t, baseIDs.NewID("makerID"), testTrade.GetMakerID())
+	require.Equal(t, baseIDs.NewID("takerID"), testTrade.GetTakerID())
+	require.Equal(t, baseIDs.NewID("makerOwnableID"), testTrade.GetMakerOwnableID())
+	require.Equal(t, baseIDs.NewID("takerOwnableID"), testTrade.GetTakerOwnableID())
+	require.Equal(t, sdkTypes.NewDec(4), testTrade.GetMakerOwnableSplit())
+	require.Equal(t, sdkTypes.NewDec(2), testTrade.GetTakerOwnableSplit())
+	require.Equal(t, sdkTypes.NewDecWithPrec(5, 1).QuoTruncate(sdkTypes.SmallestDec()), testTrade.GetExchangeRate())
+	require.Equal(t, sdkTypes.ZeroDec(), NewTrade(tradeID, order, baseIDs.NewID("takerID"), sdkTypes.ZeroDec(), sdkTypes.NewDec(2)).GetExchangeRate())
+	require.Equal(t, key.FromTradeID(tradeID), testTrade.GetKey())
+}
@@ -0,0 +1,108 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mapper
+
+import (
+	"encoding/binary"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// BookIndex indexes orders by the book they rest in, entries of a book follow the order keys and so are sorted by exchange rate and then by creation height
+var BookIndex = baseHelpers.NewIndex("book", func(mappable helpers.Mappable) []byte {
+	if order, ok := mappable.(mappables.Order); ok {
+		return GenerateBookKeyBytes(order.GetClassificationID(), order.GetMakerOwnableID(), order.GetTakerOwnableID())
+	}
+
+	return nil
+})
+
+// GenerateBookKeyBytes returns the index key bytes of the book of orders of the classification that offer the maker ownable for the taker ownable
+func GenerateBookKeyBytes(classificationID ids.ID, makerOwnableID ids.ID, takerOwnableID ids.ID) []byte {
+	return generateKeyBytes(classificationID, makerOwnableID, takerOwnableID)
+}
+
+// PairIndex indexes trades by the pair of ownables they exchanged, whichever of them the maker gave, entries of a pair follow the trade keys
+// and so are sorted by height
+var PairIndex = baseHelpers.NewIndex("pair", func(mappable helpers.Mappable) []byte {
+	if trade, ok := mappable.(mappables.Trade); ok {
+		return GeneratePairKeyBytes(trade.GetMakerOwnableID(), trade.GetTakerOwnableID())
+	}
+
+	return nil
+})
+
+// MakerIndex indexes trades by the maker of the order filled
+var MakerIndex = baseHelpers.NewIndex("maker", func(mappable helpers.Mappable) []byte {
+	if trade, ok := mappable.(mappables.Trade); ok {
+		return GenerateIdentityKeyBytes(trade.GetMakerID())
+	}
+
+	return nil
+})
+
+// TakerIndex indexes trades by the identity that filled the order
+var TakerIndex = baseHelpers.NewIndex("taker", func(mappable helpers.Mappable) []byte {
+	if trade, ok := mappable.(mappables.Trade); ok {
+		return GenerateIdentityKeyBytes(trade.GetTakerID())
+	}
+
+	return nil
+})
+
+// GeneratePairKeyBytes returns the index key bytes of the trades exchanging the ownables, which do not depend on the order they are given in
+func GeneratePairKeyBytes(ownableID ids.ID, otherOwnableID ids.ID) []byte {
+	if ownableID.Compare(otherOwnableID) > 0 {
+		ownableID, otherOwnableID = otherOwnableID, ownableID
+	}
+
+	return generateKeyBytes(ownableID, otherOwnableID)
+}
+
+// GenerateIdentityKeyBytes returns the index key bytes of the trades the identity made or took
+func GenerateIdentityKeyBytes(identityID ids.ID) []byte {
+	return generateKeyBytes(identityID)
+}
+
+// StopIndex indexes stop orders by the ID of the order they place once triggered, as stops are keyed by their trigger rate
+var StopIndex = baseHelpers.NewIndex("stop", func(mappable helpers.Mappable) []byte {
+	if stop, ok := mappable.(mappables.Stop); ok {
+		return GenerateStopKeyBytes(stop.GetOrder().GetID())
+	}
+
+	return nil
+})
+
+// GenerateStopKeyBytes returns the index key bytes of the stop placing the order, which are the store key bytes of the order so that order
+// IDs read from strings find their stop
+func GenerateStopKeyBytes(orderID ids.ID) []byte {
+	return key.FromID(orderID).GenerateStoreKeyBytes()
+}
+
+// UncheckedIndex indexes the last prices that the stops of their pair are yet to be checked against, entries follow the last price keys
+var UncheckedIndex = baseHelpers.NewIndex("unchecked", func(mappable helpers.Mappable) []byte {
+	if lastPrice, ok := mappable.(mappables.LastPrice); ok && !lastPrice.IsChecked() {
+		return generateKeyBytes(lastPrice.GetMakerOwnableID(), lastPrice.GetTakerOwnableID())
+	}
+
+	return nil
+})
+
+// generateKeyBytes length prefixes each ID so that the index keys of different ID lists never prefix one another
+func generateKeyBytes(idList ...ids.ID) []byte {
+	var Bytes []byte
+
+	for _, id := range idList {
+		lengthBytes := make([]byte, 2)
+		binary.BigEndian.PutUint16(lengthBytes, uint16(len(id.Bytes())))
+
+		Bytes = append(append(Bytes, lengthBytes...), id.Bytes()...)
+	}
+
+	return Bytes
+}
@@ -0,0 +1,15 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+)
+
+func Prototype() helpers.Migrations {
+	return baseHelpers.NewMigrations(
+		baseHelpers.NewMigration(2, nil, nil, rebuildVersion2, migrateGenesisVersion2),
+	)
+}
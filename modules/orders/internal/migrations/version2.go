@@ -0,0 +1,164 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/genesis"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
+	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+// rebuildVersion2 writes the entries of the orders in the book index, their expiry records and the escrows of their maker ownable splits,
+// which were added at version 2
+func rebuildVersion2(context sdkTypes.Context, mapper helpers.Mapper, auxiliaryKeepers []interface{}) error {
+	var lockAuxiliary, supplementAuxiliary, transferAuxiliary helpers.Auxiliary
+
+	for _, auxiliaryKeeper := range auxiliaryKeepers {
+		if auxiliary, ok := auxiliaryKeeper.(helpers.Auxiliary); ok {
+			switch auxiliary.GetName() {
+			case lock.Auxiliary.GetName():
+				lockAuxiliary = auxiliary
+			case supplement.Auxiliary.GetName():
+				supplementAuxiliary = auxiliary
+			case transfer.Auxiliary.GetName():
+				transferAuxiliary = auxiliary
+			}
+		}
+	}
+
+	if lockAuxiliary == nil || supplementAuxiliary == nil || transferAuxiliary == nil {
+		return errors.UninitializedUsage
+	}
+
+	baseHelpers.RebuildIndexes(context, mapper, key.Prototype())
+
+	var orderList []mappables.Order
+
+	mapper.Iterate(context, key.Prototype(), func(mappable helpers.Mappable) bool {
+		orderList = append(orderList, mappable.(mappables.Order))
+		return false
+	})
+
+	for _, order := range orderList {
+		metaProperties, err := supplement.GetMetaPropertiesFromResponse(supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(order.GetExpiry())))
+		if err != nil {
+			return err
+		}
+
+		expiryProperty := metaProperties.GetMetaProperty(constants.ExpiryProperty)
+		if expiryProperty == nil {
+			return errors.MetaDataError
+		}
+
+		mapper.Create(context, mappable.NewExpiry(expiryProperty.GetData().(data.HeightData).Get(), order.GetID()))
+
+		makerOwnableSplit, err := utilities.GetMakerOwnableSplit(context, supplementAuxiliary, order)
+		if err != nil {
+			return err
+		}
+
+		if !makerOwnableSplit.IsPositive() {
+			continue
+		}
+
+		// the maker ownable splits of orders were pooled in the split of the module, each is handed back to its maker and locked in the
+		// escrow of its order, leaving every balance as it was
+		if auxiliaryResponse := transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.GetMakerID(), order.GetMakerOwnableID(), makerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+			return auxiliaryResponse.GetError()
+		}
+
+		if auxiliaryResponse := lockAuxiliary.GetKeeper().Help(context, lock.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.GetID(), order.GetMakerID(), order.GetMakerOwnableID(), makerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+			return auxiliaryResponse.GetError()
+		}
+	}
+
+	return nil
+}
+
+// migrateGenesisVersion2 writes the expiry records of the orders and the escrows of their maker ownable splits, which were added at version 2,
+// by importing the genesis of the orders, metas and splits modules into a scratch store, rebuilding it as the store migration does and
+// exporting the genesis of the orders and splits modules back, the metas holding the expiries of the orders are left as they are
+func migrateGenesisVersion2(genesisState map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	for _, moduleName := range []string{module.Name, metas.Prototype().Name(), splits.Prototype().Name()} {
+		if _, found := genesisState[moduleName]; !found {
+			return nil, errors.EntityNotFound
+		}
+	}
+
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey(module.Name)
+	metasStoreKey := sdkTypes.NewKVStoreKey(metas.Prototype().Name())
+	splitsStoreKey := sdkTypes.NewKVStoreKey(splits.Prototype().Name())
+	paramsStoreKey := sdkTypes.NewKVStoreKey(params.StoreKey)
+	paramsTransientStoreKey := sdkTypes.NewTransientStoreKey(params.TStoreKey)
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(metasStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(splitsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKey, sdkTypes.StoreTypeTransient, memDB)
+
+	if err := commitMultiStore.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{}, false, log.NewNopLogger())
+
+	paramsKeeper := params.NewKeeper(Codec, paramsStoreKey, paramsTransientStoreKey)
+	metasModule := metas.Prototype().Initialize(metasStoreKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+	splitsModule := splits.Prototype().Initialize(splitsStoreKey, paramsKeeper.Subspace(splits.Prototype().Name()))
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace(module.Name).WithKeyTable(parameters.Prototype().GetKeyTable()))
+
+	metasModule.InitGenesis(context, genesisState[metas.Prototype().Name()])
+	splitsModule.InitGenesis(context, genesisState[splits.Prototype().Name()])
+	genesis.Prototype().Decode(genesisState[module.Name]).Import(context, Mapper, Parameters)
+
+	if err := rebuildVersion2(context, Mapper, []interface{}{
+		metasModule.GetAuxiliary(supplement.Auxiliary.GetName()),
+		splitsModule.GetAuxiliary(lock.Auxiliary.GetName()),
+		splitsModule.GetAuxiliary(transfer.Auxiliary.GetName()),
+	}); err != nil {
+		return nil, err
+	}
+
+	genesisState[module.Name] = genesis.Prototype().Export(context, Mapper, Parameters).Encode()
+	genesisState[splits.Prototype().Name()] = splitsModule.ExportGenesis(context)
+
+	return genesisState, nil
+}
@@ -0,0 +1,215 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/genesis"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters"
+	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/balance"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/mint"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func createTestInput(t *testing.T) (sdkTypes.Context, *sdkTypes.KVStoreKey, helpers.Module, helpers.Module) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	metasStoreKey := sdkTypes.NewKVStoreKey("testMetas")
+	splitsStoreKey := sdkTypes.NewKVStoreKey("testSplits")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	metasModule := metas.Prototype().Initialize(metasStoreKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+	splitsModule := splits.Prototype().Initialize(splitsStoreKey, paramsKeeper.Subspace(splits.Prototype().Name()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(metasStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(splitsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	for _, Module := range []helpers.Module{metasModule, splitsModule} {
+		for _, parameter := range Module.GetParameters().GetList() {
+			Module.GetParameters().Mutate(context, parameter)
+		}
+	}
+
+	return context, storeKey, metasModule, splitsModule
+}
+
+func Test_rebuildVersion2(t *testing.T) {
+	context, storeKey, metasModule, splitsModule := createTestInput(t)
+
+	classificationID := baseIDs.NewID("classificationID")
+	makerID := baseIDs.NewID("makerID")
+	makerOwnableID := baseIDs.NewID("makerOwnableID")
+	takerOwnableID := baseIDs.NewID("takerOwnableID")
+	moduleID := baseIDs.NewID(module.Name)
+
+	// an order as written at version 1, without index entries, expiry record or escrow, its maker ownable split pooled in the split of the module
+	require.Equal(t, true, splitsModule.GetAuxiliary(mint.Auxiliary.GetName()).GetKeeper().Help(context, mint.NewAuxiliaryRequest(makerID, makerOwnableID, sdkTypes.NewDec(100))).IsSuccessful())
+	require.Equal(t, true, splitsModule.GetAuxiliary(transfer.Auxiliary.GetName()).GetKeeper().Help(context, transfer.NewAuxiliaryRequest(makerID, moduleID, makerOwnableID, sdkTypes.NewDec(10))).IsSuccessful())
+
+	mutableProperties, err := scrub.GetPropertiesFromResponse(metasModule.GetAuxiliary(scrub.Auxiliary.GetName()).GetKeeper().Help(context, scrub.NewAuxiliaryRequest(
+		baseProperties.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(baseTypes.NewHeight(100))),
+		baseProperties.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(sdkTypes.NewDec(10))),
+	)))
+	require.Nil(t, err)
+
+	orderID := key.NewOrderID(classificationID, makerOwnableID, takerOwnableID, baseIDs.NewID(sdkTypes.NewDec(2).QuoTruncate(sdkTypes.SmallestDec()).String()), baseIDs.NewID("1"), makerID, baseLists.NewPropertyList())
+	baseHelpers.NewMapper(key.Prototype, mappable.Prototype).Initialize(storeKey).Create(context, mappable.NewOrder(orderID, baseLists.NewPropertyList(), mutableProperties))
+
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	auxiliaryKeepers := []interface{}{
+		metasModule.GetAuxiliary(supplement.Auxiliary.GetName()),
+		splitsModule.GetAuxiliary(lock.Auxiliary.GetName()),
+		splitsModule.GetAuxiliary(transfer.Auxiliary.GetName()),
+	}
+
+	getBalance := func(context sdkTypes.Context, ownerID ids.ID) sdkTypes.Dec {
+		value, err := balance.GetValueFromResponse(splitsModule.GetAuxiliary(balance.Auxiliary.GetName()).GetKeeper().Help(context, balance.NewAuxiliaryRequest(ownerID, makerOwnableID)))
+		require.Nil(t, err)
+
+		return value
+	}
+
+	t.Run("NegativeCase-Auxiliaries Missing", func(t *testing.T) {
+		require.Equal(t, errors.UninitializedUsage, Prototype().Migrate(context, Mapper, auxiliaryKeepers[:2], 1).(interface{ Unwrap() error }).Unwrap())
+	})
+
+	t.Run("PositiveCase-Order Rebuilt", func(t *testing.T) {
+		require.Nil(t, Prototype().Migrate(context, Mapper, auxiliaryKeepers, 1))
+
+		var bookKeyList []helpers.Key
+
+		Mapper.IterateIndex(context, mapper.BookIndex, mapper.GenerateBookKeyBytes(classificationID, makerOwnableID, takerOwnableID), func(mappable helpers.Mappable) bool {
+			bookKeyList = append(bookKeyList, mappable.GetKey())
+			return false
+		})
+		require.Equal(t, []helpers.Key{key.FromID(orderID)}, bookKeyList)
+
+		var expiryList []mappables.Expiry
+
+		Mapper.Iterate(context, key.ExpiryPrototype(), func(mappable helpers.Mappable) bool {
+			expiryList = append(expiryList, mappable.(mappables.Expiry))
+			return false
+		})
+		require.Equal(t, 1, len(expiryList))
+		require.Equal(t, int64(100), expiryList[0].GetHeight().Get())
+		require.Equal(t, 0, expiryList[0].GetOrderID().Compare(orderID))
+
+		require.Equal(t, sdkTypes.NewDec(90), getBalance(context, makerID))
+		require.Equal(t, sdkTypes.NewDec(10), getBalance(context, moduleID))
+
+		// the escrow of the order refunds its maker
+		cacheContext, _ := context.CacheContext()
+		require.Equal(t, true, splitsModule.GetAuxiliary(release.Auxiliary.GetName()).GetKeeper().Help(cacheContext, release.NewAuxiliaryRequest(moduleID, orderID, sdkTypes.NewDec(10))).IsSuccessful())
+		require.Equal(t, sdkTypes.NewDec(100), getBalance(cacheContext, makerID))
+	})
+}
+
+func Test_migrateGenesisVersion2(t *testing.T) {
+	context, _, metasModule, splitsModule := createTestInput(t)
+
+	makerID := baseIDs.NewID("makerID")
+	makerOwnableID := baseIDs.NewID("makerOwnableID")
+	moduleID := baseIDs.NewID(module.Name)
+
+	// the genesis of an order as exported at version 1, without expiry record or escrow, its maker ownable split pooled in the split of the module
+	require.Equal(t, true, splitsModule.GetAuxiliary(mint.Auxiliary.GetName()).GetKeeper().Help(context, mint.NewAuxiliaryRequest(makerID, makerOwnableID, sdkTypes.NewDec(100))).IsSuccessful())
+	require.Equal(t, true, splitsModule.GetAuxiliary(transfer.Auxiliary.GetName()).GetKeeper().Help(context, transfer.NewAuxiliaryRequest(makerID, moduleID, makerOwnableID, sdkTypes.NewDec(10))).IsSuccessful())
+
+	mutableProperties, err := scrub.GetPropertiesFromResponse(metasModule.GetAuxiliary(scrub.Auxiliary.GetName()).GetKeeper().Help(context, scrub.NewAuxiliaryRequest(
+		baseProperties.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(baseTypes.NewHeight(100))),
+		baseProperties.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(sdkTypes.NewDec(10))),
+	)))
+	require.Nil(t, err)
+
+	orderID := key.NewOrderID(baseIDs.NewID("classificationID"), makerOwnableID, baseIDs.NewID("takerOwnableID"), baseIDs.NewID(sdkTypes.NewDec(2).QuoTruncate(sdkTypes.SmallestDec()).String()), baseIDs.NewID("1"), makerID, baseLists.NewPropertyList())
+	order := mappable.NewOrder(orderID, baseLists.NewPropertyList(), mutableProperties)
+
+	genesisState := map[string]json.RawMessage{
+		module.Name:         genesis.Prototype().Initialize([]helpers.Mappable{order}, parameters.Prototype().GetList()).Encode(),
+		metasModule.Name():  metasModule.ExportGenesis(context),
+		splitsModule.Name(): splitsModule.ExportGenesis(context),
+	}
+
+	t.Run("NegativeCase-Genesis Missing", func(t *testing.T) {
+		_, err := Prototype().MigrateGenesis(map[string]json.RawMessage{module.Name: genesisState[module.Name]}, 1)
+		require.Equal(t, errors.EntityNotFound, err.(interface{ Unwrap() error }).Unwrap())
+	})
+
+	t.Run("PositiveCase-Genesis Migrated", func(t *testing.T) {
+		migratedGenesisState, err := Prototype().MigrateGenesis(genesisState, 1)
+		require.Nil(t, err)
+
+		require.Equal(t, genesis.Prototype().Decode(genesis.Prototype().Initialize([]helpers.Mappable{order, mappable.NewExpiry(baseTypes.NewHeight(100), orderID)}, parameters.Prototype().GetList()).Encode()).GetMappableList(), genesis.Prototype().Decode(migratedGenesisState[module.Name]).GetMappableList())
+
+		// the migrated genesis of the splits module holds the escrow of the order, which refunds its maker
+		importContext, _, _, importedSplitsModule := createTestInput(t)
+		importedSplitsModule.InitGenesis(importContext, migratedGenesisState[splitsModule.Name()])
+
+		getBalance := func(ownerID ids.ID) sdkTypes.Dec {
+			value, err := balance.GetValueFromResponse(importedSplitsModule.GetAuxiliary(balance.Auxiliary.GetName()).GetKeeper().Help(importContext, balance.NewAuxiliaryRequest(ownerID, makerOwnableID)))
+			require.Nil(t, err)
+
+			return value
+		}
+
+		require.Equal(t, sdkTypes.NewDec(90), getBalance(makerID))
+		require.Equal(t, sdkTypes.NewDec(10), getBalance(moduleID))
+		require.Equal(t, true, importedSplitsModule.GetAuxiliary(release.Auxiliary.GetName()).GetKeeper().Help(importContext, release.NewAuxiliaryRequest(moduleID, orderID, sdkTypes.NewDec(10))).IsSuccessful())
+		require.Equal(t, sdkTypes.NewDec(100), getBalance(makerID))
+	})
+}
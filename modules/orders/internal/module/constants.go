@@ -9,3 +9,7 @@ import (
 
 const Name = "orders"
 const StoreKeyPrefix = keys.Orders
+const ExpiryStoreKeyPrefix = keys.Expiries
+const TradeStoreKeyPrefix = keys.Trades
+const StopStoreKeyPrefix = keys.Stops
+const LastPriceStoreKeyPrefix = keys.LastPrices
@@ -0,0 +1,17 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package auction
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// ID is the parameter setting the number of blocks between the batch auctions clearing the order books of classifications that do
+// not fix their own, books are matched continuously when it is zero
+var ID = baseIDs.NewID("auctionInterval")
+
+var DefaultData = baseData.NewDecData(sdkTypes.ZeroDec())
@@ -1,14 +1,14 @@
 // Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
 // SPDX-License-Identifier: Apache-2.0
 
-package dummy
+package collector
 
 import (
-	sdkTypes "github.com/cosmos/cosmos-sdk/types"
-
 	baseData "github.com/AssetMantle/modules/schema/data/base"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
 )
 
-var ID = baseIDs.NewID("dummy")
-var DefaultData = baseData.NewDecData(sdkTypes.SmallestDec())
+// ID is the parameter naming the identity trading fees are sent to
+var ID = baseIDs.NewID("feeCollector")
+
+var DefaultData = baseData.NewIDData(baseIDs.NewID("feeCollector"))
@@ -1,15 +0,0 @@
-// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
-// SPDX-License-Identifier: Apache-2.0
-
-package dummy
-
-import (
-	sdkTypes "github.com/cosmos/cosmos-sdk/types"
-
-	baseData "github.com/AssetMantle/modules/schema/data/base"
-	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
-)
-
-var ID = baseIDs.NewID("dummy")
-
-var DefaultData = baseData.NewDecData(sdkTypes.SmallestDec())
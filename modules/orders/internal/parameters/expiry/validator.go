@@ -1,7 +1,7 @@
 // Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
 // SPDX-License-Identifier: Apache-2.0
 
-package dummy
+package expiry
 
 import (
 	"github.com/AssetMantle/modules/constants/errors"
@@ -12,13 +12,13 @@ import (
 func validator(i interface{}) error {
 	switch value := i.(type) {
 	case parameters.Parameter:
-		if value.GetID().Compare(ID) != 0 || value.GetData().(data.DecData).Get().IsNegative() {
+		if value.GetID().Compare(ID) != 0 {
 			return errors.InvalidParameter
 		}
 
-		return nil
+		return validator(value.GetData())
 	case data.DecData:
-		if value.Get().IsNegative() {
+		if !value.Get().IsPositive() || !value.Get().IsInteger() {
 			return errors.InvalidParameter
 		}
 
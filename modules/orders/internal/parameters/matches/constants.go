@@ -0,0 +1,16 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package matches
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// ID is the parameter bounding the number of matches the order books attempt at the end of a block
+var ID = baseIDs.NewID("maxOrdersMatchedPerBlock")
+
+var DefaultData = baseData.NewDecData(sdkTypes.NewDec(100))
@@ -4,11 +4,16 @@
 package parameters
 
 import (
-	"github.com/AssetMantle/modules/modules/orders/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/auction"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/collector"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/expiry"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/fees"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/matches"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/retention"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 )
 
 func Prototype() helpers.Parameters {
-	return baseHelpers.NewParameters(dummy.Parameter)
+	return baseHelpers.NewParameters(auction.Parameter, collector.Parameter, expiry.Parameter, fees.Parameter, matches.Parameter, retention.Parameter)
 }
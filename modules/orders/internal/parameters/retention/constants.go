@@ -0,0 +1,17 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package retention
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// ID is the parameter setting the number of blocks trade records are kept for before they are pruned, records are kept indefinitely
+// when it is zero
+var ID = baseIDs.NewID("tradeRetention")
+
+var DefaultData = baseData.NewDecData(sdkTypes.NewDec(100000))
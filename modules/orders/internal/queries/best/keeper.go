@@ -0,0 +1,70 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package best
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+type queryKeeper struct {
+	mapper              helpers.Mapper
+	supplementAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.QueryKeeper = (*queryKeeper)(nil)
+
+func (queryKeeper queryKeeper) Enquire(context sdkTypes.Context, queryRequest helpers.QueryRequest) helpers.QueryResponse {
+	request := queryRequestFromInterface(queryRequest)
+
+	askList, _, err := utilities.GetBookLevels(context, queryKeeper.mapper, queryKeeper.supplementAuxiliary, request.ClassificationID, request.MakerOwnableID, request.TakerOwnableID, 1)
+	if err != nil {
+		return newQueryResponse(sdkTypes.ZeroDec(), sdkTypes.ZeroDec(), sdkTypes.ZeroDec(), sdkTypes.ZeroDec(), err)
+	}
+
+	bidList, _, err := utilities.GetBookLevels(context, queryKeeper.mapper, queryKeeper.supplementAuxiliary, request.ClassificationID, request.TakerOwnableID, request.MakerOwnableID, 1)
+	if err != nil {
+		return newQueryResponse(sdkTypes.ZeroDec(), sdkTypes.ZeroDec(), sdkTypes.ZeroDec(), sdkTypes.ZeroDec(), err)
+	}
+
+	askPrice, askSplit := sdkTypes.ZeroDec(), sdkTypes.ZeroDec()
+	if len(askList) != 0 {
+		// exchange rates are kept scaled up by the inverse of the smallest decimal
+		askPrice, askSplit = askList[0].ExchangeRate.MulTruncate(sdkTypes.SmallestDec()), askList[0].MakerOwnableSplit
+	}
+
+	bidPrice, bidSplit := sdkTypes.ZeroDec(), sdkTypes.ZeroDec()
+	if len(bidList) != 0 && bidList[0].ExchangeRate.IsPositive() {
+		// bids offer the taker ownable, so their exchange rates are inverted to price the maker ownable
+		bidPrice, bidSplit = sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(bidList[0].ExchangeRate), bidList[0].MakerOwnableSplit
+	}
+
+	return newQueryResponse(askPrice, askSplit, bidPrice, bidSplit, nil)
+}
+
+func (queryKeeper queryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	queryKeeper.mapper = mapper
+
+	for _, externalKeeper := range auxiliaries {
+		switch value := externalKeeper.(type) {
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case supplement.Auxiliary.GetName():
+				queryKeeper.supplementAuxiliary = value
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return queryKeeper
+}
+
+func keeperPrototype() helpers.QueryKeeper {
+	return queryKeeper{}
+}
@@ -0,0 +1,96 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package best
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+func createTestInput(t *testing.T) (sdkTypes.Context, queryKeeper, helpers.Auxiliary) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	metasStoreKey := sdkTypes.NewKVStoreKey("testMetas")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	metasModule := metas.Prototype().Initialize(metasStoreKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(metasStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	for _, parameter := range metasModule.GetParameters().GetList() {
+		metasModule.GetParameters().Mutate(context, parameter)
+	}
+
+	testQueryKeeper := keeperPrototype().Initialize(mapper.Prototype().Initialize(storeKey), nil, []interface{}{metasModule.GetAuxiliary(supplement.Auxiliary.GetName())}).(queryKeeper)
+
+	return context, testQueryKeeper, metasModule.GetAuxiliary(scrub.Auxiliary.GetName())
+}
+
+func Test_Best_Keeper(t *testing.T) {
+	context, testQueryKeeper, scrubAuxiliary := createTestInput(t)
+
+	require.Equal(t, queryKeeper{}, keeperPrototype())
+
+	testQueryRequest := newQueryRequest(baseIDs.NewID("classificationID"), baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"))
+	require.Equal(t, queryResponse{Success: true, AskPrice: sdkTypes.ZeroDec(), AskSplit: sdkTypes.ZeroDec(), BidPrice: sdkTypes.ZeroDec(), BidSplit: sdkTypes.ZeroDec()}, testQueryKeeper.Enquire(context, testQueryRequest))
+
+	addOrder := func(makerOwnableID string, takerOwnableID string, exchangeRate string, makerOwnableSplit int64) {
+		mutableProperties, err := scrub.GetPropertiesFromResponse(scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(baseProperties.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(sdkTypes.NewDec(makerOwnableSplit))))))
+		require.Nil(t, err)
+
+		orderID := key.NewOrderID(baseIDs.NewID("classificationID"), baseIDs.NewID(makerOwnableID), baseIDs.NewID(takerOwnableID), baseIDs.NewID(exchangeRate), baseIDs.NewID("1"), baseIDs.NewID("makerID"), baseLists.NewPropertyList())
+		testQueryKeeper.mapper.NewCollection(context).Add(mappable.NewOrder(orderID, baseLists.NewPropertyList(), mutableProperties))
+	}
+
+	// asks of 3 and 4 taker ownable per maker ownable and bids of 2 and 1 taker ownable per maker ownable
+	addOrder("makerOwnableID", "takerOwnableID", sdkTypes.NewDec(4000000000000000000).String(), 5)
+	addOrder("makerOwnableID", "takerOwnableID", sdkTypes.NewDec(3000000000000000000).String(), 6)
+	addOrder("takerOwnableID", "makerOwnableID", sdkTypes.NewDec(500000000000000000).String(), 8)
+	addOrder("takerOwnableID", "makerOwnableID", sdkTypes.NewDec(1000000000000000000).String(), 9)
+
+	require.Equal(t, queryResponse{Success: true, AskPrice: sdkTypes.NewDec(3), AskSplit: sdkTypes.NewDec(6), BidPrice: sdkTypes.NewDec(2), BidSplit: sdkTypes.NewDec(8)}, testQueryKeeper.Enquire(context, testQueryRequest))
+}
@@ -0,0 +1,26 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package best
+
+import (
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+)
+
+var Query = baseHelpers.NewQuery(
+	"best",
+	"",
+	"",
+
+	module.Name,
+
+	requestPrototype,
+	responsePrototype,
+	keeperPrototype,
+
+	constants.ClassificationID,
+	constants.MakerOwnableID,
+	constants.TakerOwnableID,
+)
@@ -0,0 +1,63 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package best
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/common"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+type queryRequest struct {
+	ClassificationID ids.ID `json:"classificationID" valid:"required~required field classificationID missing"`
+	MakerOwnableID   ids.ID `json:"makerOwnableID" valid:"required~required field makerOwnableID missing"`
+	TakerOwnableID   ids.ID `json:"takerOwnableID" valid:"required~required field takerOwnableID missing"`
+}
+
+var _ helpers.QueryRequest = (*queryRequest)(nil)
+
+func (queryRequest queryRequest) Validate() error {
+	_, err := govalidator.ValidateStruct(queryRequest)
+	return err
+}
+func (queryRequest queryRequest) FromCLI(cliCommand helpers.CLICommand, _ context.CLIContext) helpers.QueryRequest {
+	return newQueryRequest(baseIDs.NewID(cliCommand.ReadString(constants.ClassificationID)), baseIDs.NewID(cliCommand.ReadString(constants.MakerOwnableID)), baseIDs.NewID(cliCommand.ReadString(constants.TakerOwnableID)))
+}
+func (queryRequest queryRequest) FromMap(vars map[string]string) helpers.QueryRequest {
+	classificationID, ok := vars[Query.GetName()]
+	if !ok {
+		classificationID = vars[constants.ClassificationID.GetName()]
+	}
+
+	return newQueryRequest(baseIDs.NewID(classificationID), baseIDs.NewID(vars[constants.MakerOwnableID.GetName()]), baseIDs.NewID(vars[constants.TakerOwnableID.GetName()]))
+}
+func (queryRequest queryRequest) Encode() ([]byte, error) {
+	return common.Codec.MarshalJSON(queryRequest)
+}
+func (queryRequest queryRequest) Decode(bytes []byte) (helpers.QueryRequest, error) {
+	if err := common.Codec.UnmarshalJSON(bytes, &queryRequest); err != nil {
+		return nil, err
+	}
+
+	return queryRequest, nil
+}
+func requestPrototype() helpers.QueryRequest {
+	return queryRequest{}
+}
+func queryRequestFromInterface(request helpers.QueryRequest) queryRequest {
+	switch value := request.(type) {
+	case queryRequest:
+		return value
+	default:
+		return queryRequest{}
+	}
+}
+func newQueryRequest(classificationID ids.ID, makerOwnableID ids.ID, takerOwnableID ids.ID) helpers.QueryRequest {
+	return queryRequest{ClassificationID: classificationID, MakerOwnableID: makerOwnableID, TakerOwnableID: takerOwnableID}
+}
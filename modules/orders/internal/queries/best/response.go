@@ -0,0 +1,59 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package best
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/common"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+// queryResponse holds the best ask and bid prices of the pair in taker ownable per maker ownable along with the maker ownable split of
+// the orders resting at each, both are zero on a side without orders
+type queryResponse struct {
+	Success  bool         `json:"success"`
+	Error    error        `json:"error" swaggertype:"string"`
+	AskPrice sdkTypes.Dec `json:"askPrice"`
+	AskSplit sdkTypes.Dec `json:"askSplit"`
+	BidPrice sdkTypes.Dec `json:"bidPrice"`
+	BidSplit sdkTypes.Dec `json:"bidSplit"`
+}
+
+var _ helpers.QueryResponse = (*queryResponse)(nil)
+
+func (queryResponse queryResponse) IsSuccessful() bool {
+	return queryResponse.Success
+}
+func (queryResponse queryResponse) GetError() error {
+	return queryResponse.Error
+}
+func (queryResponse queryResponse) Encode() ([]byte, error) {
+	return common.Codec.MarshalJSON(queryResponse)
+}
+func (queryResponse queryResponse) Decode(bytes []byte) (helpers.QueryResponse, error) {
+	if err := common.Codec.UnmarshalJSON(bytes, &queryResponse); err != nil {
+		return nil, err
+	}
+
+	return queryResponse, nil
+}
+func responsePrototype() helpers.QueryResponse {
+	return queryResponse{}
+}
+func newQueryResponse(askPrice sdkTypes.Dec, askSplit sdkTypes.Dec, bidPrice sdkTypes.Dec, bidSplit sdkTypes.Dec, error error) helpers.QueryResponse {
+	success := true
+	if error != nil {
+		success = false
+	}
+
+	return queryResponse{
+		Success:  success,
+		Error:    error,
+		AskPrice: askPrice,
+		AskSplit: askSplit,
+		BidPrice: bidPrice,
+		BidSplit: bidSplit,
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package depth
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+type queryKeeper struct {
+	mapper              helpers.Mapper
+	supplementAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.QueryKeeper = (*queryKeeper)(nil)
+
+func (queryKeeper queryKeeper) Enquire(context sdkTypes.Context, queryRequest helpers.QueryRequest) helpers.QueryResponse {
+	request := queryRequestFromInterface(queryRequest)
+
+	askList, askSplit, err := utilities.GetBookLevels(context, queryKeeper.mapper, queryKeeper.supplementAuxiliary, request.ClassificationID, request.MakerOwnableID, request.TakerOwnableID, request.Limit)
+	if err != nil {
+		return newQueryResponse(nil, sdkTypes.ZeroDec(), nil, sdkTypes.ZeroDec(), err)
+	}
+
+	bidList, bidSplit, err := utilities.GetBookLevels(context, queryKeeper.mapper, queryKeeper.supplementAuxiliary, request.ClassificationID, request.TakerOwnableID, request.MakerOwnableID, request.Limit)
+	if err != nil {
+		return newQueryResponse(nil, sdkTypes.ZeroDec(), nil, sdkTypes.ZeroDec(), err)
+	}
+
+	return newQueryResponse(askList, askSplit, bidList, bidSplit, nil)
+}
+
+func (queryKeeper queryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	queryKeeper.mapper = mapper
+
+	for _, externalKeeper := range auxiliaries {
+		switch value := externalKeeper.(type) {
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case supplement.Auxiliary.GetName():
+				queryKeeper.supplementAuxiliary = value
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return queryKeeper
+}
+
+func keeperPrototype() helpers.QueryKeeper {
+	return queryKeeper{}
+}
@@ -0,0 +1,27 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package depth
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/utilities/test/schema/helpers/base"
+)
+
+func Test_Depth_Keeper(t *testing.T) {
+	context, storeKey, _ := base.SetupTest(t)
+
+	require.Equal(t, queryKeeper{}, keeperPrototype())
+	require.Panics(t, func() {
+		keeperPrototype().Initialize(mapper.Prototype().Initialize(storeKey), nil, []interface{}{nil})
+	})
+
+	testQueryKeeper := keeperPrototype().Initialize(mapper.Prototype().Initialize(storeKey), nil, []interface{}{}).(queryKeeper)
+	require.Equal(t, queryResponse{Success: true, AskSplit: sdkTypes.ZeroDec(), BidSplit: sdkTypes.ZeroDec()}, testQueryKeeper.Enquire(context, newQueryRequest(baseIDs.NewID("classificationID"), baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), 0)))
+}
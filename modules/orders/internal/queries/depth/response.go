@@ -0,0 +1,60 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package depth
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/common"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+// queryResponse holds the levels of the orders offering the maker ownable for the taker ownable as asks and the levels of the orders
+// offering the taker ownable for the maker ownable as bids, each level carrying the exchange rate of its own orders
+type queryResponse struct {
+	Success  bool              `json:"success"`
+	Error    error             `json:"error" swaggertype:"string"`
+	Asks     []utilities.Level `json:"asks"`
+	AskSplit sdkTypes.Dec      `json:"askSplit"`
+	Bids     []utilities.Level `json:"bids"`
+	BidSplit sdkTypes.Dec      `json:"bidSplit"`
+}
+
+var _ helpers.QueryResponse = (*queryResponse)(nil)
+
+func (queryResponse queryResponse) IsSuccessful() bool {
+	return queryResponse.Success
+}
+func (queryResponse queryResponse) GetError() error {
+	return queryResponse.Error
+}
+func (queryResponse queryResponse) Encode() ([]byte, error) {
+	return common.Codec.MarshalJSON(queryResponse)
+}
+func (queryResponse queryResponse) Decode(bytes []byte) (helpers.QueryResponse, error) {
+	if err := common.Codec.UnmarshalJSON(bytes, &queryResponse); err != nil {
+		return nil, err
+	}
+
+	return queryResponse, nil
+}
+func responsePrototype() helpers.QueryResponse {
+	return queryResponse{}
+}
+func newQueryResponse(askList []utilities.Level, askSplit sdkTypes.Dec, bidList []utilities.Level, bidSplit sdkTypes.Dec, error error) helpers.QueryResponse {
+	success := true
+	if error != nil {
+		success = false
+	}
+
+	return queryResponse{
+		Success:  success,
+		Error:    error,
+		Asks:     askList,
+		AskSplit: askSplit,
+		Bids:     bidList,
+		BidSplit: bidSplit,
+	}
+}
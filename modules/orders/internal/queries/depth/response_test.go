@@ -0,0 +1,37 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package depth
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/orders/internal/common"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+)
+
+func Test_Depth_Response(t *testing.T) {
+	testQueryResponse := newQueryResponse([]utilities.Level{{ExchangeRate: sdkTypes.NewDec(2), MakerOwnableSplit: sdkTypes.NewDec(3), Orders: 1}}, sdkTypes.NewDec(3), nil, sdkTypes.ZeroDec(), nil)
+	testQueryResponseWithError := newQueryResponse(nil, sdkTypes.ZeroDec(), nil, sdkTypes.ZeroDec(), errors.MetaDataError)
+
+	require.Equal(t, true, testQueryResponse.IsSuccessful())
+	require.Equal(t, false, testQueryResponseWithError.IsSuccessful())
+	require.Equal(t, nil, testQueryResponse.GetError())
+	require.Equal(t, errors.MetaDataError, testQueryResponseWithError.GetError())
+
+	encodedResponse, _ := testQueryResponse.Encode()
+	bytes, _ := common.Codec.MarshalJSON(testQueryResponse)
+	require.Equal(t, bytes, encodedResponse)
+
+	decodedResponse, _ := queryResponse{}.Decode(bytes)
+	require.Equal(t, testQueryResponse, decodedResponse)
+
+	decodedResponse2, _ := queryResponse{}.Decode([]byte{})
+	require.Equal(t, nil, decodedResponse2)
+
+	require.Equal(t, queryResponse{}, responsePrototype())
+}
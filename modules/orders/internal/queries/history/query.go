@@ -0,0 +1,25 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+)
+
+var Query = baseHelpers.NewQuery(
+	"history",
+	"",
+	"",
+
+	module.Name,
+
+	requestPrototype,
+	responsePrototype,
+	keeperPrototype,
+
+	constants.IdentityID,
+	constants.Limit,
+)
@@ -4,7 +4,12 @@
 package queries
 
 import (
+	"github.com/AssetMantle/modules/modules/orders/internal/queries/best"
+	"github.com/AssetMantle/modules/modules/orders/internal/queries/depth"
+	"github.com/AssetMantle/modules/modules/orders/internal/queries/history"
+	"github.com/AssetMantle/modules/modules/orders/internal/queries/list"
 	"github.com/AssetMantle/modules/modules/orders/internal/queries/order"
+	"github.com/AssetMantle/modules/modules/orders/internal/queries/trades"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 )
@@ -12,5 +17,10 @@ import (
 func Prototype() helpers.Queries {
 	return baseHelpers.NewQueries(
 		order.Query,
+		list.Query,
+		depth.Query,
+		best.Query,
+		trades.Query,
+		history.Query,
 	)
 }
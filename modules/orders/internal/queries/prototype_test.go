@@ -16,4 +16,8 @@ func TestPrototype(t *testing.T) {
 	require.Equal(t, Prototype().Get("orders").GetName(), baseHelpers.NewQueries(
 		order.Query,
 	).Get("orders").GetName())
+	require.Equal(t, "depth", Prototype().Get("depth").GetName())
+	require.Equal(t, "best", Prototype().Get("best").GetName())
+	require.Equal(t, "trades", Prototype().Get("trades").GetName())
+	require.Equal(t, "history", Prototype().Get("history").GetName())
 }
@@ -0,0 +1,32 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package trades
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+type queryKeeper struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.QueryKeeper = (*queryKeeper)(nil)
+
+func (queryKeeper queryKeeper) Enquire(context sdkTypes.Context, queryRequest helpers.QueryRequest) helpers.QueryResponse {
+	request := queryRequestFromInterface(queryRequest)
+	return newQueryResponse(utilities.GetLatestTrades(context, queryKeeper.mapper, []helpers.Index{mapper.PairIndex}, mapper.GeneratePairKeyBytes(request.MakerOwnableID, request.TakerOwnableID), request.Limit), nil)
+}
+
+func (queryKeeper queryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
+	queryKeeper.mapper = mapper
+	return queryKeeper
+}
+
+func keeperPrototype() helpers.QueryKeeper {
+	return queryKeeper{}
+}
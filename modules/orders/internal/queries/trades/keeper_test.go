@@ -0,0 +1,24 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package trades
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/utilities/test/schema/helpers/base"
+)
+
+func Test_Trades_Keeper(t *testing.T) {
+	context, storeKey, _ := base.SetupTest(t)
+
+	require.Equal(t, queryKeeper{}, keeperPrototype())
+
+	testQueryKeeper := keeperPrototype().Initialize(mapper.Prototype().Initialize(storeKey), nil, []interface{}{}).(queryKeeper)
+	require.Equal(t, queryResponse{Success: true, List: []mappables.Trade{}}, testQueryKeeper.Enquire(context, newQueryRequest(baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), 0)))
+}
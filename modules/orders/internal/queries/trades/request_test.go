@@ -0,0 +1,53 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package trades
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/common"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/utilities/test/schema/helpers/base"
+)
+
+func Test_Trades_Request(t *testing.T) {
+	testQueryRequest := newQueryRequest(baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), 10)
+	require.Equal(t, nil, testQueryRequest.Validate())
+	require.NotNil(t, newQueryRequest(baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), -1).Validate())
+	require.Equal(t, queryRequest{}, requestPrototype())
+
+	cliCommand := baseHelpers.NewCLICommand("", "", "", []helpers.CLIFlag{constants.MakerOwnableID, constants.TakerOwnableID, constants.Limit})
+	cliContext := context.NewCLIContext().WithCodec(base.MakeCodec())
+	require.Equal(t, newQueryRequest(baseIDs.NewID(""), baseIDs.NewID(""), 0), queryRequest{}.FromCLI(cliCommand, cliContext))
+
+	vars := make(map[string]string)
+	vars["trades"] = "makerOwnableID"
+	vars["takerOwnableID"] = "takerOwnableID"
+	vars["limit"] = "10"
+	require.Equal(t, testQueryRequest, queryRequest{}.FromMap(vars))
+
+	delete(vars, "trades")
+	vars["makerOwnableID"] = "makerOwnableID"
+	require.Equal(t, testQueryRequest, queryRequest{}.FromMap(vars))
+
+	encodedRequest, err := testQueryRequest.Encode()
+	encodedResult, _ := common.Codec.MarshalJSON(testQueryRequest)
+	require.Equal(t, encodedResult, encodedRequest)
+	require.Nil(t, err)
+
+	decodedRequest, err := queryRequest{}.Decode(encodedRequest)
+	require.Equal(t, testQueryRequest, decodedRequest)
+	require.Equal(t, nil, err)
+
+	randomDecode, _ := queryRequest{}.Decode(baseIDs.NewID("").Bytes())
+	require.Equal(t, nil, randomDecode)
+	require.Equal(t, testQueryRequest, queryRequestFromInterface(testQueryRequest))
+	require.Equal(t, queryRequest{}, queryRequestFromInterface(nil))
+}
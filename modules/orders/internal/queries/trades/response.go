@@ -0,0 +1,51 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package trades
+
+import (
+	"github.com/AssetMantle/modules/modules/orders/internal/common"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// queryResponse holds the latest trades exchanging the pair of ownables in either direction, latest first
+type queryResponse struct {
+	Success bool              `json:"success"`
+	Error   error             `json:"error" swaggertype:"string"`
+	List    []mappables.Trade `json:"list"`
+}
+
+var _ helpers.QueryResponse = (*queryResponse)(nil)
+
+func (queryResponse queryResponse) IsSuccessful() bool {
+	return queryResponse.Success
+}
+func (queryResponse queryResponse) GetError() error {
+	return queryResponse.Error
+}
+func (queryResponse queryResponse) Encode() ([]byte, error) {
+	return common.Codec.MarshalJSON(queryResponse)
+}
+func (queryResponse queryResponse) Decode(bytes []byte) (helpers.QueryResponse, error) {
+	if err := common.Codec.UnmarshalJSON(bytes, &queryResponse); err != nil {
+		return nil, err
+	}
+
+	return queryResponse, nil
+}
+func responsePrototype() helpers.QueryResponse {
+	return queryResponse{}
+}
+func newQueryResponse(tradeList []mappables.Trade, error error) helpers.QueryResponse {
+	success := true
+	if error != nil {
+		success = false
+	}
+
+	return queryResponse{
+		Success: success,
+		Error:   error,
+		List:    tradeList,
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package trades
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/orders/internal/common"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+func Test_Trades_Response(t *testing.T) {
+	order := mappable.NewOrder(key.NewOrderID(baseIDs.NewID("classificationID"), baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), baseIDs.NewID(sdkTypes.OneDec().String()), baseIDs.NewID("100"), baseIDs.NewID("makerID"), baseLists.NewPropertyList()), baseLists.NewPropertyList(), baseLists.NewPropertyList())
+	testQueryResponse := newQueryResponse([]mappables.Trade{mappable.NewTrade(key.NewTradeID(10, 1), order, baseIDs.NewID("takerID"), sdkTypes.NewDec(4), sdkTypes.NewDec(2))}, nil)
+	testQueryResponseWithError := newQueryResponse(nil, errors.MetaDataError)
+
+	require.Equal(t, true, testQueryResponse.IsSuccessful())
+	require.Equal(t, false, testQueryResponseWithError.IsSuccessful())
+	require.Equal(t, nil, testQueryResponse.GetError())
+	require.Equal(t, errors.MetaDataError, testQueryResponseWithError.GetError())
+
+	encodedResponse, _ := testQueryResponse.Encode()
+	bytes, _ := common.Codec.MarshalJSON(testQueryResponse)
+	require.Equal(t, bytes, encodedResponse)
+
+	decodedResponse, _ := queryResponse{}.Decode(bytes)
+	require.Equal(t, testQueryResponse, decodedResponse)
+
+	decodedResponse2, _ := queryResponse{}.Decode([]byte{})
+	require.Equal(t, nil, decodedResponse2)
+
+	require.Equal(t, queryResponse{}, responsePrototype())
+}
@@ -6,5 +6,52 @@ package simulator
 const OpWeightSubmitTextProposal = "op_weight_submit_text_proposal"
 const DefaultWeightTextProposal = 1
 
-const OpWeightMsg = "OpWeightMsg"
-const DefaultWeightMsg = 1
+const OpWeightSubmitParameterChangeProposal = "op_weight_submit_parameter_change_proposal"
+const DefaultWeightParameterChangeProposal = 1
+
+const (
+	OpWeightDefineMsg    = "op_weight_define_msg"
+	OpWeightMakeMsg      = "op_weight_make_msg"
+	OpWeightImmediateMsg = "op_weight_immediate_msg"
+	OpWeightTakeMsg      = "op_weight_take_msg"
+	OpWeightModifyMsg    = "op_weight_modify_msg"
+	OpWeightCancelMsg    = "op_weight_cancel_msg"
+	OpWeightDeputizeMsg  = "op_weight_deputize_msg"
+	OpWeightRevokeMsg    = "op_weight_revoke_msg"
+	OpWeightStopMsg      = "op_weight_stop_msg"
+	OpWeightAmendMsg     = "op_weight_amend_msg"
+)
+
+const (
+	DefaultWeightDefineMsg    = 20
+	DefaultWeightMakeMsg      = 40
+	DefaultWeightImmediateMsg = 20
+	DefaultWeightTakeMsg      = 30
+	DefaultWeightModifyMsg    = 10
+	DefaultWeightCancelMsg    = 5
+	DefaultWeightDeputizeMsg  = 5
+	DefaultWeightRevokeMsg    = 5
+	DefaultWeightStopMsg      = 20
+	DefaultWeightAmendMsg     = 10
+)
+
+// maxSimulatedPropertyCount bounds the number of immutable and of mutable properties of simulated classifications
+const maxSimulatedPropertyCount = 3
+
+// maxSimulatedRateMultiple bounds the number of taker units simulated orders ask for every maker unit
+const maxSimulatedRateMultiple = 10
+
+// minSimulatedExpiresIn and maxSimulatedExpiresIn bound the number of blocks simulated orders stay open for
+const (
+	minSimulatedExpiresIn = 10
+	maxSimulatedExpiresIn = 100
+)
+
+// maxSimulatedMatches bounds the number of matches simulated order books attempt in a block
+const maxSimulatedMatches = 50
+
+// maxSimulatedMaxOrderExpiry bounds the max order expiry of simulations, which is never below maxSimulatedExpiresIn
+const maxSimulatedMaxOrderExpiry = 10 * maxSimulatedExpiresIn
+
+// maxSimulatedProtocolFeeBasisPoints bounds the protocol fee of simulations in hundredths of a percent
+const maxSimulatedProtocolFeeBasisPoints = 100
@@ -14,34 +14,60 @@ import (
 	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
 	ordersModule "github.com/AssetMantle/modules/modules/orders/internal/module"
 	"github.com/AssetMantle/modules/modules/orders/internal/parameters"
-	"github.com/AssetMantle/modules/modules/orders/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/auction"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/collector"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/expiry"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/fees"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/matches"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/retention"
 	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	parameters2 "github.com/AssetMantle/modules/schema/parameters"
-	baseSimulation "github.com/AssetMantle/modules/simulation/schema/types/base"
 )
 
 func (simulator) RandomizedGenesisState(simulationState *module.SimulationState) {
-	var Data data.Data
+	var expiryData data.Data
 
 	simulationState.AppParams.GetOrGenerate(
 		simulationState.Cdc,
-		dummy.ID.String(),
-		&Data,
+		expiry.ID.String(),
+		&expiryData,
 		simulationState.Rand,
-		func(rand *rand.Rand) { Data = base.NewDecData(sdkTypes.NewDecWithPrec(int64(rand.Intn(99)), 2)) },
+		func(rand *rand.Rand) {
+			expiryData = base.NewDecData(sdkTypes.NewDec(int64(maxSimulatedExpiresIn + rand.Intn(maxSimulatedMaxOrderExpiry-maxSimulatedExpiresIn+1))))
+		},
 	)
 
-	mappableList := make([]helpers.Mappable, simulationState.Rand.Intn(99))
+	var matchesData data.Data
 
-	for i := range mappableList {
-		immutables := baseSimulation.GenerateRandomProperties(simulationState.Rand)
-		mappableList[i] = mappable.NewOrder(key.NewOrderID(baseSimulation.GenerateRandomID(simulationState.Rand), baseSimulation.GenerateRandomID(simulationState.Rand), baseSimulation.GenerateRandomID(simulationState.Rand), baseSimulation.GenerateRandomIDWithDec(simulationState.Rand), baseSimulation.GenerateRandomIDWithInt64(simulationState.Rand), baseSimulation.GenerateRandomID(simulationState.Rand), immutables), immutables, baseSimulation.GenerateRandomProperties(simulationState.Rand))
-	}
+	simulationState.AppParams.GetOrGenerate(
+		simulationState.Cdc,
+		matches.ID.String(),
+		&matchesData,
+		simulationState.Rand,
+		func(rand *rand.Rand) {
+			matchesData = base.NewDecData(sdkTypes.NewDec(int64(rand.Intn(maxSimulatedMatches) + 1)))
+		},
+	)
+
+	var feesData data.Data
+
+	simulationState.AppParams.GetOrGenerate(
+		simulationState.Cdc,
+		fees.ID.String(),
+		&feesData,
+		simulationState.Rand,
+		func(rand *rand.Rand) {
+			feesData = base.NewDecData(sdkTypes.NewDecWithPrec(int64(rand.Intn(maxSimulatedProtocolFeeBasisPoints+1)), 4))
+		},
+	)
+
+	// documents referencing other modules are created by the simulated transactions so that genesis satisfies the invariants
+	var mappableList []helpers.Mappable
 
-	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{dummy.Parameter.Mutate(Data)})
+	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{auction.Parameter, collector.Parameter, expiry.Parameter.Mutate(expiryData), fees.Parameter.Mutate(feesData), matches.Parameter.Mutate(matchesData), retention.Parameter})
 
 	simulationState.GenState[ordersModule.Name] = common.Codec.MustMarshalJSON(genesisState)
 }
@@ -5,32 +5,624 @@ package simulator
 
 import (
 	"math/rand"
+	"sort"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/AssetMantle/modules/modules/classifications"
+	"github.com/AssetMantle/modules/modules/identities"
+	"github.com/AssetMantle/modules/modules/maintainers"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/amend"
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/cancel"
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/define"
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/deputize"
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/immediate"
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/make"
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/modify"
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/revoke"
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/stop"
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/take"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/lists"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/properties"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+	simulationUtilities "github.com/AssetMantle/modules/simulation"
 )
 
-func (simulator) WeightedOperations(appParams simulation.AppParams, codec *codec.Codec) simulation.WeightedOperations {
-	var weightMsg int
+func (simulator simulator) WeightedOperations(appParams simulation.AppParams, codec *codec.Codec) simulation.WeightedOperations {
+	weightedOperations := simulation.WeightedOperations{}
 
-	appParams.GetOrGenerate(codec, OpWeightMsg, &weightMsg, nil,
-		func(_ *rand.Rand) {
-			weightMsg = DefaultWeightMsg
-		},
-	)
+	for _, operation := range []struct {
+		appParamsKey  string
+		defaultWeight int
+		operation     simulation.Operation
+	}{
+		{OpWeightDefineMsg, DefaultWeightDefineMsg, simulator.simulateDefineMsg(codec)},
+		{OpWeightMakeMsg, DefaultWeightMakeMsg, simulator.simulateMakeMsg(codec)},
+		{OpWeightImmediateMsg, DefaultWeightImmediateMsg, simulator.simulateImmediateMsg(codec)},
+		{OpWeightTakeMsg, DefaultWeightTakeMsg, simulator.simulateTakeMsg(codec)},
+		{OpWeightModifyMsg, DefaultWeightModifyMsg, simulator.simulateModifyMsg(codec)},
+		{OpWeightCancelMsg, DefaultWeightCancelMsg, simulator.simulateCancelMsg(codec)},
+		{OpWeightDeputizeMsg, DefaultWeightDeputizeMsg, simulator.simulateDeputizeMsg(codec)},
+		{OpWeightRevokeMsg, DefaultWeightRevokeMsg, simulator.simulateRevokeMsg(codec)},
+		{OpWeightStopMsg, DefaultWeightStopMsg, simulator.simulateStopMsg(codec)},
+		{OpWeightAmendMsg, DefaultWeightAmendMsg, simulator.simulateAmendMsg(codec)},
+	} {
+		var weight int
+
+		appParams.GetOrGenerate(codec, operation.appParamsKey, &weight, nil,
+			func(_ *rand.Rand) {
+				weight = operation.defaultWeight
+			},
+		)
+
+		weightedOperations = append(weightedOperations, simulation.NewWeightedOperation(weight, operation.operation))
+	}
+
+	return weightedOperations
+}
+
+// simulateDefineMsg defines an order classification and schedules an order of it for the next block
+func (simulator simulator) simulateDefineMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		identityIDList, err := simulationUtilities.GetIdentityIDList(context, baseApp, codec, identities.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		fromID, simulationAccount, found := simulationUtilities.RandomControlledIdentity(rand, context, simulator.authenticateAuxiliary, identityIDList, simulationAccountList)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, define.NewSimulationMessage(
+			simulationAccount.Address,
+			fromID,
+			baseLists.NewMetaPropertyList(),
+			simulationUtilities.GenerateRandomPropertyDefinitionList(rand, maxSimulatedPropertyCount),
+			baseLists.NewMetaPropertyList(),
+			simulationUtilities.GenerateRandomPropertyDefinitionList(rand, maxSimulatedPropertyCount),
+		))
+		if err != nil {
+			return operationMsg, nil, err
+		}
+
+		return operationMsg, []simulation.FutureOperation{{BlockHeight: int(context.BlockHeight()) + 1, Op: simulator.simulateMakeMsg(codec)}}, nil
+	}
+}
+
+// simulateMakeMsg places an order through a maintainer of an order classification holding a split, and schedules a take of it for the next block
+func (simulator simulator) simulateMakeMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		splitList, err := simulationUtilities.GetSplitList(context, baseApp, codec, splits.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		makerID := baseIDs.NewID(maintainer.GetIdentityID().String())
+		classificationID := baseIDs.NewID(classification.GetID().String())
+		immutableMetaProperties := simulationUtilities.GenerateRandomMetaPropertyList(rand, classification.GetImmutablePropertyList())
+
+		makerOwnableID, takerOwnableID, makerOwnableSplit, takerOwnableSplit, found := simulator.randomOrderTerms(rand, context, splitList, classificationID, makerID, immutableMetaProperties, true)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
 
-	return simulation.WeightedOperations{
-		simulation.NewWeightedOperation(
-			weightMsg,
-			simulateMsg(),
-		),
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, make.NewSimulationMessage(
+			simulationAccount.Address,
+			makerID,
+			classificationID,
+			makerOwnableID,
+			takerOwnableID,
+			baseTypes.NewHeight(int64(minSimulatedExpiresIn+rand.Intn(maxSimulatedExpiresIn-minSimulatedExpiresIn+1))),
+			makerOwnableSplit,
+			takerOwnableSplit,
+			[]ids.ID{utilities.GoodTillCancelled, utilities.PostOnly}[rand.Intn(2)],
+			immutableMetaProperties,
+			baseLists.NewPropertyList(),
+			simulationUtilities.GenerateRandomMetaPropertyList(rand, classification.GetMutablePropertyList(), constants.ExpiryProperty, constants.MakerOwnableSplitProperty),
+			baseLists.NewPropertyList(),
+		))
+		if err != nil {
+			return operationMsg, nil, err
+		}
+
+		return operationMsg, []simulation.FutureOperation{{BlockHeight: int(context.BlockHeight()) + 1, Op: simulator.simulateTakeMsg(codec)}}, nil
 	}
 }
 
-func simulateMsg() simulation.Operation {
+// simulateStopMsg places a stop order through a maintainer of an order classification holding a split, triggered at up to some multiple of its
+// exchange rate
+func (simulator simulator) simulateStopMsg(codec *codec.Codec) simulation.Operation {
 	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
-		return simulation.NewOperationMsg(nil, true, ""), nil, nil
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		splitList, err := simulationUtilities.GetSplitList(context, baseApp, codec, splits.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		makerID := baseIDs.NewID(maintainer.GetIdentityID().String())
+		classificationID := baseIDs.NewID(classification.GetID().String())
+		immutableMetaProperties := simulationUtilities.GenerateRandomMetaPropertyList(rand, classification.GetImmutablePropertyList())
+
+		makerOwnableID, takerOwnableID, makerOwnableSplit, takerOwnableSplit, found := simulator.randomOrderTerms(rand, context, splitList, classificationID, makerID, immutableMetaProperties, true)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		triggerRate := takerOwnableSplit.Quo(makerOwnableSplit).MulInt64(int64(rand.Intn(maxSimulatedRateMultiple) + 1))
+		if !triggerRate.IsPositive() {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, stop.NewSimulationMessage(
+			simulationAccount.Address,
+			makerID,
+			classificationID,
+			makerOwnableID,
+			takerOwnableID,
+			baseTypes.NewHeight(int64(minSimulatedExpiresIn+rand.Intn(maxSimulatedExpiresIn-minSimulatedExpiresIn+1))),
+			makerOwnableSplit,
+			takerOwnableSplit,
+			triggerRate,
+			[]ids.ID{utilities.GoodTillCancelled, utilities.ImmediateOrCancel}[rand.Intn(2)],
+			immutableMetaProperties,
+			baseLists.NewPropertyList(),
+			simulationUtilities.GenerateRandomMetaPropertyList(rand, classification.GetMutablePropertyList(), constants.ExpiryProperty, constants.MakerOwnableSplitProperty),
+			baseLists.NewPropertyList(),
+		))
+
+		return operationMsg, nil, err
 	}
 }
+
+// simulateImmediateMsg places an order of any order classification through an identity holding a split
+func (simulator simulator) simulateImmediateMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		classificationMap, err := simulationUtilities.GetClassificationMap(context, baseApp, codec, classifications.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		var classificationList []mappables.Classification
+
+		for _, classification := range classificationMap {
+			if isOrderClassification(classification) {
+				classificationList = append(classificationList, classification)
+			}
+		}
+
+		sort.Slice(classificationList, func(i, j int) bool {
+			return classificationList[i].GetID().String() < classificationList[j].GetID().String()
+		})
+
+		if len(classificationList) == 0 {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		classification := classificationList[rand.Intn(len(classificationList))]
+
+		splitList, err := simulationUtilities.GetSplitList(context, baseApp, codec, splits.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		for _, i := range rand.Perm(len(splitList)) {
+			simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, splitList[i].GetOwnerID(), simulationAccountList)
+			if !found {
+				continue
+			}
+
+			makerID := baseIDs.NewID(splitList[i].GetOwnerID().String())
+			classificationID := baseIDs.NewID(classification.GetID().String())
+			immutableMetaProperties := simulationUtilities.GenerateRandomMetaPropertyList(rand, classification.GetImmutablePropertyList())
+
+			makerOwnableID, takerOwnableID, makerOwnableSplit, takerOwnableSplit, found := simulator.randomOrderTerms(rand, context, splitList, classificationID, makerID, immutableMetaProperties, false)
+			if !found {
+				return simulation.NoOpMsg(module.Name), nil, nil
+			}
+
+			operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, immediate.NewSimulationMessage(
+				simulationAccount.Address,
+				makerID,
+				classificationID,
+				makerOwnableID,
+				takerOwnableID,
+				baseTypes.NewHeight(int64(minSimulatedExpiresIn+rand.Intn(maxSimulatedExpiresIn-minSimulatedExpiresIn+1))),
+				makerOwnableSplit,
+				takerOwnableSplit,
+				[]ids.ID{utilities.GoodTillCancelled, utilities.ImmediateOrCancel}[rand.Intn(2)],
+				immutableMetaProperties,
+				baseLists.NewPropertyList(),
+				simulationUtilities.GenerateRandomMetaPropertyList(rand, classification.GetMutablePropertyList(), constants.ExpiryProperty, constants.MakerOwnableSplitProperty),
+				baseLists.NewPropertyList(),
+			))
+
+			return operationMsg, nil, err
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+// simulateTakeMsg takes an order through an identity holding the taker ownable, filling it completely when the identity holds enough
+func (simulator simulator) simulateTakeMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		order, makerOwnableSplit, found, err := simulator.randomOrder(rand, context, func(mappables.Order) bool { return true })
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		splitList, err := simulationUtilities.GetSplitList(context, baseApp, codec, splits.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		exchangeRate := order.GetExchangeRate().GetData().(data.DecData).Get()
+
+		for _, i := range rand.Perm(len(splitList)) {
+			if splitList[i].GetOwnableID().String() != order.GetTakerOwnableID().String() {
+				continue
+			}
+
+			simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, splitList[i].GetOwnerID(), simulationAccountList)
+			if !found {
+				continue
+			}
+
+			takerOwnableSplit := makerOwnableSplit.MulTruncate(exchangeRate).MulTruncate(sdkTypes.SmallestDec())
+			if splitList[i].GetValue().LT(takerOwnableSplit) {
+				takerOwnableSplit = splitList[i].GetValue()
+			}
+
+			if !takerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(exchangeRate).IsPositive() {
+				return simulation.NoOpMsg(module.Name), nil, nil
+			}
+
+			operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, take.NewSimulationMessage(
+				simulationAccount.Address,
+				baseIDs.NewID(splitList[i].GetOwnerID().String()),
+				takerOwnableSplit,
+				baseIDs.NewID(order.GetID().String()),
+			))
+
+			return operationMsg, nil, err
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+// simulateModifyMsg reduces the maker split of an order at the same exchange rate, so that the maker never needs to hold more
+func (simulator simulator) simulateModifyMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		var simulationAccount simulation.Account
+
+		order, makerOwnableSplit, found, err := simulator.randomOrder(rand, context, func(order mappables.Order) bool {
+			var provisioned bool
+			simulationAccount, provisioned = simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, order.GetMakerID(), simulationAccountList)
+
+			return provisioned
+		})
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		updatedMakerOwnableSplit := simulationUtilities.RandomSplitValue(rand, makerOwnableSplit)
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, modify.NewSimulationMessage(
+			simulationAccount.Address,
+			baseIDs.NewID(order.GetMakerID().String()),
+			baseIDs.NewID(order.GetID().String()),
+			updatedMakerOwnableSplit.MulTruncate(order.GetExchangeRate().GetData().(data.DecData).Get()).MulTruncate(sdkTypes.SmallestDec()),
+			updatedMakerOwnableSplit,
+			baseTypes.NewHeight(int64(minSimulatedExpiresIn+rand.Intn(maxSimulatedExpiresIn-minSimulatedExpiresIn+1))),
+			baseLists.NewMetaPropertyList(),
+			baseLists.NewPropertyList(),
+		))
+
+		return operationMsg, nil, err
+	}
+}
+
+// simulateAmendMsg reduces the size of an order at its exchange rate, so that it keeps its priority in the book
+func (simulator simulator) simulateAmendMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		var simulationAccount simulation.Account
+
+		order, makerOwnableSplit, found, err := simulator.randomOrder(rand, context, func(order mappables.Order) bool {
+			var provisioned bool
+			simulationAccount, provisioned = simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, order.GetMakerID(), simulationAccountList)
+
+			return provisioned
+		})
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		exchangeRate := order.GetExchangeRate().GetData().(data.DecData).Get()
+		updatedMakerOwnableSplit := simulationUtilities.RandomSplitValue(rand, makerOwnableSplit)
+		updatedTakerOwnableSplit := updatedMakerOwnableSplit.MulTruncate(exchangeRate).MulTruncate(sdkTypes.SmallestDec())
+
+		// splits whose exchange rate truncates away from that of the order would queue it again
+		if !updatedTakerOwnableSplit.IsPositive() || !updatedTakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(updatedMakerOwnableSplit).Equal(exchangeRate) {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, amend.NewSimulationMessage(
+			simulationAccount.Address,
+			baseIDs.NewID(order.GetMakerID().String()),
+			baseIDs.NewID(order.GetID().String()),
+			updatedMakerOwnableSplit,
+			updatedTakerOwnableSplit,
+		))
+
+		return operationMsg, nil, err
+	}
+}
+
+func (simulator simulator) simulateCancelMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		var simulationAccount simulation.Account
+
+		order, _, found, err := simulator.randomOrder(rand, context, func(order mappables.Order) bool {
+			var provisioned bool
+			simulationAccount, provisioned = simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, order.GetMakerID(), simulationAccountList)
+
+			return provisioned
+		})
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, cancel.NewSimulationMessage(
+			simulationAccount.Address,
+			baseIDs.NewID(order.GetMakerID().String()),
+			baseIDs.NewID(order.GetID().String()),
+		))
+
+		return operationMsg, nil, err
+	}
+}
+
+func (simulator simulator) simulateDeputizeMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		identityIDList, err := simulationUtilities.GetIdentityIDList(context, baseApp, codec, identities.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		var maintainedPropertyList []properties.Property
+
+		for _, property := range maintainer.GetMutablePropertyList().GetList() {
+			if simulationUtilities.RandomBool(rand) {
+				maintainedPropertyList = append(maintainedPropertyList, property)
+			}
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, deputize.NewSimulationMessage(
+			simulationAccount.Address,
+			baseIDs.NewID(maintainer.GetIdentityID().String()),
+			baseIDs.NewID(identityIDList[rand.Intn(len(identityIDList))].String()),
+			baseIDs.NewID(classification.GetID().String()),
+			baseLists.NewPropertyList(maintainedPropertyList...),
+			simulationUtilities.RandomBool(rand),
+			simulationUtilities.RandomBool(rand),
+			simulationUtilities.RandomBool(rand),
+		))
+
+		return operationMsg, nil, err
+	}
+}
+
+func (simulator simulator) simulateRevokeMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		maintainerList, err := simulationUtilities.GetMaintainerList(context, baseApp, codec, maintainers.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		maintainer, classification, simulationAccount, found, err := simulator.randomControlledMaintainer(rand, baseApp, context, codec, simulationAccountList)
+		if err != nil || !found {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		for _, i := range rand.Perm(len(maintainerList)) {
+			if maintainerList[i].GetMaintainedClassificationID().String() == classification.GetID().String() && maintainerList[i].GetIdentityID().String() != maintainer.GetIdentityID().String() {
+				operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, revoke.NewSimulationMessage(
+					simulationAccount.Address,
+					baseIDs.NewID(maintainer.GetIdentityID().String()),
+					baseIDs.NewID(maintainerList[i].GetIdentityID().String()),
+					baseIDs.NewID(classification.GetID().String()),
+				))
+
+				return operationMsg, nil, err
+			}
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+// randomOrderTerms picks a split of the maker and another ownable at random, and asks for a whole multiple of the maker split in return,
+// when crossing is wanted it takes the opposite side of an open order of the classification half of the time, asking for a whole fraction
+// of the maker split instead so that the order books match them, it returns false when the maker holds no split, when the order would
+// already exist, or when orders of the opposite side are open and crossing them is not wanted
+func (simulator simulator) randomOrderTerms(rand *rand.Rand, context sdkTypes.Context, splitList []mappables.Split, classificationID ids.ID, makerID ids.ID, immutableMetaProperties lists.MetaPropertyList, crossing bool) (ids.ID, ids.ID, sdkTypes.Dec, sdkTypes.Dec, bool) {
+	var makerSplitList []mappables.Split
+
+	ownableIDMap := map[string]bool{}
+
+	for _, split := range splitList {
+		ownableIDMap[split.GetOwnableID().String()] = true
+
+		if split.GetOwnerID().String() == makerID.String() {
+			makerSplitList = append(makerSplitList, split)
+		}
+	}
+
+	if len(makerSplitList) == 0 {
+		return nil, nil, sdkTypes.Dec{}, sdkTypes.Dec{}, false
+	}
+
+	makerSplit := makerSplitList[rand.Intn(len(makerSplitList))]
+
+	var opposingSplitList []mappables.Split
+
+	var opposingOwnableIDList []ids.ID
+
+	if crossing && rand.Intn(2) == 0 {
+		simulator.mapper.Iterate(context, key.FromID(baseIDs.NewID("")), func(mappable helpers.Mappable) bool {
+			if order := mappable.(mappables.Order); order.GetClassificationID().String() == classificationID.String() {
+				for _, split := range makerSplitList {
+					if split.GetOwnableID().String() == order.GetTakerOwnableID().String() {
+						opposingSplitList = append(opposingSplitList, split)
+						opposingOwnableIDList = append(opposingOwnableIDList, order.GetMakerOwnableID())
+					}
+				}
+			}
+
+			return false
+		})
+	}
+
+	var takerOwnableID ids.ID
+
+	if len(opposingSplitList) != 0 {
+		i := rand.Intn(len(opposingSplitList))
+		makerSplit, takerOwnableID = opposingSplitList[i], baseIDs.NewID(opposingOwnableIDList[i].String())
+	} else {
+		delete(ownableIDMap, makerSplit.GetOwnableID().String())
+
+		var ownableIDList []string
+
+		for ownableID := range ownableIDMap {
+			ownableIDList = append(ownableIDList, ownableID)
+		}
+
+		if len(ownableIDList) == 0 {
+			return nil, nil, sdkTypes.Dec{}, sdkTypes.Dec{}, false
+		}
+
+		// map iteration order is random, so the list is sorted before picking to keep simulations reproducible from their seed
+		sort.Strings(ownableIDList)
+
+		takerOwnableID = baseIDs.NewID(ownableIDList[rand.Intn(len(ownableIDList))])
+	}
+
+	makerOwnableID := baseIDs.NewID(makerSplit.GetOwnableID().String())
+	makerOwnableSplit := simulationUtilities.RandomSplitValue(rand, makerSplit.GetValue())
+	takerOwnableSplit := makerOwnableSplit.MulInt64(int64(rand.Intn(maxSimulatedRateMultiple) + 1))
+
+	opposingOrderExists := false
+
+	simulator.mapper.IterateIndex(context, mapper.BookIndex, mapper.GenerateBookKeyBytes(classificationID, takerOwnableID, makerOwnableID), func(helpers.Mappable) bool {
+		opposingOrderExists = true
+		return true
+	})
+
+	if opposingOrderExists {
+		if !crossing {
+			return nil, nil, sdkTypes.Dec{}, sdkTypes.Dec{}, false
+		}
+
+		if takerOwnableSplit = makerOwnableSplit.QuoInt64(int64(rand.Intn(maxSimulatedRateMultiple) + 1)); !takerOwnableSplit.IsPositive() {
+			return nil, nil, sdkTypes.Dec{}, sdkTypes.Dec{}, false
+		}
+	}
+
+	orderID := key.NewOrderID(
+		classificationID,
+		makerOwnableID,
+		takerOwnableID,
+		baseIDs.NewID(takerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(makerOwnableSplit).String()),
+		baseIDs.NewID(strconv.FormatInt(context.BlockHeight(), 10)),
+		makerID,
+		immutableMetaProperties.ToPropertyList(),
+	)
+
+	if _, found := utilities.GetStop(context, simulator.mapper, orderID); found || simulator.mapper.NewCollection(context).Fetch(key.FromID(orderID)).Get(key.FromID(orderID)) != nil {
+		return nil, nil, sdkTypes.Dec{}, sdkTypes.Dec{}, false
+	}
+
+	return makerOwnableID, takerOwnableID, makerOwnableSplit, takerOwnableSplit, true
+}
+
+// randomOrder picks an order accepted by the filter at random along with its revealed maker split
+func (simulator simulator) randomOrder(rand *rand.Rand, context sdkTypes.Context, filter func(mappables.Order) bool) (mappables.Order, sdkTypes.Dec, bool, error) {
+	var orderList []mappables.Order
+
+	simulator.mapper.Iterate(context, key.FromID(baseIDs.NewID("")), func(mappable helpers.Mappable) bool {
+		orderList = append(orderList, mappable.(mappables.Order))
+		return false
+	})
+
+	for _, i := range rand.Perm(len(orderList)) {
+		if !filter(orderList[i]) {
+			continue
+		}
+
+		metaProperties, err := supplement.GetMetaPropertiesFromResponse(simulator.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(orderList[i].GetMakerOwnableSplit())))
+		if err != nil {
+			return nil, sdkTypes.Dec{}, false, err
+		}
+
+		if makerOwnableSplitProperty := metaProperties.GetMetaProperty(constants.MakerOwnableSplitProperty); makerOwnableSplitProperty != nil {
+			return orderList[i], makerOwnableSplitProperty.GetData().(data.DecData).Get(), true, nil
+		}
+	}
+
+	return nil, sdkTypes.Dec{}, false, nil
+}
+
+// randomControlledMaintainer picks a maintainer of a classification defined through the orders module
+func (simulator simulator) randomControlledMaintainer(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, codec *codec.Codec, simulationAccountList []simulation.Account) (mappables.Maintainer, mappables.Classification, simulation.Account, bool, error) {
+	maintainerList, err := simulationUtilities.GetMaintainerList(context, baseApp, codec, maintainers.Prototype().Name())
+	if err != nil {
+		return nil, nil, simulation.Account{}, false, err
+	}
+
+	classificationMap, err := simulationUtilities.GetClassificationMap(context, baseApp, codec, classifications.Prototype().Name())
+	if err != nil {
+		return nil, nil, simulation.Account{}, false, err
+	}
+
+	maintainer, classification, simulationAccount, found := simulationUtilities.RandomControlledMaintainer(rand, context, simulator.authenticateAuxiliary, maintainerList, classificationMap, isOrderClassification, simulationAccountList)
+
+	return maintainer, classification, simulationAccount, found, nil
+}
+
+// isOrderClassification identifies classifications defined through the orders module by the properties the module adds to them
+func isOrderClassification(classification mappables.Classification) bool {
+	return classification.GetMutablePropertyList().GetProperty(constants.ExpiryProperty) != nil && classification.GetMutablePropertyList().GetProperty(constants.MakerOwnableSplitProperty) != nil
+}
@@ -12,16 +12,36 @@ import (
 
 	"github.com/AssetMantle/modules/modules/orders/internal/common"
 	"github.com/AssetMantle/modules/modules/orders/internal/module"
-	"github.com/AssetMantle/modules/modules/orders/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/expiry"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/fees"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/matches"
 	"github.com/AssetMantle/modules/schema/data/base"
 )
 
 func (simulator) ParamChangeList(_ *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
 		simulation.NewSimParamChange(module.Name,
-			dummy.ID.String(),
+			expiry.ID.String(),
 			func(r *rand.Rand) string {
-				bytes, err := common.Codec.MarshalJSON(dummy.Parameter.Mutate(base.NewDecData(sdk.NewDecWithPrec(int64(r.Intn(99)), 2))).GetData())
+				bytes, err := common.Codec.MarshalJSON(expiry.Parameter.Mutate(base.NewDecData(sdk.NewDec(int64(maxSimulatedExpiresIn + r.Intn(maxSimulatedMaxOrderExpiry-maxSimulatedExpiresIn+1))))).GetData())
+				if err != nil {
+					panic(err)
+				}
+				return string(bytes)
+			}),
+		simulation.NewSimParamChange(module.Name,
+			fees.ID.String(),
+			func(r *rand.Rand) string {
+				bytes, err := common.Codec.MarshalJSON(fees.Parameter.Mutate(base.NewDecData(sdk.NewDecWithPrec(int64(r.Intn(maxSimulatedProtocolFeeBasisPoints+1)), 4))).GetData())
+				if err != nil {
+					panic(err)
+				}
+				return string(bytes)
+			}),
+		simulation.NewSimParamChange(module.Name,
+			matches.ID.String(),
+			func(r *rand.Rand) string {
+				bytes, err := common.Codec.MarshalJSON(matches.Parameter.Mutate(base.NewDecData(sdk.NewDec(int64(r.Intn(maxSimulatedMatches) + 1)))).GetData())
 				if err != nil {
 					panic(err)
 				}
@@ -0,0 +1,185 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package amend
+
+import (
+	"strconv"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
+	"github.com/AssetMantle/modules/schema/data"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+type transactionKeeper struct {
+	mapper                helpers.Mapper
+	parameters            helpers.Parameters
+	scrubAuxiliary        helpers.Auxiliary
+	supplementAuxiliary   helpers.Auxiliary
+	lockAuxiliary         helpers.Auxiliary
+	releaseAuxiliary      helpers.Auxiliary
+	authenticateAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
+
+func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
+	message := messageFromInterface(msg)
+	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	orders := transactionKeeper.mapper.NewCollection(context).Fetch(key.FromID(message.OrderID))
+
+	order, ok := orders.Get(key.FromID(message.OrderID)).(mappables.Order)
+	if !ok {
+		return newTransactionResponse(errors.EntityNotFound)
+	}
+
+	if message.FromID.Compare(order.GetMakerID()) != 0 {
+		return newTransactionResponse(errors.NotAuthorized)
+	}
+
+	makerOwnableSplit, err := utilities.GetMakerOwnableSplit(context, transactionKeeper.supplementAuxiliary, order)
+	if err != nil {
+		return newTransactionResponse(err)
+	}
+
+	orderExchangeRate := order.GetExchangeRate().GetData().(data.DecData).Get()
+	exchangeRate := message.TakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(message.MakerOwnableSplit)
+	transferMakerOwnableSplit := message.MakerOwnableSplit.Sub(makerOwnableSplit)
+	amendedOrderID := order.GetID()
+
+	if err := utilities.ValidateExchangeRate(exchangeRate); err != nil {
+		return newTransactionResponse(err)
+	}
+
+	// an order reduced in size at its exchange rate keeps its creation height and so its priority in the book, the exchange rate is kept
+	// when the taker ownable split is the one the order demands for the maker ownable split, as rounding makes the rate recomputed from the
+	// splits differ from the stored one
+	keepsExchangeRate := exchangeRate.Equal(orderExchangeRate) || message.TakerOwnableSplit.Equal(orderExchangeRate.MulTruncate(message.MakerOwnableSplit).MulTruncate(sdkTypes.SmallestDec()))
+	if keepsExchangeRate {
+		exchangeRate = orderExchangeRate
+	}
+
+	if !keepsExchangeRate || transferMakerOwnableSplit.IsPositive() {
+		amendedOrderID = key.NewOrderID(
+			order.GetClassificationID(),
+			order.GetMakerOwnableID(),
+			order.GetTakerOwnableID(),
+			baseIDs.NewID(exchangeRate.String()),
+			baseIDs.NewID(strconv.FormatInt(context.BlockHeight(), 10)),
+			order.GetMakerID(),
+			order.GetImmutablePropertyList(),
+		)
+	}
+
+	requeued := amendedOrderID.Compare(order.GetID()) != 0
+	if requeued && orders.Fetch(key.FromID(amendedOrderID)).Get(key.FromID(amendedOrderID)) != nil {
+		return newTransactionResponse(errors.EntityAlreadyExists)
+	}
+
+	if requeued {
+		if err := utilities.ValidatePostOnly(context, transactionKeeper.mapper, transactionKeeper.supplementAuxiliary, mappable.NewOrder(amendedOrderID, order.GetImmutablePropertyList(), order.GetMutablePropertyList())); err != nil {
+			return newTransactionResponse(err)
+		}
+	}
+
+	// a requeued order takes its escrow to the new ID, a reduced one releases what it no longer offers
+	if requeued {
+		if auxiliaryResponse := transactionKeeper.releaseAuxiliary.GetKeeper().Help(context, release.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.GetID(), makerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+			return newTransactionResponse(auxiliaryResponse.GetError())
+		}
+
+		if auxiliaryResponse := transactionKeeper.lockAuxiliary.GetKeeper().Help(context, lock.NewAuxiliaryRequest(baseIDs.NewID(module.Name), amendedOrderID, message.FromID, order.GetMakerOwnableID(), message.MakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+			return newTransactionResponse(auxiliaryResponse.GetError())
+		}
+	} else if transferMakerOwnableSplit.IsNegative() {
+		if auxiliaryResponse := transactionKeeper.releaseAuxiliary.GetKeeper().Help(context, release.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.GetID(), transferMakerOwnableSplit.Abs())); !auxiliaryResponse.IsSuccessful() {
+			return newTransactionResponse(auxiliaryResponse.GetError())
+		}
+	}
+
+	mutableProperties, err := scrub.GetPropertiesFromResponse(transactionKeeper.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(base.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(message.MakerOwnableSplit)))))
+	if err != nil {
+		return newTransactionResponse(err)
+	}
+
+	amendedOrder := mappable.NewOrder(amendedOrderID, order.GetImmutablePropertyList(), order.GetMutablePropertyList().Mutate(mutableProperties.GetList()...))
+
+	if requeued {
+		metaProperties, err := supplement.GetMetaPropertiesFromResponse(transactionKeeper.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(order.GetExpiry())))
+		if err != nil {
+			return newTransactionResponse(err)
+		}
+
+		expiryProperty := metaProperties.GetMetaProperty(constants.ExpiryProperty)
+		if expiryProperty == nil {
+			return newTransactionResponse(errors.MetaDataError)
+		}
+
+		orders.Remove(order)
+		orders.Add(amendedOrder)
+		transactionKeeper.mapper.NewCollection(context).Add(mappable.NewExpiry(expiryProperty.GetData().(data.HeightData).Get(), amendedOrder.GetID()))
+	} else {
+		orders.Mutate(amendedOrder)
+	}
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.OrderModified,
+			sdkTypes.NewAttribute(events.AttributeKeyOrderID, order.GetID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyModifiedOrderID, amendedOrder.GetID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableSplit, message.MakerOwnableSplit.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyExchangeRate, amendedOrder.GetExchangeRate().GetData().(data.DecData).Get().String()),
+		),
+	)
+
+	return newTransactionResponse(nil)
+}
+
+func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	transactionKeeper.mapper, transactionKeeper.parameters = mapper, parameters
+
+	for _, externalKeeper := range auxiliaries {
+		switch value := externalKeeper.(type) {
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case scrub.Auxiliary.GetName():
+				transactionKeeper.scrubAuxiliary = value
+			case supplement.Auxiliary.GetName():
+				transactionKeeper.supplementAuxiliary = value
+			case lock.Auxiliary.GetName():
+				transactionKeeper.lockAuxiliary = value
+			case release.Auxiliary.GetName():
+				transactionKeeper.releaseAuxiliary = value
+			case authenticate.Auxiliary.GetName():
+				transactionKeeper.authenticateAuxiliary = value
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return transactionKeeper
+}
+func keeperPrototype() helpers.TransactionKeeper {
+	return transactionKeeper{}
+}
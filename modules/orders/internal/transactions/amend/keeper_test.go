@@ -0,0 +1,285 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package amend
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/balance"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/mint"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type TestKeepers struct {
+	OrdersKeeper helpers.TransactionKeeper
+	MetasModule  helpers.Module
+	SplitsModule helpers.Module
+}
+
+func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	metasStoreKey := sdkTypes.NewKVStoreKey("testMetas")
+	splitsStoreKey := sdkTypes.NewKVStoreKey("testSplits")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	metasModule := metas.Prototype().Initialize(metasStoreKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+	splitsModule := splits.Prototype().Initialize(splitsStoreKey, paramsKeeper.Subspace(splits.Prototype().Name()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(metasStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(splitsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	for _, module := range []helpers.Module{metasModule, splitsModule} {
+		for _, parameter := range module.GetParameters().GetList() {
+			module.GetParameters().Mutate(context, parameter)
+		}
+	}
+
+	keepers := TestKeepers{
+		OrdersKeeper: keeperPrototype().Initialize(Mapper, nil, []interface{}{
+			authenticate.AuxiliaryMock.Initialize(Mapper, nil),
+			metasModule.GetAuxiliary(scrub.Auxiliary.GetName()),
+			metasModule.GetAuxiliary(supplement.Auxiliary.GetName()),
+			splitsModule.GetAuxiliary(lock.Auxiliary.GetName()),
+			splitsModule.GetAuxiliary(release.Auxiliary.GetName()),
+		}).(helpers.TransactionKeeper),
+		MetasModule:  metasModule,
+		SplitsModule: splitsModule,
+	}
+
+	return context, keepers
+}
+
+func Test_transactionKeeper_Transact(t *testing.T) {
+	context, keepers := CreateTestInput(t)
+	context = context.WithBlockHeight(10)
+	defaultAddr := sdkTypes.AccAddress("addr")
+	verifyMockErrorAddress := sdkTypes.AccAddress("verifyError")
+
+	makerID := baseIDs.NewID("makerID")
+	makerOwnableID := baseIDs.NewID("makerOwnableID")
+	takerOwnableID := baseIDs.NewID("takerOwnableID")
+	orderMapper := keepers.OrdersKeeper.(transactionKeeper).mapper
+
+	require.Equal(t, true, keepers.SplitsModule.GetAuxiliary(mint.Auxiliary.GetName()).GetKeeper().Help(context, mint.NewAuxiliaryRequest(makerID, makerOwnableID, sdkTypes.NewDec(100))).IsSuccessful())
+
+	// addOrder places an order of the maker made at the height, with its maker ownable split held in escrow
+	addOrder := func(exchangeRate sdkTypes.Dec, height int64, makerOwnableSplit sdkTypes.Dec) ids.ID {
+		mutableProperties, err := scrub.GetPropertiesFromResponse(keepers.MetasModule.GetAuxiliary(scrub.Auxiliary.GetName()).GetKeeper().Help(context, scrub.NewAuxiliaryRequest(
+			baseProperties.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(baseTypes.NewHeight(100))),
+			baseProperties.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(makerOwnableSplit)),
+		)))
+		require.Nil(t, err)
+
+		orderID := key.NewOrderID(baseIDs.NewID("classificationID"), makerOwnableID, takerOwnableID, baseIDs.NewID(exchangeRate.String()), baseIDs.NewID(strconv.FormatInt(height, 10)), makerID, baseLists.NewPropertyList())
+		orderMapper.NewCollection(context).Add(mappable.NewOrder(orderID, baseLists.NewPropertyList(), mutableProperties))
+
+		require.Equal(t, true, keepers.SplitsModule.GetAuxiliary(lock.Auxiliary.GetName()).GetKeeper().Help(context, lock.NewAuxiliaryRequest(baseIDs.NewID(module.Name), orderID, makerID, makerOwnableID, makerOwnableSplit)).IsSuccessful())
+
+		return orderID
+	}
+
+	getOrder := func(orderID ids.ID) mappables.Order {
+		order, _ := orderMapper.NewCollection(context).Fetch(key.FromID(orderID)).Get(key.FromID(orderID)).(mappables.Order)
+		return order
+	}
+
+	getMakerOwnableSplit := func(order mappables.Order) sdkTypes.Dec {
+		makerOwnableSplit, err := utilities.GetMakerOwnableSplit(context, keepers.MetasModule.GetAuxiliary(supplement.Auxiliary.GetName()), order)
+		require.Nil(t, err)
+
+		return makerOwnableSplit
+	}
+
+	getBalance := func() sdkTypes.Dec {
+		value, err := balance.GetValueFromResponse(keepers.SplitsModule.GetAuxiliary(balance.Auxiliary.GetName()).GetKeeper().Help(context, balance.NewAuxiliaryRequest(makerID, makerOwnableID)))
+		require.Nil(t, err)
+
+		return value
+	}
+
+	// isEscrowed tells if the order ID holds an escrow of the value, releasing it on a cache of the context
+	isEscrowed := func(orderID ids.ID, value sdkTypes.Dec) bool {
+		cacheContext, _ := context.CacheContext()
+		return keepers.SplitsModule.GetAuxiliary(release.Auxiliary.GetName()).GetKeeper().Help(cacheContext, release.NewAuxiliaryRequest(baseIDs.NewID(module.Name), orderID, value)).IsSuccessful()
+	}
+
+	// one taker ownable for three maker ownable, a rate the demanded taker ownable splits do not reproduce exactly
+	exchangeRate := sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(sdkTypes.NewDec(3))
+	orderID := addOrder(exchangeRate, 1, sdkTypes.NewDec(3))
+
+	t.Run("NegativeCase-Verify Identity Failure", func(t *testing.T) {
+		want := newTransactionResponse(errors.MockError)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(verifyMockErrorAddress, makerID, orderID, sdkTypes.NewDec(2), sdkTypes.OneDec())); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Order Not Found", func(t *testing.T) {
+		want := newTransactionResponse(errors.EntityNotFound)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, baseIDs.NewID("orderID"), sdkTypes.NewDec(2), sdkTypes.OneDec())); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Not Maker", func(t *testing.T) {
+		want := newTransactionResponse(errors.NotAuthorized)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, baseIDs.NewID("otherID"), orderID, sdkTypes.NewDec(2), sdkTypes.OneDec())); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("PositiveCase-Reduce Keeps Priority", func(t *testing.T) {
+		takerOwnableSplit := exchangeRate.MulTruncate(sdkTypes.NewDec(2)).MulTruncate(sdkTypes.SmallestDec())
+		require.NotEqual(t, exchangeRate, takerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(sdkTypes.NewDec(2)))
+
+		want := newTransactionResponse(nil)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, orderID, sdkTypes.NewDec(2), takerOwnableSplit)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		order := getOrder(orderID)
+		require.NotNil(t, order)
+		require.Equal(t, sdkTypes.NewDec(2), getMakerOwnableSplit(order))
+		require.Equal(t, sdkTypes.NewDec(98), getBalance())
+		require.Equal(t, true, isEscrowed(orderID, sdkTypes.NewDec(2)))
+		require.Equal(t, false, isEscrowed(orderID, sdkTypes.NewDec(3)))
+	})
+
+	t.Run("PositiveCase-Larger Size Requeues", func(t *testing.T) {
+		want := newTransactionResponse(nil)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, orderID, sdkTypes.NewDec(6), sdkTypes.NewDec(2))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		amendedOrderID := key.NewOrderID(baseIDs.NewID("classificationID"), makerOwnableID, takerOwnableID, baseIDs.NewID(exchangeRate.String()), baseIDs.NewID("10"), makerID, baseLists.NewPropertyList())
+
+		require.Nil(t, getOrder(orderID))
+		require.NotNil(t, getOrder(amendedOrderID))
+		require.Equal(t, sdkTypes.NewDec(6), getMakerOwnableSplit(getOrder(amendedOrderID)))
+		require.Equal(t, sdkTypes.NewDec(94), getBalance())
+		require.Equal(t, false, isEscrowed(orderID, sdkTypes.SmallestDec()))
+		require.Equal(t, true, isEscrowed(amendedOrderID, sdkTypes.NewDec(6)))
+
+		orderID = amendedOrderID
+	})
+
+	t.Run("PositiveCase-New Rate Requeues", func(t *testing.T) {
+		context := context.WithBlockHeight(11)
+
+		want := newTransactionResponse(nil)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, orderID, sdkTypes.NewDec(4), sdkTypes.NewDec(2))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		amendedOrderID := key.NewOrderID(baseIDs.NewID("classificationID"), makerOwnableID, takerOwnableID, baseIDs.NewID(sdkTypes.NewDecWithPrec(5, 1).QuoTruncate(sdkTypes.SmallestDec()).String()), baseIDs.NewID("11"), makerID, baseLists.NewPropertyList())
+
+		require.Nil(t, getOrder(orderID))
+		require.NotNil(t, getOrder(amendedOrderID))
+		require.Equal(t, sdkTypes.NewDec(96), getBalance())
+		require.Equal(t, false, isEscrowed(orderID, sdkTypes.SmallestDec()))
+		require.Equal(t, true, isEscrowed(amendedOrderID, sdkTypes.NewDec(4)))
+
+		orderID = amendedOrderID
+	})
+
+	t.Run("NegativeCase-Requeued Order Exists", func(t *testing.T) {
+		addOrder(sdkTypes.NewDec(2).QuoTruncate(sdkTypes.SmallestDec()), 10, sdkTypes.NewDec(1))
+
+		want := newTransactionResponse(errors.EntityAlreadyExists)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, orderID, sdkTypes.NewDec(1), sdkTypes.NewDec(2))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.NotNil(t, getOrder(orderID))
+	})
+
+	t.Run("NegativeCase-Post Only Crossing", func(t *testing.T) {
+		context, _ := context.CacheContext()
+
+		// the opposite book offers taker ownable at three maker ownable each
+		oppositeMutableProperties, err := scrub.GetPropertiesFromResponse(keepers.MetasModule.GetAuxiliary(scrub.Auxiliary.GetName()).GetKeeper().Help(context, scrub.NewAuxiliaryRequest(
+			baseProperties.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(baseTypes.NewHeight(100))),
+			baseProperties.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(sdkTypes.OneDec())),
+		)))
+		require.Nil(t, err)
+		orderMapper.NewCollection(context).Add(mappable.NewOrder(key.NewOrderID(baseIDs.NewID("classificationID"), takerOwnableID, makerOwnableID, baseIDs.NewID(sdkTypes.NewDec(3).QuoTruncate(sdkTypes.SmallestDec()).String()), baseIDs.NewID("1"), baseIDs.NewID("otherID"), baseLists.NewPropertyList()), baseLists.NewPropertyList(), oppositeMutableProperties))
+
+		mutableProperties, err := scrub.GetPropertiesFromResponse(keepers.MetasModule.GetAuxiliary(scrub.Auxiliary.GetName()).GetKeeper().Help(context, scrub.NewAuxiliaryRequest(
+			baseProperties.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(baseTypes.NewHeight(100))),
+			baseProperties.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(sdkTypes.NewDec(3))),
+			baseProperties.NewMetaProperty(constants.TimeInForceProperty.GetKey(), baseData.NewIDData(utilities.PostOnly)),
+		)))
+		require.Nil(t, err)
+
+		postOnlyOrderID := key.NewOrderID(baseIDs.NewID("classificationID"), makerOwnableID, takerOwnableID, baseIDs.NewID(sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec()).String()), baseIDs.NewID("2"), makerID, baseLists.NewPropertyList())
+		orderMapper.NewCollection(context).Add(mappable.NewOrder(postOnlyOrderID, baseLists.NewPropertyList(), mutableProperties))
+		require.Equal(t, true, keepers.SplitsModule.GetAuxiliary(lock.Auxiliary.GetName()).GetKeeper().Help(context, lock.NewAuxiliaryRequest(baseIDs.NewID(module.Name), postOnlyOrderID, makerID, makerOwnableID, sdkTypes.NewDec(3))).IsSuccessful())
+
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, postOnlyOrderID, sdkTypes.NewDec(3), sdkTypes.OneDec())); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		want = newTransactionResponse(nil)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, postOnlyOrderID, sdkTypes.NewDec(3), sdkTypes.NewDec(2))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package amend
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/utilities/transaction"
+)
+
+func Test_Amend_Message(t *testing.T) {
+
+	fromID := baseIDs.NewID("fromID")
+	orderID := baseIDs.NewID("orderID")
+	makerOwnableSplit := sdkTypes.NewDec(2)
+	takerOwnableSplit := sdkTypes.NewDec(1)
+
+	fromAddress := "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c"
+	fromAccAddress, err := sdkTypes.AccAddressFromBech32(fromAddress)
+	require.Nil(t, err)
+
+	testMessage := newMessage(fromAccAddress, fromID, orderID, makerOwnableSplit, takerOwnableSplit)
+	require.Equal(t, message{From: fromAccAddress, FromID: fromID, OrderID: orderID, MakerOwnableSplit: makerOwnableSplit, TakerOwnableSplit: takerOwnableSplit}, testMessage)
+	require.Equal(t, module.Name, testMessage.Route())
+	require.Equal(t, Transaction.GetName(), testMessage.Type())
+	require.Equal(t, nil, testMessage.ValidateBasic())
+	require.NotNil(t, message{}.ValidateBasic())
+	require.Equal(t, sdkTypes.MustSortJSON(transaction.RegisterCodec(messagePrototype).MustMarshalJSON(testMessage)), testMessage.GetSignBytes())
+	require.Equal(t, []sdkTypes.AccAddress{fromAccAddress}, testMessage.GetSigners())
+	require.Equal(t, testMessage, messageFromInterface(testMessage))
+	require.Equal(t, message{}, messageFromInterface(nil))
+	require.Equal(t, message{}, messagePrototype())
+	require.Error(t, sdkErrors.Wrap(errors.IncorrectMessage, ""), newMessage(fromAccAddress, fromID, orderID, sdkTypes.ZeroDec(), takerOwnableSplit).ValidateBasic())
+	require.Error(t, sdkErrors.Wrap(errors.IncorrectMessage, ""), newMessage(fromAccAddress, fromID, orderID, makerOwnableSplit, sdkTypes.ZeroDec()).ValidateBasic())
+
+}
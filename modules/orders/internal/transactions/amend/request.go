@@ -0,0 +1,102 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package amend
+
+import (
+	"encoding/json"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+type transactionRequest struct {
+	BaseReq           rest.BaseReq `json:"baseReq"`
+	FromID            string       `json:"fromID" valid:"required~required field fromID missing, matches(^[A-Za-z0-9-_=.|]+$)~invalid field fromID"`
+	OrderID           string       `json:"orderID" valid:"required~required field orderID missing, matches(^[A-Za-z0-9-_=.|*]+$)~invalid field orderID"`
+	MakerOwnableSplit string       `json:"makerOwnableSplit" valid:"required~required field makerOwnableSplit missing, matches(^[0-9.]+$)~invalid field makerOwnableSplit"`
+	TakerOwnableSplit string       `json:"takerOwnableSplit" valid:"required~required field takerOwnableSplit missing, matches(^[0-9.]+$)~invalid field takerOwnableSplit"`
+}
+
+var _ helpers.TransactionRequest = (*transactionRequest)(nil)
+
+// Validate godoc
+// @Summary amend order transaction
+// @Description amend order transaction
+// @Accept text/plain
+// @Produce json
+// @Tags Orders
+// @Param body  transactionRequest true "Request body to amend order"
+// @Success 200 {object} transactionResponse   "Message for a successful response."
+// @Failure default  {object}  transactionResponse "Message for an unexpected error response."
+// @Router /orders/amend [post]
+func (transactionRequest transactionRequest) Validate() error {
+	_, err := govalidator.ValidateStruct(transactionRequest)
+	return err
+}
+func (transactionRequest transactionRequest) FromCLI(cliCommand helpers.CLICommand, cliContext context.CLIContext) (helpers.TransactionRequest, error) {
+	return newTransactionRequest(
+		cliCommand.ReadBaseReq(cliContext),
+		cliCommand.ReadString(constants.FromID),
+		cliCommand.ReadString(constants.OrderID),
+		cliCommand.ReadString(constants.MakerOwnableSplit),
+		cliCommand.ReadString(constants.TakerOwnableSplit),
+	), nil
+}
+func (transactionRequest transactionRequest) FromJSON(rawMessage json.RawMessage) (helpers.TransactionRequest, error) {
+	if err := json.Unmarshal(rawMessage, &transactionRequest); err != nil {
+		return nil, err
+	}
+
+	return transactionRequest, nil
+}
+func (transactionRequest transactionRequest) GetBaseReq() rest.BaseReq {
+	return transactionRequest.BaseReq
+}
+func (transactionRequest transactionRequest) MakeMsg() (sdkTypes.Msg, error) {
+	from, err := sdkTypes.AccAddressFromBech32(transactionRequest.GetBaseReq().From)
+	if err != nil {
+		return nil, err
+	}
+
+	makerOwnableSplit, err := sdkTypes.NewDecFromStr(transactionRequest.MakerOwnableSplit)
+	if err != nil {
+		return nil, err
+	}
+
+	takerOwnableSplit, err := sdkTypes.NewDecFromStr(transactionRequest.TakerOwnableSplit)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMessage(
+		from,
+		baseIDs.NewID(transactionRequest.FromID),
+		baseIDs.NewID(transactionRequest.OrderID),
+		makerOwnableSplit,
+		takerOwnableSplit,
+	), nil
+}
+func (transactionRequest) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, transactionRequest{})
+}
+func requestPrototype() helpers.TransactionRequest {
+	return transactionRequest{}
+}
+func newTransactionRequest(baseReq rest.BaseReq, fromID string, orderID string, makerOwnableSplit string, takerOwnableSplit string) helpers.TransactionRequest {
+	return transactionRequest{
+		BaseReq:           baseReq,
+		FromID:            fromID,
+		OrderID:           orderID,
+		MakerOwnableSplit: makerOwnableSplit,
+		TakerOwnableSplit: takerOwnableSplit,
+	}
+}
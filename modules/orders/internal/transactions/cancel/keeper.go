@@ -7,11 +7,13 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
 	"github.com/AssetMantle/modules/modules/orders/internal/key"
 	"github.com/AssetMantle/modules/modules/orders/internal/module"
-	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
 	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
@@ -23,7 +25,7 @@ type transactionKeeper struct {
 	mapper                helpers.Mapper
 	parameters            helpers.Parameters
 	supplementAuxiliary   helpers.Auxiliary
-	transferAuxiliary     helpers.Auxiliary
+	releaseAuxiliary      helpers.Auxiliary
 	authenticateAuxiliary helpers.Auxiliary
 }
 
@@ -37,16 +39,26 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	orders := transactionKeeper.mapper.NewCollection(context).Fetch(key.FromID(message.OrderID))
 
-	order := orders.Get(key.FromID(message.OrderID))
-	if order == nil {
-		return newTransactionResponse(errors.EntityNotFound)
+	var closed helpers.Mappable
+
+	order, ok := orders.Get(key.FromID(message.OrderID)).(mappables.Order)
+	if ok {
+		closed = order
+	} else {
+		// stop orders not yet triggered are cancelled by the ID of the order they would place
+		stop, ok := utilities.GetStop(context, transactionKeeper.mapper, message.OrderID)
+		if !ok {
+			return newTransactionResponse(errors.EntityNotFound)
+		}
+
+		order, closed = stop.GetOrder(), stop
 	}
 
-	if message.FromID.Compare(order.(mappables.Order).GetMakerID()) != 0 {
+	if message.FromID.Compare(order.GetMakerID()) != 0 {
 		return newTransactionResponse(errors.NotAuthorized)
 	}
 
-	metaProperties, Error := supplement.GetMetaPropertiesFromResponse(transactionKeeper.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(order.(mappables.Order).GetMakerOwnableSplit())))
+	metaProperties, Error := supplement.GetMetaPropertiesFromResponse(transactionKeeper.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(order.GetMakerOwnableSplit())))
 	if Error != nil {
 		return newTransactionResponse(Error)
 	}
@@ -58,11 +70,13 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	makerOwnableSplit := makerOwnableSplitProperty.GetData().(data.DecData).Get()
 
-	if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), message.FromID, order.(mappables.Order).GetMakerOwnableID(), makerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+	if auxiliaryResponse := transactionKeeper.releaseAuxiliary.GetKeeper().Help(context, release.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.GetID(), makerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
 		return newTransactionResponse(auxiliaryResponse.GetError())
 	}
 
-	orders.Remove(order)
+	orders.Remove(closed)
+
+	utilities.EmitOrderClosedEvent(context, events.OrderCancelled, order, makerOwnableSplit)
 
 	return newTransactionResponse(nil)
 }
@@ -76,8 +90,8 @@ func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, par
 			switch value.GetName() {
 			case supplement.Auxiliary.GetName():
 				transactionKeeper.supplementAuxiliary = value
-			case transfer.Auxiliary.GetName():
-				transactionKeeper.transferAuxiliary = value
+			case release.Auxiliary.GetName():
+				transactionKeeper.releaseAuxiliary = value
 			case authenticate.Auxiliary.GetName():
 				transactionKeeper.authenticateAuxiliary = value
 			}
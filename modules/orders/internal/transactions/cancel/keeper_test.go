@@ -0,0 +1,175 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package cancel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/balance"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/mint"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type TestKeepers struct {
+	OrdersKeeper helpers.TransactionKeeper
+	MetasModule  helpers.Module
+	SplitsModule helpers.Module
+}
+
+func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	metasStoreKey := sdkTypes.NewKVStoreKey("testMetas")
+	splitsStoreKey := sdkTypes.NewKVStoreKey("testSplits")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	metasModule := metas.Prototype().Initialize(metasStoreKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+	splitsModule := splits.Prototype().Initialize(splitsStoreKey, paramsKeeper.Subspace(splits.Prototype().Name()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(metasStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(splitsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	for _, module := range []helpers.Module{metasModule, splitsModule} {
+		for _, parameter := range module.GetParameters().GetList() {
+			module.GetParameters().Mutate(context, parameter)
+		}
+	}
+
+	keepers := TestKeepers{
+		OrdersKeeper: keeperPrototype().Initialize(Mapper, nil, []interface{}{
+			authenticate.AuxiliaryMock.Initialize(Mapper, nil),
+			metasModule.GetAuxiliary(supplement.Auxiliary.GetName()),
+			splitsModule.GetAuxiliary(release.Auxiliary.GetName()),
+		}).(helpers.TransactionKeeper),
+		MetasModule:  metasModule,
+		SplitsModule: splitsModule,
+	}
+
+	return context, keepers
+}
+
+func Test_transactionKeeper_Transact(t *testing.T) {
+	context, keepers := CreateTestInput(t)
+	defaultAddr := sdkTypes.AccAddress("addr")
+	verifyMockErrorAddress := sdkTypes.AccAddress("verifyError")
+
+	makerID := baseIDs.NewID("makerID")
+	makerOwnableID := baseIDs.NewID("makerOwnableID")
+	orderMapper := keepers.OrdersKeeper.(transactionKeeper).mapper
+
+	require.Equal(t, true, keepers.SplitsModule.GetAuxiliary(mint.Auxiliary.GetName()).GetKeeper().Help(context, mint.NewAuxiliaryRequest(makerID, makerOwnableID, sdkTypes.NewDec(100))).IsSuccessful())
+
+	// an untriggered stop order of the maker, with its maker ownable split held in escrow as the stop transaction leaves it
+	mutableProperties, err := scrub.GetPropertiesFromResponse(keepers.MetasModule.GetAuxiliary(scrub.Auxiliary.GetName()).GetKeeper().Help(context, scrub.NewAuxiliaryRequest(
+		baseProperties.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(baseTypes.NewHeight(100))),
+		baseProperties.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(sdkTypes.NewDec(10))),
+	)))
+	require.Nil(t, err)
+
+	orderID := key.NewOrderID(baseIDs.NewID("classificationID"), makerOwnableID, baseIDs.NewID("takerOwnableID"), baseIDs.NewID(sdkTypes.NewDec(2).QuoTruncate(sdkTypes.SmallestDec()).String()), baseIDs.NewID("1"), makerID, baseLists.NewPropertyList())
+	stopKey := key.FromStopID(key.NewStopID(orderID, baseIDs.NewID(sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec()).String())))
+	orderMapper.NewCollection(context).Add(mappable.NewStop(mappable.NewOrder(orderID, baseLists.NewPropertyList(), mutableProperties), sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec())))
+	require.Equal(t, true, keepers.SplitsModule.GetAuxiliary(lock.Auxiliary.GetName()).GetKeeper().Help(context, lock.NewAuxiliaryRequest(baseIDs.NewID(module.Name), orderID, makerID, makerOwnableID, sdkTypes.NewDec(10))).IsSuccessful())
+
+	getBalance := func() sdkTypes.Dec {
+		value, err := balance.GetValueFromResponse(keepers.SplitsModule.GetAuxiliary(balance.Auxiliary.GetName()).GetKeeper().Help(context, balance.NewAuxiliaryRequest(makerID, makerOwnableID)))
+		require.Nil(t, err)
+
+		return value
+	}
+
+	t.Run("NegativeCase-Verify Identity Failure", func(t *testing.T) {
+		want := newTransactionResponse(errors.MockError)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(verifyMockErrorAddress, makerID, orderID)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Order Not Found", func(t *testing.T) {
+		want := newTransactionResponse(errors.EntityNotFound)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, baseIDs.NewID("orderID"))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Not Maker Of Stop", func(t *testing.T) {
+		want := newTransactionResponse(errors.NotAuthorized)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, baseIDs.NewID("otherID"), orderID)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.NotNil(t, orderMapper.NewCollection(context).Fetch(stopKey).Get(stopKey))
+		require.Equal(t, sdkTypes.NewDec(90), getBalance())
+	})
+
+	t.Run("PositiveCase-Cancel Untriggered Stop", func(t *testing.T) {
+		want := newTransactionResponse(nil)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, orderID)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Nil(t, orderMapper.NewCollection(context).Fetch(stopKey).Get(stopKey))
+		require.Equal(t, sdkTypes.NewDec(100), getBalance())
+	})
+
+	t.Run("NegativeCase-Stop Already Cancelled", func(t *testing.T) {
+		want := newTransactionResponse(errors.EntityNotFound)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, orderID)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Equal(t, sdkTypes.NewDec(100), getBalance())
+	})
+}
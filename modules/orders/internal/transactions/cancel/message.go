@@ -63,3 +63,8 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, orderID ids.ID) sdkType
 		OrderID: orderID,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, orderID ids.ID) sdkTypes.Msg {
+	return newMessage(from, fromID, orderID)
+}
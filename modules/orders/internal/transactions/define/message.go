@@ -83,3 +83,8 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, immutableMetaProperties
 		MutableProperties:       mutableProperties,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, immutableMetaProperties lists.MetaPropertyList, immutableProperties lists.PropertyList, mutableMetaProperties lists.MetaPropertyList, mutableProperties lists.PropertyList) sdkTypes.Msg {
+	return newMessage(from, fromID, immutableMetaProperties, immutableProperties, mutableMetaProperties, mutableProperties)
+}
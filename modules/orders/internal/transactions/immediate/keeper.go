@@ -9,20 +9,27 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
 	"github.com/AssetMantle/modules/modules/orders/internal/key"
 	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
 	"github.com/AssetMantle/modules/modules/orders/internal/module"
-	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/expiry"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/matches"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/settle"
 	"github.com/AssetMantle/modules/schema/data"
 	baseData "github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
 	"github.com/AssetMantle/modules/schema/lists/base"
-	"github.com/AssetMantle/modules/schema/mappables"
 	base2 "github.com/AssetMantle/modules/schema/properties/base"
 	"github.com/AssetMantle/modules/schema/properties/constants"
 	baseTypes "github.com/AssetMantle/modules/schema/types/base"
@@ -32,9 +39,13 @@ type transactionKeeper struct {
 	mapper                helpers.Mapper
 	parameters            helpers.Parameters
 	conformAuxiliary      helpers.Auxiliary
+	memberAuxiliary       helpers.Auxiliary
+	royaltyAuxiliary      helpers.Auxiliary
 	scrubAuxiliary        helpers.Auxiliary
 	supplementAuxiliary   helpers.Auxiliary
-	transferAuxiliary     helpers.Auxiliary
+	lockAuxiliary         helpers.Auxiliary
+	releaseAuxiliary      helpers.Auxiliary
+	settleAuxiliary       helpers.Auxiliary
 	authenticateAuxiliary helpers.Auxiliary
 }
 
@@ -42,11 +53,11 @@ var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
 
 func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
 	message := messageFromInterface(msg)
-	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
-		return newTransactionResponse(auxiliaryResponse.GetError())
+	if message.ExpiresIn.Get() > transactionKeeper.parameters.Fetch(context, expiry.ID).Get(expiry.ID).GetData().(data.DecData).Get().TruncateInt64() {
+		return newTransactionResponse(errors.InvalidRequest)
 	}
 
-	if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(message.FromID, baseIDs.NewID(module.Name), message.MakerOwnableID, message.MakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
 		return newTransactionResponse(auxiliaryResponse.GetError())
 	}
 
@@ -57,6 +68,10 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	immutableProperties := base.NewPropertyList(append(immutableMetaProperties.GetList(), message.ImmutableProperties.GetList()...)...)
 	exchangeRate := message.TakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(message.MakerOwnableSplit)
+	if Error := utilities.ValidateExchangeRate(exchangeRate); Error != nil {
+		return newTransactionResponse(Error)
+	}
+
 	orderID := key.NewOrderID(message.ClassificationID, message.MakerOwnableID, message.TakerOwnableID, baseIDs.NewID(exchangeRate.String()), baseIDs.NewID(strconv.FormatInt(context.BlockHeight(), 10)), message.FromID, immutableProperties)
 	orders := transactionKeeper.mapper.NewCollection(context).Fetch(key.FromID(orderID))
 
@@ -64,7 +79,28 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		return newTransactionResponse(errors.EntityAlreadyExists)
 	}
 
-	mutableMetaProperties := message.MutableMetaProperties.Add(base2.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(baseTypes.NewHeight(message.ExpiresIn.Get()+context.BlockHeight()))))
+	// the charge rates and the auction interval only depend on the immutable properties, so they are checked before the escrow is locked
+	immutableOrder := mappable.NewOrder(orderID, immutableProperties, base.NewPropertyList())
+
+	// orders whose maker would be left nothing of what it receives once charged fees and royalties are refused
+	if Error := utilities.ValidateChargeRates(context, transactionKeeper.parameters, transactionKeeper.memberAuxiliary, transactionKeeper.supplementAuxiliary, transactionKeeper.royaltyAuxiliary, immutableOrder); Error != nil {
+		return newTransactionResponse(Error)
+	}
+
+	// orders of classifications cleared by batch auctions are not matched on placement
+	if auctionInterval, Error := utilities.GetAuctionInterval(context, transactionKeeper.parameters, transactionKeeper.memberAuxiliary, transactionKeeper.supplementAuxiliary, immutableOrder); Error != nil {
+		return newTransactionResponse(Error)
+	} else if auctionInterval != 0 {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
+	if auxiliaryResponse := transactionKeeper.lockAuxiliary.GetKeeper().Help(context, lock.NewAuxiliaryRequest(baseIDs.NewID(module.Name), orderID, message.FromID, message.MakerOwnableID, message.MakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	expiryHeight := baseTypes.NewHeight(message.ExpiresIn.Get() + context.BlockHeight())
+
+	mutableMetaProperties := message.MutableMetaProperties.Add(base2.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(expiryHeight)))
 	mutableMetaProperties = mutableMetaProperties.Add(base2.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(message.MakerOwnableSplit)))
 
 	scrubbedMutableMetaProperties, Error := scrub.GetPropertiesFromResponse(transactionKeeper.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(mutableMetaProperties.GetList()...)))
@@ -78,105 +114,46 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		return newTransactionResponse(auxiliaryResponse.GetError())
 	}
 
-	order := mappable.NewOrder(orderID, immutableProperties, mutableProperties)
-	orders = orders.Add(order)
+	timeInForceProperties, Error := scrub.GetPropertiesFromResponse(transactionKeeper.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(base2.NewMetaProperty(constants.TimeInForceProperty.GetKey(), baseData.NewIDData(message.TimeInForce)))))
+	if Error != nil {
+		return newTransactionResponse(Error)
+	}
 
-	// Order execution
-	orderMutated := false
-	orderLeftOverMakerOwnableSplit := message.MakerOwnableSplit
+	mutableProperties = mutableProperties.Add(timeInForceProperties.GetList()...)
 
-	orderExchangeRate := order.GetExchangeRate().GetData().(data.DecData).Get()
+	order := mappable.NewOrder(orderID, immutableProperties, mutableProperties)
 
-	accumulator := func(mappableOrder helpers.Mappable) bool {
-		executableOrder := mappableOrder.(mappables.Order)
+	orders.Add(order)
+	transactionKeeper.mapper.NewCollection(context).Add(mappable.NewExpiry(expiryHeight, order.GetID()))
 
-		executableOrderExchangeRate := executableOrder.GetExchangeRate().GetData().(data.DecData).Get()
+	utilities.EmitOrderMadeEvent(context, order, message.MakerOwnableSplit)
 
-		executableOrderMetaProperties, Error := supplement.GetMetaPropertiesFromResponse(transactionKeeper.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(executableOrder.GetMakerOwnableSplit(), executableOrder.GetExpiry())))
-		if Error != nil {
-			panic(Error)
-		}
+	// orders are filled against the book index of the opposite book in price time priority, stopping at its first order that does not cross
+	// them and after as many fills as the matches a block attempts
+	matcher := utilities.NewMatcher(transactionKeeper.mapper, transactionKeeper.parameters, transactionKeeper.memberAuxiliary, transactionKeeper.royaltyAuxiliary, transactionKeeper.scrubAuxiliary, transactionKeeper.settleAuxiliary, transactionKeeper.supplementAuxiliary)
 
-		var executableOrderMakerOwnableSplit sdkTypes.Dec
+	if message.TimeInForce.Compare(utilities.PostOnly) == 0 {
+		return newTransactionResponse(utilities.ValidatePostOnly(context, transactionKeeper.mapper, transactionKeeper.supplementAuxiliary, order))
+	}
 
-		if makerOwnableSplitProperty := executableOrderMetaProperties.GetMetaProperty(constants.MakerOwnableSplitProperty); makerOwnableSplitProperty != nil {
-			executableOrderMakerOwnableSplit = makerOwnableSplitProperty.GetData().(data.DecData).Get()
-		} else {
-			panic(errors.MetaDataError)
-		}
+	filledOrder, makerOwnableSplit, _, Error := matcher.FillOrder(context, order, message.MakerOwnableSplit, transactionKeeper.parameters.Fetch(context, matches.ID).Get(matches.ID).GetData().(data.DecData).Get().TruncateInt64())
+	if Error != nil {
+		return newTransactionResponse(Error)
+	}
 
-		executableOrderTakerOwnableSplitDemanded := executableOrderExchangeRate.MulTruncate(executableOrderMakerOwnableSplit).MulTruncate(sdkTypes.SmallestDec())
-
-		if orderExchangeRate.MulTruncate(executableOrderExchangeRate).MulTruncate(sdkTypes.SmallestDec()).MulTruncate(sdkTypes.SmallestDec()).LTE(sdkTypes.OneDec()) {
-			switch {
-			case orderLeftOverMakerOwnableSplit.GT(executableOrderTakerOwnableSplitDemanded):
-				// sending to buyer
-				if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.GetMakerID(), order.GetTakerOwnableID(), executableOrderMakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-					panic(auxiliaryResponse.GetError())
-				}
-				// sending to executableOrder
-				if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), executableOrder.GetMakerID(), order.GetMakerOwnableID(), executableOrderTakerOwnableSplitDemanded)); !auxiliaryResponse.IsSuccessful() {
-					panic(auxiliaryResponse.GetError())
-				}
-
-				orderLeftOverMakerOwnableSplit = orderLeftOverMakerOwnableSplit.Sub(executableOrderTakerOwnableSplitDemanded)
-
-				orders.Remove(executableOrder)
-			case orderLeftOverMakerOwnableSplit.LT(executableOrderTakerOwnableSplitDemanded):
-				// sending to buyer
-				sendToBuyer := orderLeftOverMakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(executableOrderExchangeRate)
-				if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.GetMakerID(), order.GetTakerOwnableID(), sendToBuyer)); !auxiliaryResponse.IsSuccessful() {
-					panic(auxiliaryResponse.GetError())
-				}
-				// sending to executableOrder
-				if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), executableOrder.GetMakerID(), order.GetMakerOwnableID(), orderLeftOverMakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-					panic(auxiliaryResponse.GetError())
-				}
-
-				mutableProperties, Error := scrub.GetPropertiesFromResponse(transactionKeeper.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(base2.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(executableOrderMakerOwnableSplit.Sub(sendToBuyer))))))
-				if Error != nil {
-					panic(Error)
-				}
-
-				orders.Mutate(mappable.NewOrder(executableOrder.GetID(), executableOrder.GetImmutablePropertyList(), executableOrder.GetMutablePropertyList().Mutate(mutableProperties.GetList()...)))
-
-				orderLeftOverMakerOwnableSplit = sdkTypes.ZeroDec()
-			default:
-				// case orderLeftOverMakerOwnableSplit.Equal(executableOrderTakerOwnableSplitDemanded):
-				// sending to buyer
-				if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.GetMakerID(), order.GetTakerOwnableID(), executableOrderMakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-					panic(auxiliaryResponse.GetError())
-				}
-				// sending to seller
-				if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), executableOrder.GetMakerID(), order.GetMakerOwnableID(), orderLeftOverMakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-					panic(auxiliaryResponse.GetError())
-				}
-
-				orders.Remove(executableOrder)
-
-				orderLeftOverMakerOwnableSplit = sdkTypes.ZeroDec()
+	if filledOrder != nil && makerOwnableSplit.IsPositive() {
+		switch {
+		case message.TimeInForce.Compare(utilities.FillOrKill) == 0:
+			return newTransactionResponse(errors.InvalidRequest)
+		case message.TimeInForce.Compare(utilities.ImmediateOrCancel) == 0:
+			if auxiliaryResponse := transactionKeeper.releaseAuxiliary.GetKeeper().Help(context, release.NewAuxiliaryRequest(baseIDs.NewID(module.Name), filledOrder.GetID(), makerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+				return newTransactionResponse(auxiliaryResponse.GetError())
 			}
 
-			orderMutated = true
-		}
-
-		if orderLeftOverMakerOwnableSplit.Equal(sdkTypes.ZeroDec()) {
-			orders.Remove(order)
-			return true
-		}
-
-		return false
-	}
-
-	orders.Iterate(key.FromID(key.NewOrderID(order.GetClassificationID(), order.GetTakerOwnableID(), order.GetMakerOwnableID(), baseIDs.NewID(""), baseIDs.NewID(""), baseIDs.NewID(""), base.NewPropertyList())), accumulator)
+			transactionKeeper.mapper.NewCollection(context).Remove(filledOrder)
 
-	if !orderLeftOverMakerOwnableSplit.Equal(sdkTypes.ZeroDec()) && orderMutated {
-		mutableProperties, Error := scrub.GetPropertiesFromResponse(transactionKeeper.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(base2.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(orderLeftOverMakerOwnableSplit)))))
-		if Error != nil {
-			return newTransactionResponse(Error)
+			utilities.EmitOrderClosedEvent(context, events.OrderCancelled, filledOrder, makerOwnableSplit)
 		}
-
-		orders.Mutate(mappable.NewOrder(orderID, order.GetImmutablePropertyList(), order.GetMutablePropertyList().Mutate(mutableProperties.GetList()...)))
 	}
 
 	return newTransactionResponse(nil)
@@ -191,12 +168,20 @@ func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, par
 			switch value.GetName() {
 			case conform.Auxiliary.GetName():
 				transactionKeeper.conformAuxiliary = value
+			case member.Auxiliary.GetName():
+				transactionKeeper.memberAuxiliary = value
+			case royalty.Auxiliary.GetName():
+				transactionKeeper.royaltyAuxiliary = value
 			case scrub.Auxiliary.GetName():
 				transactionKeeper.scrubAuxiliary = value
 			case supplement.Auxiliary.GetName():
 				transactionKeeper.supplementAuxiliary = value
-			case transfer.Auxiliary.GetName():
-				transactionKeeper.transferAuxiliary = value
+			case lock.Auxiliary.GetName():
+				transactionKeeper.lockAuxiliary = value
+			case release.Auxiliary.GetName():
+				transactionKeeper.releaseAuxiliary = value
+			case settle.Auxiliary.GetName():
+				transactionKeeper.settleAuxiliary = value
 			case authenticate.Auxiliary.GetName():
 				transactionKeeper.authenticateAuxiliary = value
 			}
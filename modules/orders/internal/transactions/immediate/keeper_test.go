@@ -0,0 +1,263 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package immediate
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/fees"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/matches"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/balance"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/mint"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/settle"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type TestKeepers struct {
+	OrdersKeeper helpers.TransactionKeeper
+	MetasModule  helpers.Module
+	SplitsModule helpers.Module
+}
+
+func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	metasStoreKey := sdkTypes.NewKVStoreKey("testMetas")
+	splitsStoreKey := sdkTypes.NewKVStoreKey("testSplits")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test").WithKeyTable(parameters.Prototype().GetKeyTable()))
+	metasModule := metas.Prototype().Initialize(metasStoreKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+	splitsModule := splits.Prototype().Initialize(splitsStoreKey, paramsKeeper.Subspace(splits.Prototype().Name()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(metasStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(splitsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	for _, parameter := range Parameters.GetList() {
+		Parameters.Mutate(context, parameter)
+	}
+
+	// fees are waived so that fills move whole splits
+	Parameters.Mutate(context, fees.Parameter.Mutate(baseData.NewDecData(sdkTypes.ZeroDec())))
+
+	for _, module := range []helpers.Module{metasModule, splitsModule} {
+		for _, parameter := range module.GetParameters().GetList() {
+			module.GetParameters().Mutate(context, parameter)
+		}
+	}
+
+	keepers := TestKeepers{
+		OrdersKeeper: keeperPrototype().Initialize(Mapper, Parameters, []interface{}{
+			authenticate.AuxiliaryMock.Initialize(Mapper, nil),
+			conform.AuxiliaryMock.Initialize(Mapper, nil),
+			member.AuxiliaryMock.Initialize(Mapper, nil),
+			royalty.AuxiliaryMock.Initialize(Mapper, nil),
+			metasModule.GetAuxiliary(scrub.Auxiliary.GetName()),
+			metasModule.GetAuxiliary(supplement.Auxiliary.GetName()),
+			splitsModule.GetAuxiliary(lock.Auxiliary.GetName()),
+			splitsModule.GetAuxiliary(release.Auxiliary.GetName()),
+			splitsModule.GetAuxiliary(settle.Auxiliary.GetName()),
+		}).(helpers.TransactionKeeper),
+		MetasModule:  metasModule,
+		SplitsModule: splitsModule,
+	}
+
+	return context, keepers
+}
+
+func Test_transactionKeeper_Transact(t *testing.T) {
+	context, keepers := CreateTestInput(t)
+	context = context.WithBlockHeight(10)
+	defaultAddr := sdkTypes.AccAddress("addr")
+
+	classificationID := baseIDs.NewID("classificationID")
+	takerID := baseIDs.NewID("takerID")
+	orderMapper := keepers.OrdersKeeper.(transactionKeeper).mapper
+
+	mintSplit := func(context sdkTypes.Context, ownerID string, ownableID string, value sdkTypes.Dec) {
+		require.Equal(t, true, keepers.SplitsModule.GetAuxiliary(mint.Auxiliary.GetName()).GetKeeper().Help(context, mint.NewAuxiliaryRequest(baseIDs.NewID(ownerID), baseIDs.NewID(ownableID), value)).IsSuccessful())
+	}
+
+	getBalance := func(context sdkTypes.Context, ownerID string, ownableID string) sdkTypes.Dec {
+		value, err := balance.GetValueFromResponse(keepers.SplitsModule.GetAuxiliary(balance.Auxiliary.GetName()).GetKeeper().Help(context, balance.NewAuxiliaryRequest(baseIDs.NewID(ownerID), baseIDs.NewID(ownableID))))
+		require.Nil(t, err)
+
+		return value
+	}
+
+	// addOrder rests an order of the maker selling the maker ownable split for the taker ownable at the taker ownable split it demands per
+	// maker ownable split, made at the height and held in escrow
+	addOrder := func(context sdkTypes.Context, makerID string, makerOwnableID string, takerOwnableID string, price sdkTypes.Dec, height int64, makerOwnableSplit sdkTypes.Dec) mappables.Order {
+		mutableProperties, err := scrub.GetPropertiesFromResponse(keepers.MetasModule.GetAuxiliary(scrub.Auxiliary.GetName()).GetKeeper().Help(context, scrub.NewAuxiliaryRequest(
+			baseProperties.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(baseTypes.NewHeight(1000))),
+			baseProperties.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(makerOwnableSplit)),
+		)))
+		require.Nil(t, err)
+
+		orderID := key.NewOrderID(classificationID, baseIDs.NewID(makerOwnableID), baseIDs.NewID(takerOwnableID), baseIDs.NewID(price.QuoTruncate(sdkTypes.SmallestDec()).String()), baseIDs.NewID(strconv.FormatInt(height, 10)), baseIDs.NewID(makerID), baseLists.NewPropertyList())
+		order := mappable.NewOrder(orderID, baseLists.NewPropertyList(), mutableProperties)
+
+		mintSplit(context, makerID, makerOwnableID, makerOwnableSplit)
+		require.Equal(t, true, keepers.SplitsModule.GetAuxiliary(lock.Auxiliary.GetName()).GetKeeper().Help(context, lock.NewAuxiliaryRequest(baseIDs.NewID(module.Name), orderID, baseIDs.NewID(makerID), baseIDs.NewID(makerOwnableID), makerOwnableSplit)).IsSuccessful())
+		orderMapper.NewCollection(context).Add(order)
+
+		return order
+	}
+
+	hasOrder := func(context sdkTypes.Context, order mappables.Order) bool {
+		return orderMapper.NewCollection(context).Fetch(order.GetKey()).Get(order.GetKey()) != nil
+	}
+
+	newTestMessage := func(makerOwnableSplit int64, takerOwnableSplit int64, timeInForce ids.ID) sdkTypes.Msg {
+		return newMessage(defaultAddr, takerID, classificationID, baseIDs.NewID("a"), baseIDs.NewID("b"), baseTypes.NewHeight(100), sdkTypes.NewDec(makerOwnableSplit), sdkTypes.NewDec(takerOwnableSplit), timeInForce, baseLists.NewMetaPropertyList(), baseLists.NewPropertyList(), baseLists.NewMetaPropertyList(), baseLists.NewPropertyList())
+	}
+
+	getImmediateOrderKey := func(makerOwnableSplit int64, takerOwnableSplit int64) helpers.Key {
+		return key.FromID(key.NewOrderID(classificationID, baseIDs.NewID("a"), baseIDs.NewID("b"), baseIDs.NewID(sdkTypes.NewDec(takerOwnableSplit).QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(sdkTypes.NewDec(makerOwnableSplit)).String()), baseIDs.NewID(strconv.FormatInt(context.BlockHeight(), 10)), takerID, baseLists.NewPropertyList()))
+	}
+
+	mintSplit(context, "takerID", "a", sdkTypes.NewDec(100))
+
+	laterOrder := addOrder(context, "later", "b", "a", sdkTypes.OneDec(), 2, sdkTypes.NewDec(10))
+	earlierOrder := addOrder(context, "earlier", "b", "a", sdkTypes.OneDec(), 1, sdkTypes.NewDec(10))
+	uncrossedOrder := addOrder(context, "uncrossed", "b", "a", sdkTypes.NewDec(3), 1, sdkTypes.NewDec(10))
+
+	// the key of the order of another book starts with the key prefix of the book as the IDs in keys are not delimited
+	collidingOrder := addOrder(context, "colliding", "ba", "c", sdkTypes.OneDec(), 1, sdkTypes.NewDec(10))
+
+	t.Run("NegativeCase-Exchange Rate Above Bound", func(t *testing.T) {
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, takerID, classificationID, baseIDs.NewID("a"), baseIDs.NewID("b"), baseTypes.NewHeight(100), sdkTypes.SmallestDec(), sdkTypes.NewDec(2), utilities.GoodTillCancelled, baseLists.NewMetaPropertyList(), baseLists.NewPropertyList(), baseLists.NewMetaPropertyList(), baseLists.NewPropertyList())); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Equal(t, sdkTypes.NewDec(100), getBalance(context, "takerID", "a"))
+	})
+
+	t.Run("NegativeCase-Post Only Crossing", func(t *testing.T) {
+		cacheContext, _ := context.CacheContext()
+
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.OrdersKeeper.Transact(cacheContext, newTestMessage(5, 5, utilities.PostOnly)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("PositiveCase-Post Only Rests", func(t *testing.T) {
+		cacheContext, _ := context.CacheContext()
+
+		want := newTransactionResponse(nil)
+		if got := keepers.OrdersKeeper.Transact(cacheContext, newTestMessage(10, 40, utilities.PostOnly)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		order, ok := orderMapper.NewCollection(cacheContext).Fetch(getImmediateOrderKey(10, 40)).Get(getImmediateOrderKey(10, 40)).(mappables.Order)
+		require.Equal(t, true, ok)
+
+		timeInForce, err := utilities.GetTimeInForce(cacheContext, keepers.MetasModule.GetAuxiliary(supplement.Auxiliary.GetName()), order)
+		require.Nil(t, err)
+		require.Equal(t, utilities.PostOnly, timeInForce)
+		require.Equal(t, true, hasOrder(cacheContext, earlierOrder))
+	})
+
+	t.Run("NegativeCase-Fill Or Kill Unfilled", func(t *testing.T) {
+		cacheContext, _ := context.CacheContext()
+
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.OrdersKeeper.Transact(cacheContext, newTestMessage(30, 30, utilities.FillOrKill)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("PositiveCase-Match Limit", func(t *testing.T) {
+		cacheContext, _ := context.CacheContext()
+		keepers.OrdersKeeper.(transactionKeeper).parameters.Mutate(cacheContext, matches.Parameter.Mutate(baseData.NewDecData(sdkTypes.OneDec())))
+
+		want := newTransactionResponse(nil)
+		if got := keepers.OrdersKeeper.Transact(cacheContext, newTestMessage(20, 20, utilities.GoodTillCancelled)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Equal(t, false, hasOrder(cacheContext, earlierOrder))
+		require.Equal(t, true, hasOrder(cacheContext, laterOrder))
+		require.Equal(t, sdkTypes.NewDec(10), getBalance(cacheContext, "takerID", "b"))
+		require.NotNil(t, orderMapper.NewCollection(cacheContext).Fetch(getImmediateOrderKey(20, 20)).Get(getImmediateOrderKey(20, 20)))
+	})
+
+	t.Run("PositiveCase-Immediate Or Cancel In Price Time Priority", func(t *testing.T) {
+		want := newTransactionResponse(nil)
+		if got := keepers.OrdersKeeper.Transact(context, newTestMessage(30, 30, utilities.ImmediateOrCancel)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Equal(t, false, hasOrder(context, earlierOrder))
+		require.Equal(t, false, hasOrder(context, laterOrder))
+		require.Equal(t, true, hasOrder(context, uncrossedOrder))
+		require.Equal(t, true, hasOrder(context, collidingOrder))
+		require.Nil(t, orderMapper.NewCollection(context).Fetch(getImmediateOrderKey(30, 30)).Get(getImmediateOrderKey(30, 30)))
+		require.Equal(t, sdkTypes.NewDec(20), getBalance(context, "takerID", "b"))
+		require.Equal(t, sdkTypes.ZeroDec(), getBalance(context, "takerID", "ba"))
+		require.Equal(t, sdkTypes.NewDec(80), getBalance(context, "takerID", "a"))
+		require.Equal(t, sdkTypes.NewDec(10), getBalance(context, "earlier", "a"))
+	})
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/AssetMantle/modules/constants/errors"
 	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
 	"github.com/AssetMantle/modules/schema/helpers"
 	"github.com/AssetMantle/modules/schema/ids"
 	"github.com/AssetMantle/modules/schema/lists"
@@ -29,6 +30,7 @@ type message struct {
 	ExpiresIn               types.Height           `json:"expiresIn" valid:"required~required field expiresIn missing"`
 	MakerOwnableSplit       sdkTypes.Dec           `json:"makerOwnableSplit" valid:"required~required field makerOwnableSplit missing"`
 	TakerOwnableSplit       sdkTypes.Dec           `json:"takerOwnableSplit" valid:"required~required field takerOwnableSplit missing"`
+	TimeInForce             ids.ID                 `json:"timeInForce" valid:"required~required field timeInForce missing"`
 	ImmutableMetaProperties lists.MetaPropertyList `json:"immutableMetaProperties" valid:"required~required field immutableMetaProperties missing"`
 	ImmutableProperties     lists.PropertyList     `json:"immutableProperties" valid:"required~required field immutableProperties missing"`
 	MutableMetaProperties   lists.MetaPropertyList `json:"mutableMetaProperties" valid:"required~required field mutableMetaProperties missing"`
@@ -53,6 +55,10 @@ func (message message) ValidateBasic() error {
 		return sdkErrors.Wrap(errors.IncorrectMessage, "")
 	}
 
+	if !utilities.IsTimeInForce(message.TimeInForce) {
+		return sdkErrors.Wrap(errors.IncorrectMessage, "")
+	}
+
 	return nil
 }
 func (message message) GetSignBytes() []byte {
@@ -88,7 +94,7 @@ func messagePrototype() helpers.Message {
 	return message{}
 }
 
-func newMessage(from sdkTypes.AccAddress, fromID ids.ID, classificationID ids.ID, makerOwnableID ids.ID, takerOwnableID ids.ID, expiresIn types.Height, makerOwnableSplit sdkTypes.Dec, takerOwnableSplit sdkTypes.Dec, immutableMetaProperties lists.MetaPropertyList, immutableProperties lists.PropertyList, mutableMetaProperties lists.MetaPropertyList, mutableProperties lists.PropertyList) sdkTypes.Msg {
+func newMessage(from sdkTypes.AccAddress, fromID ids.ID, classificationID ids.ID, makerOwnableID ids.ID, takerOwnableID ids.ID, expiresIn types.Height, makerOwnableSplit sdkTypes.Dec, takerOwnableSplit sdkTypes.Dec, timeInForce ids.ID, immutableMetaProperties lists.MetaPropertyList, immutableProperties lists.PropertyList, mutableMetaProperties lists.MetaPropertyList, mutableProperties lists.PropertyList) sdkTypes.Msg {
 	return message{
 		From:                    from,
 		FromID:                  fromID,
@@ -98,9 +104,15 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, classificationID ids.ID
 		ExpiresIn:               expiresIn,
 		MakerOwnableSplit:       makerOwnableSplit,
 		TakerOwnableSplit:       takerOwnableSplit,
+		TimeInForce:             timeInForce,
 		ImmutableMetaProperties: immutableMetaProperties,
 		ImmutableProperties:     immutableProperties,
 		MutableMetaProperties:   mutableMetaProperties,
 		MutableProperties:       mutableProperties,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, classificationID ids.ID, makerOwnableID ids.ID, takerOwnableID ids.ID, expiresIn types.Height, makerOwnableSplit sdkTypes.Dec, takerOwnableSplit sdkTypes.Dec, timeInForce ids.ID, immutableMetaProperties lists.MetaPropertyList, immutableProperties lists.PropertyList, mutableMetaProperties lists.MetaPropertyList, mutableProperties lists.PropertyList) sdkTypes.Msg {
+	return newMessage(from, fromID, classificationID, makerOwnableID, takerOwnableID, expiresIn, makerOwnableSplit, takerOwnableSplit, timeInForce, immutableMetaProperties, immutableProperties, mutableMetaProperties, mutableProperties)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/AssetMantle/modules/constants/errors"
 	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	orderUtilities "github.com/AssetMantle/modules/modules/orders/internal/utilities"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
 	"github.com/AssetMantle/modules/schema/lists/utilities"
 	baseTypes "github.com/AssetMantle/modules/schema/types/base"
@@ -27,6 +28,7 @@ func Test_Make_Message(t *testing.T) {
 	makerOwnableSplit := sdkTypes.NewDec(2)
 	takerOwnableSplit, _ := sdkTypes.NewDecFromStr("2000000000000000000")
 	zeroTakerOwnableSplit, _ := sdkTypes.NewDecFromStr("0")
+	timeInForce := orderUtilities.ImmediateOrCancel
 
 	fromAddress := "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c"
 	fromAccAddress, err := sdkTypes.AccAddressFromBech32(fromAddress)
@@ -41,8 +43,8 @@ func Test_Make_Message(t *testing.T) {
 	mutableProperties, err := utilities.ReadProperties("defaultMutable1:S|defaultMutable1")
 	require.Equal(t, nil, err)
 
-	testMessage := newMessage(fromAccAddress, fromID, classificationID, makerOwnableID, takerOwnableID, expiresIn, makerOwnableSplit, takerOwnableSplit, immutableMetaProperties, immutableProperties, mutableMetaProperties, mutableProperties)
-	require.Equal(t, message{From: fromAccAddress, FromID: fromID, ClassificationID: classificationID, MakerOwnableID: makerOwnableID, TakerOwnableID: takerOwnableID, ExpiresIn: expiresIn, TakerOwnableSplit: takerOwnableSplit, MakerOwnableSplit: makerOwnableSplit, ImmutableMetaProperties: immutableMetaProperties, ImmutableProperties: immutableProperties, MutableMetaProperties: mutableMetaProperties, MutableProperties: mutableProperties}, testMessage)
+	testMessage := newMessage(fromAccAddress, fromID, classificationID, makerOwnableID, takerOwnableID, expiresIn, makerOwnableSplit, takerOwnableSplit, timeInForce, immutableMetaProperties, immutableProperties, mutableMetaProperties, mutableProperties)
+	require.Equal(t, message{From: fromAccAddress, FromID: fromID, ClassificationID: classificationID, MakerOwnableID: makerOwnableID, TakerOwnableID: takerOwnableID, ExpiresIn: expiresIn, TakerOwnableSplit: takerOwnableSplit, MakerOwnableSplit: makerOwnableSplit, TimeInForce: timeInForce, ImmutableMetaProperties: immutableMetaProperties, ImmutableProperties: immutableProperties, MutableMetaProperties: mutableMetaProperties, MutableProperties: mutableProperties}, testMessage)
 	require.Equal(t, module.Name, testMessage.Route())
 	require.Equal(t, Transaction.GetName(), testMessage.Type())
 	require.Equal(t, nil, testMessage.ValidateBasic())
@@ -52,7 +54,8 @@ func Test_Make_Message(t *testing.T) {
 	require.Equal(t, testMessage, messageFromInterface(testMessage))
 	require.Equal(t, message{}, messageFromInterface(nil))
 	require.Equal(t, message{}, messagePrototype())
-	require.Error(t, sdkErrors.Wrap(errors.IncorrectMessage, ""), newMessage(fromAccAddress, fromID, classificationID, makerOwnableID, takerOwnableID, expiresIn, makerOwnableSplit, zeroTakerOwnableSplit, immutableMetaProperties, immutableProperties, mutableMetaProperties, mutableProperties).ValidateBasic())
-	require.Error(t, sdkErrors.Wrap(errors.IncorrectMessage, ""), newMessage(fromAccAddress, fromID, classificationID, makerOwnableID, makerOwnableID, expiresIn, makerOwnableSplit, takerOwnableSplit, immutableMetaProperties, immutableProperties, mutableMetaProperties, mutableProperties).ValidateBasic())
+	require.Error(t, sdkErrors.Wrap(errors.IncorrectMessage, ""), newMessage(fromAccAddress, fromID, classificationID, makerOwnableID, takerOwnableID, expiresIn, makerOwnableSplit, zeroTakerOwnableSplit, timeInForce, immutableMetaProperties, immutableProperties, mutableMetaProperties, mutableProperties).ValidateBasic())
+	require.Error(t, sdkErrors.Wrap(errors.IncorrectMessage, ""), newMessage(fromAccAddress, fromID, classificationID, makerOwnableID, makerOwnableID, expiresIn, makerOwnableSplit, takerOwnableSplit, timeInForce, immutableMetaProperties, immutableProperties, mutableMetaProperties, mutableProperties).ValidateBasic())
+	require.Error(t, sdkErrors.Wrap(errors.IncorrectMessage, ""), newMessage(fromAccAddress, fromID, classificationID, makerOwnableID, takerOwnableID, expiresIn, makerOwnableSplit, takerOwnableSplit, baseIDs.NewID("timeInForce"), immutableMetaProperties, immutableProperties, mutableMetaProperties, mutableProperties).ValidateBasic())
 
 }
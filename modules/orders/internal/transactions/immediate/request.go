@@ -12,6 +12,7 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
+	orderUtilities "github.com/AssetMantle/modules/modules/orders/internal/utilities"
 	"github.com/AssetMantle/modules/schema/helpers"
 	"github.com/AssetMantle/modules/schema/helpers/constants"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
@@ -29,6 +30,7 @@ type transactionRequest struct {
 	ExpiresIn               int64        `json:"expiresIn" valid:"required~required field expiresIn missing, matches(^[0-9]+$)~invalid field expiresIn"`
 	MakerOwnableSplit       string       `json:"makerOwnableSplit" valid:"required~required field makerOwnableSplit missing, matches(^[0-9.]+$)~invalid field makerOwnableSplit"`
 	TakerOwnableSplit       string       `json:"takerOwnableSplit" valid:"required~required field takerOwnableSplit missing, matches(^[0-9.]+$)~invalid field takerOwnableSplit"`
+	TimeInForce             string       `json:"timeInForce" valid:"optional,matches(^[A-Z]+$)~invalid field timeInForce"`
 	ImmutableMetaProperties string       `json:"immutableMetaProperties" valid:"required~required field immutableMetaProperties missing, matches(^.*$)~invalid field immutableMetaProperties"`
 	ImmutableProperties     string       `json:"immutableProperties" valid:"required~required field immutableProperties missing, matches(^.*$)~invalid field immutableProperties"`
 	MutableMetaProperties   string       `json:"mutableMetaProperties" valid:"required~required field mutableMetaProperties missing, matches(^.*$)~invalid field mutableMetaProperties"`
@@ -61,6 +63,7 @@ func (transactionRequest transactionRequest) FromCLI(cliCommand helpers.CLIComma
 		cliCommand.ReadInt64(constants.ExpiresIn),
 		cliCommand.ReadString(constants.MakerOwnableSplit),
 		cliCommand.ReadString(constants.TakerOwnableSplit),
+		cliCommand.ReadString(constants.TimeInForce),
 		cliCommand.ReadString(constants.ImmutableMetaProperties),
 		cliCommand.ReadString(constants.ImmutableProperties),
 		cliCommand.ReadString(constants.MutableMetaProperties),
@@ -94,6 +97,11 @@ func (transactionRequest transactionRequest) MakeMsg() (sdkTypes.Msg, error) {
 		return nil, err
 	}
 
+	timeInForce := orderUtilities.GoodTillCancelled
+	if transactionRequest.TimeInForce != "" {
+		timeInForce = baseIDs.NewID(transactionRequest.TimeInForce)
+	}
+
 	immutableMetaProperties, err := utilities.ReadMetaProperties(transactionRequest.ImmutableMetaProperties)
 	if err != nil {
 		return nil, err
@@ -123,6 +131,7 @@ func (transactionRequest transactionRequest) MakeMsg() (sdkTypes.Msg, error) {
 		baseTypes.NewHeight(transactionRequest.ExpiresIn),
 		makerOwnableSplit,
 		takerOwnableSplit,
+		timeInForce,
 		immutableMetaProperties,
 		immutableProperties,
 		mutableMetaProperties,
@@ -136,7 +145,7 @@ func requestPrototype() helpers.TransactionRequest {
 	return transactionRequest{}
 }
 
-func newTransactionRequest(baseReq rest.BaseReq, fromID string, classificationID string, makerOwnableID string, takerOwnableID string, expiresIn int64, makerOwnableSplit, takerOwnableSplit string, immutableMetaProperties string, immutableProperties string, mutableMetaProperties string, mutableProperties string) helpers.TransactionRequest {
+func newTransactionRequest(baseReq rest.BaseReq, fromID string, classificationID string, makerOwnableID string, takerOwnableID string, expiresIn int64, makerOwnableSplit, takerOwnableSplit string, timeInForce string, immutableMetaProperties string, immutableProperties string, mutableMetaProperties string, mutableProperties string) helpers.TransactionRequest {
 	return transactionRequest{
 		BaseReq:                 baseReq,
 		FromID:                  fromID,
@@ -146,6 +155,7 @@ func newTransactionRequest(baseReq rest.BaseReq, fromID string, classificationID
 		ExpiresIn:               expiresIn,
 		MakerOwnableSplit:       makerOwnableSplit,
 		TakerOwnableSplit:       takerOwnableSplit,
+		TimeInForce:             timeInForce,
 		ImmutableMetaProperties: immutableMetaProperties,
 		ImmutableProperties:     immutableProperties,
 		MutableMetaProperties:   mutableMetaProperties,
@@ -9,7 +9,9 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/maintainers/auxiliaries/verify"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
@@ -17,7 +19,10 @@ import (
 	"github.com/AssetMantle/modules/modules/orders/internal/key"
 	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
 	"github.com/AssetMantle/modules/modules/orders/internal/module"
-	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/expiry"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/schema/data"
 	baseData "github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
@@ -31,9 +36,11 @@ type transactionKeeper struct {
 	mapper                     helpers.Mapper
 	parameters                 helpers.Parameters
 	conformAuxiliary           helpers.Auxiliary
+	memberAuxiliary            helpers.Auxiliary
+	royaltyAuxiliary           helpers.Auxiliary
 	scrubAuxiliary             helpers.Auxiliary
 	supplementAuxiliary        helpers.Auxiliary
-	transferAuxiliary          helpers.Auxiliary
+	lockAuxiliary              helpers.Auxiliary
 	authenticateAuxiliary      helpers.Auxiliary
 	maintainersVerifyAuxiliary helpers.Auxiliary
 }
@@ -42,15 +49,15 @@ var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
 
 func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
 	message := messageFromInterface(msg)
-	if auxiliaryResponse := transactionKeeper.maintainersVerifyAuxiliary.GetKeeper().Help(context, verify.NewAuxiliaryRequest(message.ClassificationID, message.FromID)); !auxiliaryResponse.IsSuccessful() {
-		return newTransactionResponse(auxiliaryResponse.GetError())
+	if message.ExpiresIn.Get() > transactionKeeper.parameters.Fetch(context, expiry.ID).Get(expiry.ID).GetData().(data.DecData).Get().TruncateInt64() {
+		return newTransactionResponse(errors.InvalidRequest)
 	}
 
-	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
+	if auxiliaryResponse := transactionKeeper.maintainersVerifyAuxiliary.GetKeeper().Help(context, verify.NewAuxiliaryRequest(message.ClassificationID, message.FromID)); !auxiliaryResponse.IsSuccessful() {
 		return newTransactionResponse(auxiliaryResponse.GetError())
 	}
 
-	if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(message.FromID, baseIDs.NewID(module.Name), message.MakerOwnableID, message.MakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
 		return newTransactionResponse(auxiliaryResponse.GetError())
 	}
 
@@ -62,16 +69,30 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 	immutableProperties := base.NewPropertyList(append(immutableMetaProperties.GetList(), message.ImmutableProperties.GetList()...)...)
 
 	exchangeRate := message.TakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(message.MakerOwnableSplit)
+	if Error := utilities.ValidateExchangeRate(exchangeRate); Error != nil {
+		return newTransactionResponse(Error)
+	}
+
 	orderID := key.NewOrderID(message.ClassificationID, message.MakerOwnableID, message.TakerOwnableID, baseIDs.NewID(exchangeRate.String()), baseIDs.NewID(strconv.FormatInt(context.BlockHeight(), 10)), message.FromID, immutableProperties)
 	orders := transactionKeeper.mapper.NewCollection(context).Fetch(key.FromID(orderID))
 	makerOwnableSplit := message.MakerOwnableSplit
 
-	order := orders.Get(key.FromID(orderID))
-	if order != nil {
+	if orders.Get(key.FromID(orderID)) != nil {
 		return newTransactionResponse(errors.EntityAlreadyExists)
 	}
 
-	mutableMetaProperties := message.MutableMetaProperties.Add(base2.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(baseTypes.NewHeight(message.ExpiresIn.Get()+context.BlockHeight()))))
+	// orders whose maker would be left nothing of what it receives once charged fees and royalties are refused
+	if Error := utilities.ValidateChargeRates(context, transactionKeeper.parameters, transactionKeeper.memberAuxiliary, transactionKeeper.supplementAuxiliary, transactionKeeper.royaltyAuxiliary, mappable.NewOrder(orderID, immutableProperties, base.NewPropertyList())); Error != nil {
+		return newTransactionResponse(Error)
+	}
+
+	if auxiliaryResponse := transactionKeeper.lockAuxiliary.GetKeeper().Help(context, lock.NewAuxiliaryRequest(baseIDs.NewID(module.Name), orderID, message.FromID, message.MakerOwnableID, makerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	expiryHeight := baseTypes.NewHeight(message.ExpiresIn.Get() + context.BlockHeight())
+
+	mutableMetaProperties := message.MutableMetaProperties.Add(base2.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(expiryHeight)))
 	mutableMetaProperties = mutableMetaProperties.Add(base2.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(makerOwnableSplit)))
 
 	scrubbedMutableMetaProperties, Error := scrub.GetPropertiesFromResponse(transactionKeeper.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(mutableMetaProperties.GetList()...)))
@@ -85,7 +106,18 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		return newTransactionResponse(auxiliaryResponse.GetError())
 	}
 
-	orders.Add(mappable.NewOrder(orderID, immutableProperties, mutableProperties))
+	timeInForceProperties, Error := scrub.GetPropertiesFromResponse(transactionKeeper.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(base2.NewMetaProperty(constants.TimeInForceProperty.GetKey(), baseData.NewIDData(message.TimeInForce)))))
+	if Error != nil {
+		return newTransactionResponse(Error)
+	}
+
+	mutableProperties = mutableProperties.Add(timeInForceProperties.GetList()...)
+
+	order := mappable.NewOrder(orderID, immutableProperties, mutableProperties)
+	orders.Add(order)
+	transactionKeeper.mapper.NewCollection(context).Add(mappable.NewExpiry(expiryHeight, order.GetID()))
+
+	utilities.EmitOrderMadeEvent(context, order, makerOwnableSplit)
 
 	return newTransactionResponse(nil)
 }
@@ -99,12 +131,16 @@ func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, par
 			switch value.GetName() {
 			case conform.Auxiliary.GetName():
 				transactionKeeper.conformAuxiliary = value
+			case member.Auxiliary.GetName():
+				transactionKeeper.memberAuxiliary = value
+			case royalty.Auxiliary.GetName():
+				transactionKeeper.royaltyAuxiliary = value
 			case scrub.Auxiliary.GetName():
 				transactionKeeper.scrubAuxiliary = value
 			case supplement.Auxiliary.GetName():
 				transactionKeeper.supplementAuxiliary = value
-			case transfer.Auxiliary.GetName():
-				transactionKeeper.transferAuxiliary = value
+			case lock.Auxiliary.GetName():
+				transactionKeeper.lockAuxiliary = value
 			case authenticate.Auxiliary.GetName():
 				transactionKeeper.authenticateAuxiliary = value
 			case verify.Auxiliary.GetName():
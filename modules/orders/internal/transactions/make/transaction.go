@@ -23,6 +23,7 @@ var Transaction = baseHelpers.NewTransaction(
 	constants.TakerOwnableSplit,
 	constants.TakerOwnableID,
 	constants.ExpiresIn,
+	constants.TimeInForce,
 	constants.ImmutableMetaProperties,
 	constants.ImmutableProperties,
 	constants.MutableMetaProperties,
@@ -7,6 +7,7 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
@@ -14,7 +15,10 @@ import (
 	"github.com/AssetMantle/modules/modules/orders/internal/key"
 	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
 	"github.com/AssetMantle/modules/modules/orders/internal/module"
-	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/expiry"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
 	"github.com/AssetMantle/modules/schema/data"
 	baseData "github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
@@ -31,7 +35,8 @@ type transactionKeeper struct {
 	conformAuxiliary      helpers.Auxiliary
 	scrubAuxiliary        helpers.Auxiliary
 	supplementAuxiliary   helpers.Auxiliary
-	transferAuxiliary     helpers.Auxiliary
+	lockAuxiliary         helpers.Auxiliary
+	releaseAuxiliary      helpers.Auxiliary
 	authenticateAuxiliary helpers.Auxiliary
 }
 
@@ -39,6 +44,10 @@ var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
 
 func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
 	message := messageFromInterface(msg)
+	if message.ExpiresIn.Get() > transactionKeeper.parameters.Fetch(context, expiry.ID).Get(expiry.ID).GetData().(data.DecData).Get().TruncateInt64() {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
 	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
 		return newTransactionResponse(auxiliaryResponse.GetError())
 	}
@@ -51,33 +60,24 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 	}
 	order := Mappable.(mappables.Order)
 
-	metaProperties, Error := supplement.GetMetaPropertiesFromResponse(transactionKeeper.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(order.GetMakerOwnableSplit())))
-	if Error != nil {
-		return newTransactionResponse(Error)
+	if message.FromID.Compare(order.GetMakerID()) != 0 {
+		return newTransactionResponse(errors.NotAuthorized)
 	}
 
-	transferMakerOwnableSplit := sdkTypes.ZeroDec()
-
-	if makerOwnableSplitProperty := metaProperties.GetMetaProperty(constants.MakerOwnableSplitProperty); makerOwnableSplitProperty != nil {
-		oldMakerOwnableSplit := makerOwnableSplitProperty.GetData().(data.DecData).Get()
-
-		transferMakerOwnableSplit = message.MakerOwnableSplit.Sub(oldMakerOwnableSplit)
-	} else {
-		return newTransactionResponse(errors.MetaDataError)
+	makerOwnableSplit, Error := utilities.GetMakerOwnableSplit(context, transactionKeeper.supplementAuxiliary, order)
+	if Error != nil {
+		return newTransactionResponse(Error)
 	}
 
-	if transferMakerOwnableSplit.LT(sdkTypes.ZeroDec()) {
-		if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), message.FromID, order.GetMakerOwnableID(), transferMakerOwnableSplit.Abs())); !auxiliaryResponse.IsSuccessful() {
-			return newTransactionResponse(auxiliaryResponse.GetError())
-		}
-	} else if transferMakerOwnableSplit.GT(sdkTypes.ZeroDec()) {
-		if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(message.FromID, baseIDs.NewID(module.Name), order.GetMakerOwnableID(), transferMakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-			return newTransactionResponse(auxiliaryResponse.GetError())
-		}
+	exchangeRate := message.TakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(message.MakerOwnableSplit)
+	if Error := utilities.ValidateExchangeRate(exchangeRate); Error != nil {
+		return newTransactionResponse(Error)
 	}
 
 	mutableMetaProperties := message.MutableMetaProperties.Add(base.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(message.MakerOwnableSplit)))
-	mutableMetaProperties = mutableMetaProperties.Add(base.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(baseTypes.NewHeight(message.ExpiresIn.Get()+context.BlockHeight()))))
+	expiryHeight := baseTypes.NewHeight(message.ExpiresIn.Get() + context.BlockHeight())
+
+	mutableMetaProperties = mutableMetaProperties.Add(base.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(expiryHeight)))
 
 	scrubbedMutableMetaProperties, Error := scrub.GetPropertiesFromResponse(transactionKeeper.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(mutableMetaProperties.GetList()...)))
 	if Error != nil {
@@ -86,22 +86,52 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	updatedMutables := order.GetMutablePropertyList().Mutate(append(scrubbedMutableMetaProperties.GetList(), message.MutableProperties.GetList()...)...)
 
-	if auxiliaryResponse := transactionKeeper.conformAuxiliary.GetKeeper().Help(context, conform.NewAuxiliaryRequest(order.GetClassificationID(), order.GetImmutablePropertyList(), updatedMutables)); !auxiliaryResponse.IsSuccessful() {
+	if auxiliaryResponse := transactionKeeper.conformAuxiliary.GetKeeper().Help(context, conform.NewAuxiliaryRequest(order.GetClassificationID(), order.GetImmutablePropertyList(), utilities.WithoutTimeInForce(updatedMutables))); !auxiliaryResponse.IsSuccessful() {
 		return newTransactionResponse(auxiliaryResponse.GetError())
 	}
 
-	orders.Remove(order)
-	orders.Add(mappable.NewOrder(
+	modifiedOrder := mappable.NewOrder(
 		key.NewOrderID(
 			order.GetClassificationID(),
 			order.GetMakerOwnableID(),
 			order.GetTakerOwnableID(),
-			baseIDs.NewID(message.TakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(message.MakerOwnableSplit).String()),
+			baseIDs.NewID(exchangeRate.String()),
 			baseIDs.NewID(order.GetCreation().GetData().String()),
 			order.GetMakerID(), order.GetImmutablePropertyList(),
 		),
 		order.GetImmutablePropertyList(),
-		updatedMutables),
+		updatedMutables,
+	)
+
+	if modifiedOrder.GetID().Compare(order.GetID()) != 0 && orders.Fetch(key.FromID(modifiedOrder.GetID())).Get(key.FromID(modifiedOrder.GetID())) != nil {
+		return newTransactionResponse(errors.EntityAlreadyExists)
+	}
+
+	if Error := utilities.ValidatePostOnly(context, transactionKeeper.mapper, transactionKeeper.supplementAuxiliary, modifiedOrder); Error != nil {
+		return newTransactionResponse(Error)
+	}
+
+	// the escrow follows the order to its new ID
+	if auxiliaryResponse := transactionKeeper.releaseAuxiliary.GetKeeper().Help(context, release.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.GetID(), makerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	if auxiliaryResponse := transactionKeeper.lockAuxiliary.GetKeeper().Help(context, lock.NewAuxiliaryRequest(baseIDs.NewID(module.Name), modifiedOrder.GetID(), order.GetMakerID(), order.GetMakerOwnableID(), message.MakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	orders.Remove(order)
+	orders.Add(modifiedOrder)
+	transactionKeeper.mapper.NewCollection(context).Add(mappable.NewExpiry(expiryHeight, modifiedOrder.GetID()))
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.OrderModified,
+			sdkTypes.NewAttribute(events.AttributeKeyOrderID, order.GetID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyModifiedOrderID, modifiedOrder.GetID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableSplit, message.MakerOwnableSplit.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyExchangeRate, modifiedOrder.GetExchangeRate().GetData().(data.DecData).Get().String()),
+		),
 	)
 
 	return newTransactionResponse(nil)
@@ -120,8 +150,10 @@ func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, par
 				transactionKeeper.scrubAuxiliary = value
 			case supplement.Auxiliary.GetName():
 				transactionKeeper.supplementAuxiliary = value
-			case transfer.Auxiliary.GetName():
-				transactionKeeper.transferAuxiliary = value
+			case lock.Auxiliary.GetName():
+				transactionKeeper.lockAuxiliary = value
+			case release.Auxiliary.GetName():
+				transactionKeeper.releaseAuxiliary = value
 			case authenticate.Auxiliary.GetName():
 				transactionKeeper.authenticateAuxiliary = value
 			}
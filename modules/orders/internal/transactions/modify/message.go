@@ -91,3 +91,8 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, orderID ids.ID, takerOw
 		MutableProperties:     mutableProperties,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, orderID ids.ID, takerOwnableSplit sdkTypes.Dec, makerOwnableSplit sdkTypes.Dec, expiresIn types.Height, mutableMetaProperties lists.MetaPropertyList, mutableProperties lists.PropertyList) sdkTypes.Msg {
+	return newMessage(from, fromID, orderID, takerOwnableSplit, makerOwnableSplit, expiresIn, mutableMetaProperties, mutableProperties)
+}
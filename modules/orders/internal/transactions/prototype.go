@@ -4,6 +4,7 @@
 package transactions
 
 import (
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/amend"
 	"github.com/AssetMantle/modules/modules/orders/internal/transactions/cancel"
 	"github.com/AssetMantle/modules/modules/orders/internal/transactions/define"
 	"github.com/AssetMantle/modules/modules/orders/internal/transactions/deputize"
@@ -11,6 +12,7 @@ import (
 	"github.com/AssetMantle/modules/modules/orders/internal/transactions/make"
 	"github.com/AssetMantle/modules/modules/orders/internal/transactions/modify"
 	"github.com/AssetMantle/modules/modules/orders/internal/transactions/revoke"
+	"github.com/AssetMantle/modules/modules/orders/internal/transactions/stop"
 	"github.com/AssetMantle/modules/modules/orders/internal/transactions/take"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
@@ -18,6 +20,7 @@ import (
 
 func Prototype() helpers.Transactions {
 	return baseHelpers.NewTransactions(
+		amend.Transaction,
 		cancel.Transaction,
 		define.Transaction,
 		deputize.Transaction,
@@ -25,6 +28,7 @@ func Prototype() helpers.Transactions {
 		make.Transaction,
 		modify.Transaction,
 		revoke.Transaction,
+		stop.Transaction,
 		take.Transaction,
 	)
 }
@@ -65,3 +65,8 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, toID ids.ID, classifica
 		ClassificationID: classificationID,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, toID ids.ID, classificationID ids.ID) sdkTypes.Msg {
+	return newMessage(from, fromID, toID, classificationID)
+}
@@ -0,0 +1,177 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package stop
+
+import (
+	"strconv"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/maintainers/auxiliaries/verify"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/expiry"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/schema/data"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/lists/base"
+	base2 "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type transactionKeeper struct {
+	mapper                     helpers.Mapper
+	parameters                 helpers.Parameters
+	conformAuxiliary           helpers.Auxiliary
+	memberAuxiliary            helpers.Auxiliary
+	royaltyAuxiliary           helpers.Auxiliary
+	scrubAuxiliary             helpers.Auxiliary
+	supplementAuxiliary        helpers.Auxiliary
+	lockAuxiliary              helpers.Auxiliary
+	authenticateAuxiliary      helpers.Auxiliary
+	maintainersVerifyAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
+
+func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
+	message := messageFromInterface(msg)
+	if message.ExpiresIn.Get() > transactionKeeper.parameters.Fetch(context, expiry.ID).Get(expiry.ID).GetData().(data.DecData).Get().TruncateInt64() {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
+	if auxiliaryResponse := transactionKeeper.maintainersVerifyAuxiliary.GetKeeper().Help(context, verify.NewAuxiliaryRequest(message.ClassificationID, message.FromID)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	immutableMetaProperties, Error := scrub.GetPropertiesFromResponse(transactionKeeper.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(message.ImmutableMetaProperties.GetList()...)))
+	if Error != nil {
+		return newTransactionResponse(Error)
+	}
+
+	immutableProperties := base.NewPropertyList(append(immutableMetaProperties.GetList(), message.ImmutableProperties.GetList()...)...)
+
+	exchangeRate := message.TakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(message.MakerOwnableSplit)
+	if Error := utilities.ValidateExchangeRate(exchangeRate); Error != nil {
+		return newTransactionResponse(Error)
+	}
+
+	orderID := key.NewOrderID(message.ClassificationID, message.MakerOwnableID, message.TakerOwnableID, baseIDs.NewID(exchangeRate.String()), baseIDs.NewID(strconv.FormatInt(context.BlockHeight(), 10)), message.FromID, immutableProperties)
+	orders := transactionKeeper.mapper.NewCollection(context).Fetch(key.FromID(orderID))
+	makerOwnableSplit := message.MakerOwnableSplit
+
+	if _, found := utilities.GetStop(context, transactionKeeper.mapper, orderID); found || orders.Get(key.FromID(orderID)) != nil {
+		return newTransactionResponse(errors.EntityAlreadyExists)
+	}
+
+	// the charge rates and the auction interval only depend on the immutable properties, so they are checked before the escrow is locked
+	immutableOrder := mappable.NewOrder(orderID, immutableProperties, base.NewPropertyList())
+
+	// orders whose maker would be left nothing of what it receives once charged fees and royalties are refused
+	if Error := utilities.ValidateChargeRates(context, transactionKeeper.parameters, transactionKeeper.memberAuxiliary, transactionKeeper.supplementAuxiliary, transactionKeeper.royaltyAuxiliary, immutableOrder); Error != nil {
+		return newTransactionResponse(Error)
+	}
+
+	// stop orders of classifications cleared by batch auctions could not fill once triggered
+	if message.TimeInForce.Compare(utilities.ImmediateOrCancel) == 0 {
+		if auctionInterval, Error := utilities.GetAuctionInterval(context, transactionKeeper.parameters, transactionKeeper.memberAuxiliary, transactionKeeper.supplementAuxiliary, immutableOrder); Error != nil {
+			return newTransactionResponse(Error)
+		} else if auctionInterval != 0 {
+			return newTransactionResponse(errors.InvalidRequest)
+		}
+	}
+
+	if auxiliaryResponse := transactionKeeper.lockAuxiliary.GetKeeper().Help(context, lock.NewAuxiliaryRequest(baseIDs.NewID(module.Name), orderID, message.FromID, message.MakerOwnableID, makerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	expiryHeight := baseTypes.NewHeight(message.ExpiresIn.Get() + context.BlockHeight())
+
+	mutableMetaProperties := message.MutableMetaProperties.Add(base2.NewMetaProperty(constants.ExpiryProperty.GetKey(), baseData.NewHeightData(expiryHeight)))
+	mutableMetaProperties = mutableMetaProperties.Add(base2.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(makerOwnableSplit)))
+
+	scrubbedMutableMetaProperties, Error := scrub.GetPropertiesFromResponse(transactionKeeper.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(mutableMetaProperties.GetList()...)))
+	if Error != nil {
+		return newTransactionResponse(Error)
+	}
+
+	mutableProperties := base.NewPropertyList(append(scrubbedMutableMetaProperties.GetList(), message.MutableProperties.GetList()...)...)
+
+	if auxiliaryResponse := transactionKeeper.conformAuxiliary.GetKeeper().Help(context, conform.NewAuxiliaryRequest(message.ClassificationID, immutableProperties, mutableProperties)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	timeInForceProperties, Error := scrub.GetPropertiesFromResponse(transactionKeeper.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(base2.NewMetaProperty(constants.TimeInForceProperty.GetKey(), baseData.NewIDData(message.TimeInForce)))))
+	if Error != nil {
+		return newTransactionResponse(Error)
+	}
+
+	mutableProperties = mutableProperties.Add(timeInForceProperties.GetList()...)
+
+	order := mappable.NewOrder(orderID, immutableProperties, mutableProperties)
+
+	stop := mappable.NewStop(order, message.TriggerRate.QuoTruncate(sdkTypes.SmallestDec()))
+	orders.Add(stop)
+
+	// stops are only checked against last prices that have not been checked yet, so a last price already at the trigger rate is checked again
+	if lastPrice, found := utilities.GetLastPrice(context, transactionKeeper.mapper, order.GetMakerOwnableID(), order.GetTakerOwnableID()); found && lastPrice.IsChecked() && lastPrice.GetExchangeRate().LTE(stop.GetTriggerRate()) {
+		orders.Mutate(mappable.NewLastPrice(lastPrice.GetMakerOwnableID(), lastPrice.GetTakerOwnableID(), lastPrice.GetExchangeRate(), lastPrice.GetHeight()))
+	}
+	transactionKeeper.mapper.NewCollection(context).Add(mappable.NewExpiry(expiryHeight, order.GetID()))
+
+	utilities.EmitStopMadeEvent(context, stop, makerOwnableSplit)
+
+	return newTransactionResponse(nil)
+}
+
+func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	transactionKeeper.mapper, transactionKeeper.parameters = mapper, parameters
+
+	for _, externalKeeper := range auxiliaries {
+		switch value := externalKeeper.(type) {
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case conform.Auxiliary.GetName():
+				transactionKeeper.conformAuxiliary = value
+			case member.Auxiliary.GetName():
+				transactionKeeper.memberAuxiliary = value
+			case royalty.Auxiliary.GetName():
+				transactionKeeper.royaltyAuxiliary = value
+			case scrub.Auxiliary.GetName():
+				transactionKeeper.scrubAuxiliary = value
+			case supplement.Auxiliary.GetName():
+				transactionKeeper.supplementAuxiliary = value
+			case lock.Auxiliary.GetName():
+				transactionKeeper.lockAuxiliary = value
+			case authenticate.Auxiliary.GetName():
+				transactionKeeper.authenticateAuxiliary = value
+			case verify.Auxiliary.GetName():
+				transactionKeeper.maintainersVerifyAuxiliary = value
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return transactionKeeper
+}
+func keeperPrototype() helpers.TransactionKeeper {
+	return transactionKeeper{}
+}
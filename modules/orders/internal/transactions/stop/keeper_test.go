@@ -0,0 +1,227 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package stop
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/maintainers/auxiliaries/verify"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/balance"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/mint"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/lists"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type TestKeepers struct {
+	OrdersKeeper helpers.TransactionKeeper
+	MetasModule  helpers.Module
+	SplitsModule helpers.Module
+}
+
+func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	metasStoreKey := sdkTypes.NewKVStoreKey("testMetas")
+	splitsStoreKey := sdkTypes.NewKVStoreKey("testSplits")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test").WithKeyTable(parameters.Prototype().GetKeyTable()))
+	metasModule := metas.Prototype().Initialize(metasStoreKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+	splitsModule := splits.Prototype().Initialize(splitsStoreKey, paramsKeeper.Subspace(splits.Prototype().Name()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(metasStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(splitsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	for _, parameter := range Parameters.GetList() {
+		Parameters.Mutate(context, parameter)
+	}
+
+	for _, module := range []helpers.Module{metasModule, splitsModule} {
+		for _, parameter := range module.GetParameters().GetList() {
+			module.GetParameters().Mutate(context, parameter)
+		}
+	}
+
+	keepers := TestKeepers{
+		OrdersKeeper: keeperPrototype().Initialize(Mapper, Parameters, []interface{}{
+			authenticate.AuxiliaryMock.Initialize(Mapper, nil),
+			conform.AuxiliaryMock.Initialize(Mapper, nil),
+			member.AuxiliaryMock.Initialize(Mapper, nil),
+			royalty.AuxiliaryMock.Initialize(Mapper, nil),
+			verify.AuxiliaryMock.Initialize(Mapper, nil),
+			metasModule.GetAuxiliary(scrub.Auxiliary.GetName()),
+			metasModule.GetAuxiliary(supplement.Auxiliary.GetName()),
+			splitsModule.GetAuxiliary(lock.Auxiliary.GetName()),
+		}).(helpers.TransactionKeeper),
+		MetasModule:  metasModule,
+		SplitsModule: splitsModule,
+	}
+
+	return context, keepers
+}
+
+func Test_transactionKeeper_Transact(t *testing.T) {
+	context, keepers := CreateTestInput(t)
+	context = context.WithBlockHeight(10)
+	defaultAddr := sdkTypes.AccAddress("addr")
+	verifyMockErrorAddress := sdkTypes.AccAddress("verifyError")
+
+	classificationID := baseIDs.NewID("classificationID")
+	makerID := baseIDs.NewID("makerID")
+	makerOwnableID := baseIDs.NewID("makerOwnableID")
+	takerOwnableID := baseIDs.NewID("takerOwnableID")
+	orderMapper := keepers.OrdersKeeper.(transactionKeeper).mapper
+
+	require.Equal(t, true, keepers.SplitsModule.GetAuxiliary(mint.Auxiliary.GetName()).GetKeeper().Help(context, mint.NewAuxiliaryRequest(makerID, makerOwnableID, sdkTypes.NewDec(100))).IsSuccessful())
+
+	auctionIntervalMetaPropertyList := baseLists.NewMetaPropertyList(baseProperties.NewMetaProperty(constants.AuctionIntervalProperty.GetKey(), baseData.NewDecData(sdkTypes.NewDec(5))))
+
+	newTestMessage := func(from sdkTypes.AccAddress, timeInForce ids.ID, immutableMetaProperties lists.MetaPropertyList) sdkTypes.Msg {
+		return newMessage(from, makerID, classificationID, makerOwnableID, takerOwnableID, baseTypes.NewHeight(100), sdkTypes.NewDec(10), sdkTypes.NewDec(20), sdkTypes.NewDecWithPrec(15, 1), timeInForce, immutableMetaProperties, baseLists.NewPropertyList(), baseLists.NewMetaPropertyList(), baseLists.NewPropertyList())
+	}
+
+	orderID := key.NewOrderID(classificationID, makerOwnableID, takerOwnableID, baseIDs.NewID(sdkTypes.NewDec(2).QuoTruncate(sdkTypes.SmallestDec()).String()), baseIDs.NewID(strconv.FormatInt(context.BlockHeight(), 10)), makerID, baseLists.NewPropertyList())
+
+	getStop := func() mappables.Stop {
+		stop, _ := utilities.GetStop(context, orderMapper, orderID)
+		return stop
+	}
+
+	getBalance := func() sdkTypes.Dec {
+		value, err := balance.GetValueFromResponse(keepers.SplitsModule.GetAuxiliary(balance.Auxiliary.GetName()).GetKeeper().Help(context, balance.NewAuxiliaryRequest(makerID, makerOwnableID)))
+		require.Nil(t, err)
+
+		return value
+	}
+
+	// isEscrowed tells if the order ID holds an escrow of the value, releasing it on a cache of the context
+	isEscrowed := func(value sdkTypes.Dec) bool {
+		cacheContext, _ := context.CacheContext()
+		return keepers.SplitsModule.GetAuxiliary(release.Auxiliary.GetName()).GetKeeper().Help(cacheContext, release.NewAuxiliaryRequest(baseIDs.NewID(module.Name), orderID, value)).IsSuccessful()
+	}
+
+	t.Run("NegativeCase-Verify Identity Failure", func(t *testing.T) {
+		want := newTransactionResponse(errors.MockError)
+		if got := keepers.OrdersKeeper.Transact(context, newTestMessage(verifyMockErrorAddress, utilities.GoodTillCancelled, baseLists.NewMetaPropertyList())); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Equal(t, sdkTypes.NewDec(100), getBalance())
+	})
+
+	t.Run("NegativeCase-Immediate Or Cancel In Batch Auction", func(t *testing.T) {
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.OrdersKeeper.Transact(context, newTestMessage(defaultAddr, utilities.ImmediateOrCancel, auctionIntervalMetaPropertyList)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Equal(t, sdkTypes.NewDec(100), getBalance())
+	})
+
+	t.Run("NegativeCase-Exchange Rate Above Bound", func(t *testing.T) {
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, classificationID, makerOwnableID, takerOwnableID, baseTypes.NewHeight(100), sdkTypes.SmallestDec(), sdkTypes.NewDec(2), sdkTypes.NewDecWithPrec(15, 1), utilities.GoodTillCancelled, baseLists.NewMetaPropertyList(), baseLists.NewPropertyList(), baseLists.NewMetaPropertyList(), baseLists.NewPropertyList())); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Equal(t, sdkTypes.NewDec(100), getBalance())
+	})
+
+	t.Run("PositiveCase-Escrow Locked At Creation", func(t *testing.T) {
+		want := newTransactionResponse(nil)
+		if got := keepers.OrdersKeeper.Transact(context, newTestMessage(defaultAddr, utilities.GoodTillCancelled, baseLists.NewMetaPropertyList())); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		stop := getStop()
+		require.NotNil(t, stop)
+		require.Equal(t, sdkTypes.NewDecWithPrec(15, 1).QuoTruncate(sdkTypes.SmallestDec()), stop.GetTriggerRate())
+		require.Nil(t, orderMapper.NewCollection(context).Fetch(key.FromID(orderID)).Get(key.FromID(orderID)))
+		require.Equal(t, sdkTypes.NewDec(90), getBalance())
+		require.Equal(t, true, isEscrowed(sdkTypes.NewDec(10)))
+		require.Equal(t, false, isEscrowed(sdkTypes.NewDec(11)))
+	})
+
+	t.Run("NegativeCase-Stop Exists", func(t *testing.T) {
+		want := newTransactionResponse(errors.EntityAlreadyExists)
+		if got := keepers.OrdersKeeper.Transact(context, newTestMessage(defaultAddr, utilities.GoodTillCancelled, baseLists.NewMetaPropertyList())); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Equal(t, sdkTypes.NewDec(90), getBalance())
+	})
+
+	t.Run("PositiveCase-Trigger Rate Already Reached", func(t *testing.T) {
+		orderMapper.NewCollection(context).Add(mappable.NewCheckedLastPrice(makerOwnableID, takerOwnableID, sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec()), baseTypes.NewHeight(9)))
+
+		want := newTransactionResponse(nil)
+		if got := keepers.OrdersKeeper.Transact(context, newMessage(defaultAddr, makerID, classificationID, makerOwnableID, takerOwnableID, baseTypes.NewHeight(100), sdkTypes.NewDec(10), sdkTypes.NewDec(30), sdkTypes.NewDecWithPrec(15, 1), utilities.GoodTillCancelled, baseLists.NewMetaPropertyList(), baseLists.NewPropertyList(), baseLists.NewMetaPropertyList(), baseLists.NewPropertyList())); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		// the stops of the pair are to be checked again against its last price
+		lastPrice, found := utilities.GetLastPrice(context, orderMapper, makerOwnableID, takerOwnableID)
+		require.Equal(t, true, found)
+		require.Equal(t, false, lastPrice.IsChecked())
+	})
+}
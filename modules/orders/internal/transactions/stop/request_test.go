@@ -0,0 +1,117 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package stop
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/lists/utilities"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func Test_Stop_Request(t *testing.T) {
+
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+	cliCommand := baseHelpers.NewCLICommand("", "", "", []helpers.CLIFlag{constants.FromID, constants.ClassificationID, constants.MakerOwnableSplit, constants.MakerOwnableID, constants.TakerOwnableID, constants.ExpiresIn, constants.TakerOwnableSplit, constants.TriggerRate, constants.TimeInForce, constants.ImmutableMetaProperties, constants.ImmutableProperties, constants.MutableMetaProperties, constants.MutableProperties})
+	cliContext := context.NewCLIContext().WithCodec(Codec)
+
+	immutableMetaPropertiesString := "defaultImmutableMeta1:S|defaultImmutableMeta1"
+	immutablePropertiesString := "defaultMutableMeta1:S|defaultMutableMeta1"
+	mutableMetaPropertiesString := "defaultMutableMeta1:S|defaultMutableMeta1"
+	mutablePropertiesString := "defaultMutable1:S|defaultMutable1"
+
+	immutableMetaProperties, err := utilities.ReadMetaProperties(immutableMetaPropertiesString)
+	require.Equal(t, nil, err)
+	immutableProperties, err := utilities.ReadProperties(immutablePropertiesString)
+	require.Equal(t, nil, err)
+	mutableMetaProperties, err := utilities.ReadMetaProperties(mutableMetaPropertiesString)
+	require.Equal(t, nil, err)
+	mutableProperties, err := utilities.ReadProperties(mutablePropertiesString)
+	require.Equal(t, nil, err)
+
+	fromAddress := "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c"
+	fromAccAddress, err := sdkTypes.AccAddressFromBech32(fromAddress)
+	require.Nil(t, err)
+
+	testBaseReq := rest.BaseReq{From: fromAddress, ChainID: "test", Fees: sdkTypes.NewCoins()}
+	testTransactionRequest := newTransactionRequest(testBaseReq, "fromID", "classificationID", "makerOwnableID", "takerOwnableID", 123, "2", sdkTypes.OneDec().String(), "0.4", "IOC", immutableMetaPropertiesString, immutablePropertiesString, mutableMetaPropertiesString, mutablePropertiesString)
+
+	require.Equal(t, transactionRequest{BaseReq: testBaseReq, FromID: "fromID", ClassificationID: "classificationID", MakerOwnableID: "makerOwnableID", TakerOwnableID: "takerOwnableID", ExpiresIn: 123, MakerOwnableSplit: "2", TakerOwnableSplit: sdkTypes.OneDec().String(), TriggerRate: "0.4", TimeInForce: "IOC", ImmutableMetaProperties: immutableMetaPropertiesString, ImmutableProperties: immutablePropertiesString, MutableMetaProperties: mutableMetaPropertiesString, MutableProperties: mutablePropertiesString}, testTransactionRequest)
+	require.Equal(t, nil, testTransactionRequest.Validate())
+
+	requestFromCLI, err := transactionRequest{}.FromCLI(cliCommand, cliContext)
+	require.Equal(t, nil, err)
+	require.Equal(t, transactionRequest{BaseReq: rest.BaseReq{From: cliContext.GetFromAddress().String(), ChainID: cliContext.ChainID, Simulate: cliContext.Simulate}, FromID: "", ImmutableMetaProperties: "", ImmutableProperties: "", MutableMetaProperties: "", MutableProperties: ""}, requestFromCLI)
+
+	jsonMessage, _ := json.Marshal(testTransactionRequest)
+	transactionRequestUnmarshalled, error3 := transactionRequest{}.FromJSON(jsonMessage)
+	require.Equal(t, nil, error3)
+	require.Equal(t, testTransactionRequest, transactionRequestUnmarshalled)
+
+	randomUnmarshall, err := transactionRequest{}.FromJSON([]byte{})
+	require.Equal(t, nil, randomUnmarshall)
+	require.NotNil(t, err)
+
+	require.Equal(t, testBaseReq, testTransactionRequest.GetBaseReq())
+
+	msg, err := testTransactionRequest.MakeMsg()
+	require.Equal(t, newMessage(fromAccAddress, baseIDs.NewID("fromID"), baseIDs.NewID("classificationID"), baseIDs.NewID("makerOwnableID"), baseIDs.NewID("takerOwnableID"), baseTypes.NewHeight(123), sdkTypes.NewDec(2), sdkTypes.OneDec(), sdkTypes.NewDecWithPrec(4, 1), baseIDs.NewID("IOC"), immutableMetaProperties, immutableProperties, mutableMetaProperties, mutableProperties), msg)
+	require.Nil(t, err)
+
+	msg, err = newTransactionRequest(rest.BaseReq{From: "randomFromAddress", ChainID: "test"}, "fromID", "classificationID", "makerOwnableID", "takerOwnableID", 123, "2", sdkTypes.OneDec().String(), "0.4", "IOC", immutableMetaPropertiesString, immutablePropertiesString, mutableMetaPropertiesString, mutablePropertiesString).MakeMsg()
+	require.Equal(t, nil, msg)
+	require.NotNil(t, err)
+
+	msg, err = newTransactionRequest(rest.BaseReq{From: fromAddress, ChainID: "test"}, "fromID", "classificationID", "makerOwnableID", "takerOwnableID", 123, "randomInput", sdkTypes.OneDec().String(), "0.4", "IOC", immutableMetaPropertiesString, immutablePropertiesString, mutableMetaPropertiesString, mutablePropertiesString).MakeMsg()
+	require.Equal(t, nil, msg)
+	require.NotNil(t, err)
+
+	msg, err = newTransactionRequest(rest.BaseReq{From: fromAddress, ChainID: "test"}, "fromID", "classificationID", "makerOwnableID", "takerOwnableID", 123, "2", sdkTypes.OneDec().String(), "randomInput", "IOC", immutableMetaPropertiesString, immutablePropertiesString, mutableMetaPropertiesString, mutablePropertiesString).MakeMsg()
+	require.Equal(t, nil, msg)
+	require.NotNil(t, err)
+
+	msg, err = newTransactionRequest(rest.BaseReq{From: "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c", ChainID: "test", Fees: sdkTypes.NewCoins()}, "fromID", "classificationID", "makerOwnableID", "takerOwnableID", 123, "2", sdkTypes.OneDec().String(), "0.4", "IOC", "randomString", immutablePropertiesString, mutableMetaPropertiesString, mutablePropertiesString).MakeMsg()
+	require.Equal(t, nil, msg)
+	require.NotNil(t, err)
+
+	msg, err = newTransactionRequest(rest.BaseReq{From: "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c", ChainID: "test", Fees: sdkTypes.NewCoins()}, "fromID", "classificationID", "makerOwnableID", "takerOwnableID", 123, "2", sdkTypes.OneDec().String(), "0.4", "IOC", immutableMetaPropertiesString, "randomString", mutableMetaPropertiesString, mutablePropertiesString).MakeMsg()
+	require.Equal(t, nil, msg)
+	require.NotNil(t, err)
+
+	msg, err = newTransactionRequest(rest.BaseReq{From: "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c", ChainID: "test", Fees: sdkTypes.NewCoins()}, "fromID", "classificationID", "makerOwnableID", "takerOwnableID", 123, "2", sdkTypes.OneDec().String(), "0.4", "IOC", immutableMetaPropertiesString, immutablePropertiesString, "randomString", mutablePropertiesString).MakeMsg()
+	require.Equal(t, nil, msg)
+	require.NotNil(t, err)
+
+	msg, err = newTransactionRequest(rest.BaseReq{From: "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c", ChainID: "test", Fees: sdkTypes.NewCoins()}, "fromID", "classificationID", "makerOwnableID", "takerOwnableID", 123, "2", sdkTypes.OneDec().String(), "0.4", "IOC", immutableMetaPropertiesString, immutablePropertiesString, mutableMetaPropertiesString, "randomString").MakeMsg()
+	require.Equal(t, nil, msg)
+	require.NotNil(t, err)
+
+	msg, err = newTransactionRequest(rest.BaseReq{From: "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c", ChainID: "test", Fees: sdkTypes.NewCoins()}, "fromID", "classificationID", "makerOwnableID", "takerOwnableID", 123, "2", "test", "0.4", "IOC", immutableMetaPropertiesString, immutablePropertiesString, mutableMetaPropertiesString, "randomString").MakeMsg()
+	require.Equal(t, nil, msg)
+	require.NotNil(t, err)
+
+	require.Equal(t, transactionRequest{}, requestPrototype())
+	require.NotPanics(t, func() {
+		requestPrototype().RegisterCodec(codec.New())
+	})
+}
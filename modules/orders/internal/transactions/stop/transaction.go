@@ -0,0 +1,32 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package stop
+
+import (
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+)
+
+var Transaction = baseHelpers.NewTransaction(
+	"stop",
+	"",
+	"",
+
+	requestPrototype,
+	messagePrototype,
+	keeperPrototype,
+	constants.ClassificationID,
+	constants.FromID,
+	constants.MakerOwnableSplit,
+	constants.MakerOwnableID,
+	constants.TakerOwnableSplit,
+	constants.TakerOwnableID,
+	constants.TriggerRate,
+	constants.ExpiresIn,
+	constants.TimeInForce,
+	constants.ImmutableMetaProperties,
+	constants.ImmutableProperties,
+	constants.MutableMetaProperties,
+	constants.MutableProperties,
+)
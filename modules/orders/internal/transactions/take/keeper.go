@@ -7,12 +7,15 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
 	"github.com/AssetMantle/modules/modules/orders/internal/key"
 	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
-	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/utilities"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/settle"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
 	"github.com/AssetMantle/modules/schema/data"
 	baseData "github.com/AssetMantle/modules/schema/data/base"
@@ -26,8 +29,11 @@ import (
 type transactionKeeper struct {
 	mapper                helpers.Mapper
 	parameters            helpers.Parameters
+	memberAuxiliary       helpers.Auxiliary
+	royaltyAuxiliary      helpers.Auxiliary
 	scrubAuxiliary        helpers.Auxiliary
 	supplementAuxiliary   helpers.Auxiliary
+	settleAuxiliary       helpers.Auxiliary
 	transferAuxiliary     helpers.Auxiliary
 	authenticateAuxiliary helpers.Auxiliary
 }
@@ -48,6 +54,13 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 	}
 	order := Mutable.(mappables.Order)
 
+	// orders of classifications cleared by batch auctions can only be filled by the auctions
+	if auctionInterval, Error := utilities.GetAuctionInterval(context, transactionKeeper.parameters, transactionKeeper.memberAuxiliary, transactionKeeper.supplementAuxiliary, order); Error != nil {
+		return newTransactionResponse(Error)
+	} else if auctionInterval != 0 {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
 	metaProperties, Error := supplement.GetMetaPropertiesFromResponse(transactionKeeper.supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(order.GetTakerID(), order.GetMakerOwnableSplit())))
 	if Error != nil {
 		newTransactionResponse(Error)
@@ -99,14 +112,23 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		orders.Mutate(order)
 	}
 
-	if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(message.FromID, order.GetMakerID(), order.GetTakerOwnableID(), makerReceiveTakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-		return newTransactionResponse(auxiliaryResponse.GetError())
+	makerFeeRate, takerFeeRate, Error := utilities.GetFeeRates(context, transactionKeeper.parameters, transactionKeeper.memberAuxiliary, transactionKeeper.supplementAuxiliary, order)
+	if Error != nil {
+		return newTransactionResponse(Error)
 	}
 
-	if auxiliaryResponse := transactionKeeper.transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(baseIDs.NewID(module.Name), message.FromID, order.GetMakerOwnableID(), takerReceiveMakerOwnableSplit)); !auxiliaryResponse.IsSuccessful() {
-		return newTransactionResponse(auxiliaryResponse.GetError())
+	makerFee, makerRoyalty, Error := utilities.TransferProceeds(context, transactionKeeper.parameters, transactionKeeper.transferAuxiliary, transactionKeeper.royaltyAuxiliary, message.FromID, order.GetMakerID(), order.GetTakerOwnableID(), order.GetMakerOwnableID(), makerReceiveTakerOwnableSplit, makerFeeRate)
+	if Error != nil {
+		return newTransactionResponse(Error)
 	}
 
+	if _, _, Error := utilities.SettleProceeds(context, transactionKeeper.parameters, transactionKeeper.settleAuxiliary, transactionKeeper.royaltyAuxiliary, order, message.FromID, order.GetTakerOwnableID(), takerReceiveMakerOwnableSplit, takerFeeRate); Error != nil {
+		return newTransactionResponse(Error)
+	}
+
+	utilities.EmitOrderExecutedEvent(context, order, message.FromID, takerReceiveMakerOwnableSplit, makerReceiveTakerOwnableSplit, makerFee, makerRoyalty)
+	utilities.RecordTrade(context, transactionKeeper.mapper, order, message.FromID, takerReceiveMakerOwnableSplit, makerReceiveTakerOwnableSplit)
+
 	return newTransactionResponse(nil)
 }
 
@@ -117,10 +139,16 @@ func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, par
 		switch value := auxiliary.(type) {
 		case helpers.Auxiliary:
 			switch value.GetName() {
+			case member.Auxiliary.GetName():
+				transactionKeeper.memberAuxiliary = value
+			case royalty.Auxiliary.GetName():
+				transactionKeeper.royaltyAuxiliary = value
 			case scrub.Auxiliary.GetName():
 				transactionKeeper.scrubAuxiliary = value
 			case supplement.Auxiliary.GetName():
 				transactionKeeper.supplementAuxiliary = value
+			case settle.Auxiliary.GetName():
+				transactionKeeper.settleAuxiliary = value
 			case transfer.Auxiliary.GetName():
 				transactionKeeper.transferAuxiliary = value
 			case authenticate.Auxiliary.GetName():
@@ -65,3 +65,8 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, takerOwnableSplit sdkTy
 		OrderID:           orderID,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, takerOwnableSplit sdkTypes.Dec, orderID ids.ID) sdkTypes.Msg {
+	return newMessage(from, fromID, takerOwnableSplit, orderID)
+}
@@ -0,0 +1,35 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/auction"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+// GetAuctionInterval returns the number of blocks between the batch auctions clearing the orders of the classification of the order,
+// which is the interval the classification fixes in its immutable properties or else the module parameter, orders with an interval
+// of zero are matched continuously
+func GetAuctionInterval(context sdkTypes.Context, parameters helpers.Parameters, memberAuxiliary helpers.Auxiliary, supplementAuxiliary helpers.Auxiliary, order mappables.Order) (int64, error) {
+	auctionIntervalData, err := getClassificationData(context, memberAuxiliary, supplementAuxiliary, order, constants.AuctionIntervalProperty)
+	if err != nil {
+		return 0, err
+	}
+
+	if auctionIntervalData == nil {
+		return parameters.Fetch(context, auction.ID).Get(auction.ID).GetData().(data.DecData).Get().TruncateInt64(), nil
+	}
+
+	if auctionInterval := auctionIntervalData.(data.DecData).Get(); !auctionInterval.IsNegative() && auctionInterval.IsInteger() {
+		return auctionInterval.TruncateInt64(), nil
+	}
+
+	return 0, errors.InvalidParameter
+}
@@ -0,0 +1,59 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/define"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/auction"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/lists"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+func TestGetAuctionInterval(t *testing.T) {
+	context, Parameters, classificationsModule, metasModule := createFeesTestInput(t)
+	memberAuxiliary, supplementAuxiliary := classificationsModule.GetAuxiliary(member.Auxiliary.GetName()), metasModule.GetAuxiliary(supplement.Auxiliary.GetName())
+
+	scrubAuctionInterval := func(auctionInterval int64) lists.PropertyList {
+		propertyList, err := scrub.GetPropertiesFromResponse(metasModule.GetAuxiliary(scrub.Auxiliary.GetName()).GetKeeper().Help(context, scrub.NewAuxiliaryRequest(baseProperties.NewMetaProperty(constants.AuctionIntervalProperty.GetKey(), baseData.NewDecData(sdkTypes.NewDec(auctionInterval))))))
+		require.Nil(t, err)
+
+		return propertyList
+	}
+
+	classificationID, err := define.GetClassificationIDFromResponse(classificationsModule.GetAuxiliary(define.Auxiliary.GetName()).GetKeeper().Help(context, define.NewAuxiliaryRequest(scrubAuctionInterval(0), baseLists.NewPropertyList())))
+	require.Nil(t, err)
+
+	Parameters.Mutate(context, auction.Parameter.Mutate(baseData.NewDecData(sdkTypes.NewDec(5))))
+
+	for _, test := range []struct {
+		immutableProperties lists.PropertyList
+		want                int64
+	}{
+		{baseLists.NewPropertyList(), 5},
+		// the classification fixes continuous matching
+		{scrubAuctionInterval(0), 0},
+		// intervals the classification does not fix are not taken from the order
+		{scrubAuctionInterval(3), 5},
+	} {
+		order := mappable.NewOrder(key.NewOrderID(classificationID, baseIDs.NewID("maker"), baseIDs.NewID("taker"), baseIDs.NewID("2.000000000000000000"), baseIDs.NewID("1"), baseIDs.NewID("makerID"), test.immutableProperties), test.immutableProperties, baseLists.NewPropertyList())
+
+		auctionInterval, err := GetAuctionInterval(context, Parameters, memberAuxiliary, supplementAuxiliary, order)
+		require.Nil(t, err)
+		require.Equal(t, test.want, auctionInterval)
+	}
+}
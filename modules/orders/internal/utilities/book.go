@@ -0,0 +1,86 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+// Level aggregates the orders of a book resting at the same exchange rate
+type Level struct {
+	ExchangeRate      sdkTypes.Dec `json:"exchangeRate"`
+	MakerOwnableSplit sdkTypes.Dec `json:"makerOwnableSplit"`
+	Orders            int          `json:"orders"`
+}
+
+// GetBookLevels aggregates the orders of the classification offering the maker ownable for the taker ownable by exchange rate, lowest
+// exchange rate first, it returns at most limit levels when limit is positive along with the maker ownable split resting in the whole book
+func GetBookLevels(context sdkTypes.Context, mapper helpers.Mapper, supplementAuxiliary helpers.Auxiliary, classificationID ids.ID, makerOwnableID ids.ID, takerOwnableID ids.ID, limit int) ([]Level, sdkTypes.Dec, error) {
+	var levelList []Level
+
+	totalMakerOwnableSplit := sdkTypes.ZeroDec()
+
+	var err error
+
+	mapper.Iterate(context, key.FromID(key.NewOrderID(classificationID, makerOwnableID, takerOwnableID, baseIDs.NewID(""), baseIDs.NewID(""), baseIDs.NewID(""), base.NewPropertyList())), func(mappable helpers.Mappable) bool {
+		order := mappable.(mappables.Order)
+
+		// keys of different books may share a prefix as the IDs in it are not delimited
+		if order.GetClassificationID().Compare(classificationID) != 0 || order.GetMakerOwnableID().Compare(makerOwnableID) != 0 || order.GetTakerOwnableID().Compare(takerOwnableID) != 0 {
+			return false
+		}
+
+		var makerOwnableSplit sdkTypes.Dec
+
+		if makerOwnableSplit, err = GetMakerOwnableSplit(context, supplementAuxiliary, order); err != nil {
+			return true
+		}
+
+		totalMakerOwnableSplit = totalMakerOwnableSplit.Add(makerOwnableSplit)
+
+		exchangeRate := order.GetExchangeRate().GetData().(data.DecData).Get()
+
+		switch {
+		case len(levelList) != 0 && levelList[len(levelList)-1].ExchangeRate.Equal(exchangeRate):
+			levelList[len(levelList)-1].MakerOwnableSplit = levelList[len(levelList)-1].MakerOwnableSplit.Add(makerOwnableSplit)
+			levelList[len(levelList)-1].Orders++
+		case limit <= 0 || len(levelList) < limit:
+			levelList = append(levelList, Level{ExchangeRate: exchangeRate, MakerOwnableSplit: makerOwnableSplit, Orders: 1})
+		}
+
+		return false
+	})
+
+	if err != nil {
+		return nil, sdkTypes.ZeroDec(), err
+	}
+
+	return levelList, totalMakerOwnableSplit, nil
+}
+
+// GetMakerOwnableSplit returns the maker ownable split left in the order
+func GetMakerOwnableSplit(context sdkTypes.Context, supplementAuxiliary helpers.Auxiliary, order mappables.Order) (sdkTypes.Dec, error) {
+	metaProperties, err := supplement.GetMetaPropertiesFromResponse(supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(order.GetMakerOwnableSplit())))
+	if err != nil {
+		return sdkTypes.Dec{}, err
+	}
+
+	makerOwnableSplitProperty := metaProperties.GetMetaProperty(constants.MakerOwnableSplitProperty)
+	if makerOwnableSplitProperty == nil {
+		return sdkTypes.Dec{}, errors.MetaDataError
+	}
+
+	return makerOwnableSplitProperty.GetData().(data.DecData).Get(), nil
+}
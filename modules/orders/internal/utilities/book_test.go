@@ -0,0 +1,111 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+func createBookTestInput(t *testing.T) (sdkTypes.Context, helpers.Mapper, helpers.Auxiliary, helpers.Auxiliary) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	metasStoreKey := sdkTypes.NewKVStoreKey("testMetas")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	metasModule := metas.Prototype().Initialize(metasStoreKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(metasStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	for _, parameter := range metasModule.GetParameters().GetList() {
+		metasModule.GetParameters().Mutate(context, parameter)
+	}
+
+	return context, mapper.Prototype().Initialize(storeKey), metasModule.GetAuxiliary(supplement.Auxiliary.GetName()), metasModule.GetAuxiliary(scrub.Auxiliary.GetName())
+}
+
+func addBookTestOrder(t *testing.T, context sdkTypes.Context, mapper helpers.Mapper, scrubAuxiliary helpers.Auxiliary, makerOwnableID string, takerOwnableID string, exchangeRate string, makerID string, makerOwnableSplit int64) {
+	mutableProperties, err := scrub.GetPropertiesFromResponse(scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(baseProperties.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(sdkTypes.NewDec(makerOwnableSplit))))))
+	require.Nil(t, err)
+
+	orderID := key.NewOrderID(baseIDs.NewID("classification"), baseIDs.NewID(makerOwnableID), baseIDs.NewID(takerOwnableID), baseIDs.NewID(exchangeRate), baseIDs.NewID("1"), baseIDs.NewID(makerID), baseLists.NewPropertyList())
+	mapper.NewCollection(context).Add(mappable.NewOrder(orderID, baseLists.NewPropertyList(), mutableProperties))
+}
+
+func TestGetBookLevels(t *testing.T) {
+	context, mapper, supplementAuxiliary, scrubAuxiliary := createBookTestInput(t)
+	classificationID, makerOwnableID, takerOwnableID := baseIDs.NewID("classification"), baseIDs.NewID("maker"), baseIDs.NewID("taker")
+
+	levelList, totalMakerOwnableSplit, err := GetBookLevels(context, mapper, supplementAuxiliary, classificationID, makerOwnableID, takerOwnableID, 0)
+	require.Nil(t, err)
+	require.Nil(t, levelList)
+	require.Equal(t, sdkTypes.ZeroDec(), totalMakerOwnableSplit)
+
+	addBookTestOrder(t, context, mapper, scrubAuxiliary, "maker", "taker", "20.000000000000000000", "makerID1", 5)
+	addBookTestOrder(t, context, mapper, scrubAuxiliary, "maker", "taker", "3.000000000000000000", "makerID1", 2)
+	addBookTestOrder(t, context, mapper, scrubAuxiliary, "maker", "taker", "3.000000000000000000", "makerID2", 4)
+	addBookTestOrder(t, context, mapper, scrubAuxiliary, "taker", "maker", "1.000000000000000000", "makerID1", 7)
+	addBookTestOrder(t, context, mapper, scrubAuxiliary, "make", "rtaker", "1.000000000000000000", "makerID1", 9)
+
+	levelList, totalMakerOwnableSplit, err = GetBookLevels(context, mapper, supplementAuxiliary, classificationID, makerOwnableID, takerOwnableID, 0)
+	require.Nil(t, err)
+	require.Equal(t, []Level{
+		{ExchangeRate: sdkTypes.NewDec(3), MakerOwnableSplit: sdkTypes.NewDec(6), Orders: 2},
+		{ExchangeRate: sdkTypes.NewDec(20), MakerOwnableSplit: sdkTypes.NewDec(5), Orders: 1},
+	}, levelList)
+	require.Equal(t, sdkTypes.NewDec(11), totalMakerOwnableSplit)
+
+	levelList, totalMakerOwnableSplit, err = GetBookLevels(context, mapper, supplementAuxiliary, classificationID, makerOwnableID, takerOwnableID, 1)
+	require.Nil(t, err)
+	require.Equal(t, []Level{{ExchangeRate: sdkTypes.NewDec(3), MakerOwnableSplit: sdkTypes.NewDec(6), Orders: 2}}, levelList)
+	require.Equal(t, sdkTypes.NewDec(11), totalMakerOwnableSplit)
+
+	levelList, totalMakerOwnableSplit, err = GetBookLevels(context, mapper, supplementAuxiliary, classificationID, takerOwnableID, makerOwnableID, 0)
+	require.Nil(t, err)
+	require.Equal(t, []Level{{ExchangeRate: sdkTypes.OneDec(), MakerOwnableSplit: sdkTypes.NewDec(7), Orders: 1}}, levelList)
+	require.Equal(t, sdkTypes.NewDec(7), totalMakerOwnableSplit)
+}
@@ -0,0 +1,43 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// getClassificationData returns the data the order carries under the immutable property when its classification fixes the same value,
+// which every order of the classification then carries, it returns nil when the order does not carry the property or its classification
+// leaves the value to the makers
+func getClassificationData(context sdkTypes.Context, memberAuxiliary helpers.Auxiliary, supplementAuxiliary helpers.Auxiliary, order mappables.Order, propertyID ids.PropertyID) (data.Data, error) {
+	property := order.GetImmutablePropertyList().GetProperty(propertyID)
+	if property == nil {
+		return nil, nil
+	}
+
+	if auxiliaryResponse := memberAuxiliary.GetKeeper().Help(context, member.NewAuxiliaryRequest(order.GetClassificationID(), base.NewPropertyList(property), nil)); !auxiliaryResponse.IsSuccessful() {
+		return nil, nil
+	}
+
+	metaProperties, err := supplement.GetMetaPropertiesFromResponse(supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(property)))
+	if err != nil {
+		return nil, err
+	}
+
+	metaProperty := metaProperties.GetMetaProperty(propertyID)
+	if metaProperty == nil {
+		return nil, errors.MetaDataError
+	}
+
+	return metaProperty.GetData(), nil
+}
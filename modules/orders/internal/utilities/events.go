@@ -0,0 +1,108 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// EmitOrderMadeEvent emits the placement of the order offering the maker ownable split
+func EmitOrderMadeEvent(context sdkTypes.Context, order mappables.Order, makerOwnableSplit sdkTypes.Dec) {
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.OrderMade,
+			sdkTypes.NewAttribute(events.AttributeKeyOrderID, order.GetID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyClassificationID, order.GetClassificationID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerID, order.GetMakerID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableID, order.GetMakerOwnableID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyTakerOwnableID, order.GetTakerOwnableID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableSplit, makerOwnableSplit.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyExchangeRate, order.GetExchangeRate().GetData().(data.DecData).Get().String()),
+		),
+	)
+}
+
+// EmitOrderExecutedEvent emits a fill of the order, in which its maker gave the maker ownable split to the taker and received the taker ownable split
+// less the fee and the royalty
+func EmitOrderExecutedEvent(context sdkTypes.Context, order mappables.Order, takerID ids.ID, makerOwnableSplit sdkTypes.Dec, takerOwnableSplit sdkTypes.Dec, fee sdkTypes.Dec, royalty sdkTypes.Dec) {
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.OrderExecuted,
+			sdkTypes.NewAttribute(events.AttributeKeyOrderID, order.GetID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerID, order.GetMakerID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyTakerID, takerID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableID, order.GetMakerOwnableID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyTakerOwnableID, order.GetTakerOwnableID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableSplit, makerOwnableSplit.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyTakerOwnableSplit, takerOwnableSplit.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyFee, fee.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyRoyalty, royalty.String()),
+		),
+	)
+}
+
+// EmitOrderClosedEvent emits the removal of the order by its maker or on its expiry, along with the maker ownable split refunded to the maker
+func EmitOrderClosedEvent(context sdkTypes.Context, eventType string, order mappables.Order, makerOwnableSplit sdkTypes.Dec) {
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			eventType,
+			sdkTypes.NewAttribute(events.AttributeKeyOrderID, order.GetID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerID, order.GetMakerID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableID, order.GetMakerOwnableID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableSplit, makerOwnableSplit.String()),
+		),
+	)
+}
+
+// EmitAuctionClearedEvent emits the clearing of a batch auction between the orders of the classification offering the maker ownable for the
+// taker ownable and their opposite orders, in which the maker ownable split was exchanged for the taker ownable split at the exchange rate
+func EmitAuctionClearedEvent(context sdkTypes.Context, classificationID ids.ID, makerOwnableID ids.ID, takerOwnableID ids.ID, exchangeRate sdkTypes.Dec, makerOwnableSplit sdkTypes.Dec, takerOwnableSplit sdkTypes.Dec) {
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.AuctionCleared,
+			sdkTypes.NewAttribute(events.AttributeKeyClassificationID, classificationID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableID, makerOwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyTakerOwnableID, takerOwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyExchangeRate, exchangeRate.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableSplit, makerOwnableSplit.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyTakerOwnableSplit, takerOwnableSplit.String()),
+		),
+	)
+}
+
+// EmitStopMadeEvent emits the placement of the stop order offering the maker ownable split once triggered
+func EmitStopMadeEvent(context sdkTypes.Context, stop mappables.Stop, makerOwnableSplit sdkTypes.Dec) {
+	order := stop.GetOrder()
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.StopMade,
+			sdkTypes.NewAttribute(events.AttributeKeyOrderID, order.GetID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyClassificationID, order.GetClassificationID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerID, order.GetMakerID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableID, order.GetMakerOwnableID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyTakerOwnableID, order.GetTakerOwnableID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyMakerOwnableSplit, makerOwnableSplit.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyExchangeRate, order.GetExchangeRate().GetData().(data.DecData).Get().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyTriggerRate, stop.GetTriggerRate().String()),
+		),
+	)
+}
+
+// EmitStopTriggeredEvent emits the activation of the stop order by the last exchange rate of its ownable pair
+func EmitStopTriggeredEvent(context sdkTypes.Context, stop mappables.Stop, exchangeRate sdkTypes.Dec) {
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.StopTriggered,
+			sdkTypes.NewAttribute(events.AttributeKeyOrderID, stop.GetOrder().GetID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyTriggerRate, stop.GetTriggerRate().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyExchangeRate, exchangeRate.String()),
+		),
+	)
+}
@@ -0,0 +1,43 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+)
+
+func TestEmitOrderExecutedEvent(t *testing.T) {
+	context := sdkTypes.NewContext(nil, abciTypes.Header{}, false, log.NewNopLogger())
+	order := mappable.NewOrder(key.NewOrderID(baseIDs.NewID("classification"), baseIDs.NewID("maker"), baseIDs.NewID("taker"), baseIDs.NewID("2.000000000000000000"), baseIDs.NewID("1"), baseIDs.NewID("makerID"), baseLists.NewPropertyList()), baseLists.NewPropertyList(), baseLists.NewPropertyList())
+
+	EmitOrderExecutedEvent(context, order, baseIDs.NewID("takerID"), sdkTypes.NewDec(1), sdkTypes.NewDec(2), sdkTypes.NewDecWithPrec(2, 2), sdkTypes.NewDecWithPrec(1, 1))
+
+	emittedEvents := context.EventManager().Events()
+	require.Equal(t, 1, len(emittedEvents))
+	require.Equal(t, events.OrderExecuted, emittedEvents[0].Type)
+
+	attributes := make(map[string]string)
+	for _, attribute := range emittedEvents[0].Attributes {
+		attributes[string(attribute.Key)] = string(attribute.Value)
+	}
+
+	require.Equal(t, order.GetID().String(), attributes[events.AttributeKeyOrderID])
+	require.Equal(t, "makerID", attributes[events.AttributeKeyMakerID])
+	require.Equal(t, "takerID", attributes[events.AttributeKeyTakerID])
+	require.Equal(t, sdkTypes.NewDec(1).String(), attributes[events.AttributeKeyMakerOwnableSplit])
+	require.Equal(t, sdkTypes.NewDec(2).String(), attributes[events.AttributeKeyTakerOwnableSplit])
+	require.Equal(t, sdkTypes.NewDecWithPrec(2, 2).String(), attributes[events.AttributeKeyFee])
+	require.Equal(t, sdkTypes.NewDecWithPrec(1, 1).String(), attributes[events.AttributeKeyRoyalty])
+}
@@ -0,0 +1,35 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"math/big"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+)
+
+// MaxExchangeRate bounds the exchange rates orders are placed at, which is a taker ownable split demanded of a billion billion per maker
+// ownable split offered, so that the fills of orders at exchange rates the matching reads from the book stay within decimal bounds
+var MaxExchangeRate = sdkTypes.NewDecFromInt(sdkTypes.NewIntWithDecimal(1, 36))
+
+// ValidateExchangeRate checks that orders can be placed at the exchange rate
+func ValidateExchangeRate(exchangeRate sdkTypes.Dec) error {
+	if exchangeRate.GT(MaxExchangeRate) {
+		return errors.InvalidRequest
+	}
+
+	return nil
+}
+
+// Crosses tells if orders at the exchange rate and orders of the opposite book at the opposite exchange rate can fill each other, exchange
+// rates being the taker ownable split demanded per maker ownable split offered scaled up by the inverse of the smallest decimal, their
+// product is truncated as decimal multiplications would be but computed on unbounded integers, so that no exchange rate makes it overflow
+func Crosses(exchangeRate sdkTypes.Dec, oppositeExchangeRate sdkTypes.Dec) bool {
+	product := new(big.Int).Mul(exchangeRate.BigInt(), oppositeExchangeRate.BigInt())
+
+	// the product scales the rates by the precision twice, and their scale by the inverse of the smallest decimal is removed from each
+	return new(big.Int).Quo(product, new(big.Int).Exp(big.NewInt(10), big.NewInt(3*sdkTypes.Precision), nil)).Cmp(sdkTypes.OneDec().BigInt()) <= 0
+}
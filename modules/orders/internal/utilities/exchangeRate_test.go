@@ -0,0 +1,45 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants/errors"
+)
+
+func TestValidateExchangeRate(t *testing.T) {
+	require.Nil(t, ValidateExchangeRate(sdkTypes.OneDec()))
+	require.Nil(t, ValidateExchangeRate(MaxExchangeRate))
+	require.Equal(t, errors.InvalidRequest, ValidateExchangeRate(MaxExchangeRate.Add(sdkTypes.SmallestDec())))
+}
+
+func TestCrosses(t *testing.T) {
+	// exchange rates of one are a taker ownable split demanded per maker ownable split offered of the smallest decimal
+	unitExchangeRate := sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec())
+
+	tests := []struct {
+		name                 string
+		exchangeRate         sdkTypes.Dec
+		oppositeExchangeRate sdkTypes.Dec
+		want                 bool
+	}{
+		{"reciprocal", unitExchangeRate.MulInt64(2), unitExchangeRate.QuoInt64(2), true},
+		{"below reciprocal", unitExchangeRate, unitExchangeRate.QuoInt64(2), true},
+		{"above reciprocal", unitExchangeRate.MulInt64(2), unitExchangeRate, false},
+		{"truncated to reciprocal", sdkTypes.OneDec(), MaxExchangeRate.Add(sdkTypes.NewDecWithPrec(5, 1)), true},
+		{"maximal against smallest", MaxExchangeRate, sdkTypes.OneDec(), true},
+		{"maximal against maximal", MaxExchangeRate, MaxExchangeRate, false},
+		{"beyond decimal bounds", MaxExchangeRate.Mul(MaxExchangeRate), MaxExchangeRate.Mul(MaxExchangeRate), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Crosses(tt.exchangeRate, tt.oppositeExchangeRate))
+			require.Equal(t, tt.want, Crosses(tt.oppositeExchangeRate, tt.exchangeRate))
+		})
+	}
+}
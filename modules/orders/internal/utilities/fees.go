@@ -0,0 +1,133 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/collector"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/fees"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/settle"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+// GetFeeRates returns the fractions charged on what the resting order and the incoming order of a fill in the classification of the
+// order receive, each being the protocol fee plus the maker or taker fee the classification fixes in its immutable properties
+func GetFeeRates(context sdkTypes.Context, parameters helpers.Parameters, memberAuxiliary helpers.Auxiliary, supplementAuxiliary helpers.Auxiliary, order mappables.Order) (sdkTypes.Dec, sdkTypes.Dec, error) {
+	protocolFee := parameters.Fetch(context, fees.ID).Get(fees.ID).GetData().(data.DecData).Get()
+
+	makerFee, err := getClassificationFee(context, memberAuxiliary, supplementAuxiliary, order, constants.MakerFeeProperty)
+	if err != nil {
+		return sdkTypes.Dec{}, sdkTypes.Dec{}, err
+	}
+
+	takerFee, err := getClassificationFee(context, memberAuxiliary, supplementAuxiliary, order, constants.TakerFeeProperty)
+	if err != nil {
+		return sdkTypes.Dec{}, sdkTypes.Dec{}, err
+	}
+
+	makerFeeRate, takerFeeRate := protocolFee.Add(makerFee), protocolFee.Add(takerFee)
+	if makerFeeRate.GTE(sdkTypes.OneDec()) || takerFeeRate.GTE(sdkTypes.OneDec()) {
+		return sdkTypes.Dec{}, sdkTypes.Dec{}, errors.InvalidParameter
+	}
+
+	return makerFeeRate, takerFeeRate, nil
+}
+
+// ValidateChargeRates returns an error when the fee the order can be charged on either side of a fill together with the royalty its maker
+// ownable carries would take all that its maker receives
+func ValidateChargeRates(context sdkTypes.Context, parameters helpers.Parameters, memberAuxiliary helpers.Auxiliary, supplementAuxiliary helpers.Auxiliary, royaltyAuxiliary helpers.Auxiliary, order mappables.Order) error {
+	makerFeeRate, takerFeeRate, err := GetFeeRates(context, parameters, memberAuxiliary, supplementAuxiliary, order)
+	if err != nil {
+		return err
+	}
+
+	_, royaltyRate, err := royalty.GetRoyaltyFromResponse(royaltyAuxiliary.GetKeeper().Help(context, royalty.NewAuxiliaryRequest(order.GetMakerOwnableID())))
+	if err != nil {
+		return err
+	}
+
+	if sdkTypes.MaxDec(makerFeeRate, takerFeeRate).Add(royaltyRate).GTE(sdkTypes.OneDec()) {
+		return errors.InvalidRequest
+	}
+
+	return nil
+}
+
+// getClassificationFee returns the fee the order carries under the property, which is only charged when its classification fixes the
+// same value so that makers cannot set the fees of their counterparties
+func getClassificationFee(context sdkTypes.Context, memberAuxiliary helpers.Auxiliary, supplementAuxiliary helpers.Auxiliary, order mappables.Order, propertyID ids.PropertyID) (sdkTypes.Dec, error) {
+	feeData, err := getClassificationData(context, memberAuxiliary, supplementAuxiliary, order, propertyID)
+	if err != nil {
+		return sdkTypes.Dec{}, err
+	}
+
+	if feeData == nil {
+		return sdkTypes.ZeroDec(), nil
+	}
+
+	if fee := feeData.(data.DecData).Get(); !fee.IsNegative() {
+		return fee, nil
+	}
+
+	return sdkTypes.Dec{}, errors.InvalidParameter
+}
+
+// TransferProceeds transfers the split received for the sold ownable to the identity less the fee charged at the fee rate, which is
+// sent to the fee collector, and less the royalty the sold ownable carries, which is sent to its recipient, it returns the fee and the royalty
+func TransferProceeds(context sdkTypes.Context, parameters helpers.Parameters, transferAuxiliary helpers.Auxiliary, royaltyAuxiliary helpers.Auxiliary, fromID ids.ID, toID ids.ID, ownableID ids.ID, soldOwnableID ids.ID, split sdkTypes.Dec, feeRate sdkTypes.Dec) (sdkTypes.Dec, sdkTypes.Dec, error) {
+	return payProceeds(context, parameters, royaltyAuxiliary, toID, soldOwnableID, split, feeRate, func(recipientID ids.ID, value sdkTypes.Dec) helpers.AuxiliaryResponse {
+		return transferAuxiliary.GetKeeper().Help(context, transfer.NewAuxiliaryRequest(fromID, recipientID, ownableID, value))
+	})
+}
+
+// SettleProceeds pays the split received for the sold ownable to the identity out of the escrow of the order it was sold by, with the fee
+// and the royalty taken as TransferProceeds does, it returns the fee and the royalty
+func SettleProceeds(context sdkTypes.Context, parameters helpers.Parameters, settleAuxiliary helpers.Auxiliary, royaltyAuxiliary helpers.Auxiliary, order mappables.Order, toID ids.ID, soldOwnableID ids.ID, split sdkTypes.Dec, feeRate sdkTypes.Dec) (sdkTypes.Dec, sdkTypes.Dec, error) {
+	return payProceeds(context, parameters, royaltyAuxiliary, toID, soldOwnableID, split, feeRate, func(recipientID ids.ID, value sdkTypes.Dec) helpers.AuxiliaryResponse {
+		return settleAuxiliary.GetKeeper().Help(context, settle.NewAuxiliaryRequest(baseIDs.NewID(module.Name), order.GetID(), recipientID, value))
+	})
+}
+
+// payProceeds pays the fee, the royalty and what is left of the split to their recipients
+func payProceeds(context sdkTypes.Context, parameters helpers.Parameters, royaltyAuxiliary helpers.Auxiliary, toID ids.ID, soldOwnableID ids.ID, split sdkTypes.Dec, feeRate sdkTypes.Dec, pay func(ids.ID, sdkTypes.Dec) helpers.AuxiliaryResponse) (sdkTypes.Dec, sdkTypes.Dec, error) {
+	royaltyRecipientID, royaltyRate, err := royalty.GetRoyaltyFromResponse(royaltyAuxiliary.GetKeeper().Help(context, royalty.NewAuxiliaryRequest(soldOwnableID)))
+	if err != nil {
+		return sdkTypes.Dec{}, sdkTypes.Dec{}, err
+	}
+
+	// the royalty is paid out of what the fee leaves, as fees changed after the order was made can still add up with the royalty to the
+	// whole split, and a fill that cannot be paid out would be retried every block
+	fee := split.MulTruncate(feeRate)
+	royaltySplit := sdkTypes.MinDec(split.MulTruncate(royaltyRate), split.Sub(fee))
+
+	if fee.IsPositive() {
+		if auxiliaryResponse := pay(parameters.Fetch(context, collector.ID).Get(collector.ID).GetData().(data.IDData).Get(), fee); !auxiliaryResponse.IsSuccessful() {
+			return sdkTypes.Dec{}, sdkTypes.Dec{}, auxiliaryResponse.GetError()
+		}
+	}
+
+	if royaltySplit.IsPositive() {
+		if auxiliaryResponse := pay(royaltyRecipientID, royaltySplit); !auxiliaryResponse.IsSuccessful() {
+			return sdkTypes.Dec{}, sdkTypes.Dec{}, auxiliaryResponse.GetError()
+		}
+	}
+
+	if proceeds := split.Sub(fee).Sub(royaltySplit); proceeds.IsPositive() {
+		if auxiliaryResponse := pay(toID, proceeds); !auxiliaryResponse.IsSuccessful() {
+			return sdkTypes.Dec{}, sdkTypes.Dec{}, auxiliaryResponse.GetError()
+		}
+	}
+
+	return fee, royaltySplit, nil
+}
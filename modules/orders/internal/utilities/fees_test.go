@@ -0,0 +1,167 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
+	"github.com/AssetMantle/modules/modules/classifications"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/define"
+	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/member"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters"
+	"github.com/AssetMantle/modules/modules/orders/internal/parameters/fees"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/lists"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+func createFeesTestInput(t *testing.T) (sdkTypes.Context, helpers.Parameters, helpers.Module, helpers.Module) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	classificationsStoreKey := sdkTypes.NewKVStoreKey("testClassifications")
+	metasStoreKey := sdkTypes.NewKVStoreKey("testMetas")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	classificationsModule := classifications.Prototype().Initialize(classificationsStoreKey, paramsKeeper.Subspace(classifications.Prototype().Name()))
+	metasModule := metas.Prototype().Initialize(metasStoreKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test").WithKeyTable(parameters.Prototype().GetKeyTable()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(classificationsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(metasStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	for _, module := range []helpers.Module{classificationsModule, metasModule} {
+		for _, parameter := range module.GetParameters().GetList() {
+			module.GetParameters().Mutate(context, parameter)
+		}
+	}
+
+	for _, parameter := range Parameters.GetList() {
+		Parameters.Mutate(context, parameter)
+	}
+
+	Parameters.Mutate(context, fees.Parameter.Mutate(baseData.NewDecData(sdkTypes.NewDecWithPrec(1, 3))))
+
+	return context, Parameters, classificationsModule, metasModule
+}
+
+func scrubFeesTestProperties(t *testing.T, context sdkTypes.Context, metasModule helpers.Module, makerFee sdkTypes.Dec, takerFee sdkTypes.Dec) lists.PropertyList {
+	propertyList, err := scrub.GetPropertiesFromResponse(metasModule.GetAuxiliary(scrub.Auxiliary.GetName()).GetKeeper().Help(context, scrub.NewAuxiliaryRequest(baseProperties.NewMetaProperty(constants.MakerFeeProperty.GetKey(), baseData.NewDecData(makerFee)), baseProperties.NewMetaProperty(constants.TakerFeeProperty.GetKey(), baseData.NewDecData(takerFee)))))
+	require.Nil(t, err)
+
+	return propertyList
+}
+
+func TestGetFeeRates(t *testing.T) {
+	context, Parameters, classificationsModule, metasModule := createFeesTestInput(t)
+	memberAuxiliary, supplementAuxiliary := classificationsModule.GetAuxiliary(member.Auxiliary.GetName()), metasModule.GetAuxiliary(supplement.Auxiliary.GetName())
+
+	classificationID, err := define.GetClassificationIDFromResponse(classificationsModule.GetAuxiliary(define.Auxiliary.GetName()).GetKeeper().Help(context, define.NewAuxiliaryRequest(scrubFeesTestProperties(t, context, metasModule, sdkTypes.NewDecWithPrec(2, 3), sdkTypes.NewDecWithPrec(5, 1)), baseLists.NewPropertyList())))
+	require.Nil(t, err)
+
+	newOrder := func(immutableProperties lists.PropertyList) mappables.Order {
+		return mappable.NewOrder(key.NewOrderID(classificationID, baseIDs.NewID("maker"), baseIDs.NewID("taker"), baseIDs.NewID("2.000000000000000000"), baseIDs.NewID("1"), baseIDs.NewID("makerID"), immutableProperties), immutableProperties, baseLists.NewPropertyList())
+	}
+
+	makerFeeRate, takerFeeRate, err := GetFeeRates(context, Parameters, memberAuxiliary, supplementAuxiliary, newOrder(baseLists.NewPropertyList()))
+	require.Nil(t, err)
+	require.Equal(t, sdkTypes.NewDecWithPrec(1, 3), makerFeeRate)
+	require.Equal(t, sdkTypes.NewDecWithPrec(1, 3), takerFeeRate)
+
+	// only the maker fee matches the one fixed by the classification
+	makerFeeRate, takerFeeRate, err = GetFeeRates(context, Parameters, memberAuxiliary, supplementAuxiliary, newOrder(scrubFeesTestProperties(t, context, metasModule, sdkTypes.NewDecWithPrec(2, 3), sdkTypes.NewDecWithPrec(3, 1))))
+	require.Nil(t, err)
+	require.Equal(t, sdkTypes.NewDecWithPrec(3, 3), makerFeeRate)
+	require.Equal(t, sdkTypes.NewDecWithPrec(1, 3), takerFeeRate)
+
+	makerFeeRate, takerFeeRate, err = GetFeeRates(context, Parameters, memberAuxiliary, supplementAuxiliary, newOrder(scrubFeesTestProperties(t, context, metasModule, sdkTypes.NewDecWithPrec(2, 3), sdkTypes.NewDecWithPrec(5, 1))))
+	require.Nil(t, err)
+	require.Equal(t, sdkTypes.NewDecWithPrec(3, 3), makerFeeRate)
+	require.Equal(t, sdkTypes.NewDecWithPrec(501, 3), takerFeeRate)
+}
+
+func TestValidateChargeRates(t *testing.T) {
+	context, Parameters, classificationsModule, metasModule := createFeesTestInput(t)
+	memberAuxiliary, supplementAuxiliary, royaltyAuxiliary := classificationsModule.GetAuxiliary(member.Auxiliary.GetName()), metasModule.GetAuxiliary(supplement.Auxiliary.GetName()), royalty.AuxiliaryMock.Initialize(nil, nil)
+
+	newOrder := func(makerOwnableID string) mappables.Order {
+		return mappable.NewOrder(key.NewOrderID(baseIDs.NewID("classificationID"), baseIDs.NewID(makerOwnableID), baseIDs.NewID("taker"), baseIDs.NewID("2.000000000000000000"), baseIDs.NewID("1"), baseIDs.NewID("makerID"), baseLists.NewPropertyList()), baseLists.NewPropertyList(), baseLists.NewPropertyList())
+	}
+
+	require.Nil(t, ValidateChargeRates(context, Parameters, memberAuxiliary, supplementAuxiliary, royaltyAuxiliary, newOrder("maker")))
+	require.Nil(t, ValidateChargeRates(context, Parameters, memberAuxiliary, supplementAuxiliary, royaltyAuxiliary, newOrder("0.998")))
+	require.Equal(t, errors.InvalidRequest, ValidateChargeRates(context, Parameters, memberAuxiliary, supplementAuxiliary, royaltyAuxiliary, newOrder("0.999")))
+	require.Equal(t, errors.MockError, ValidateChargeRates(context, Parameters, memberAuxiliary, supplementAuxiliary, royaltyAuxiliary, newOrder("royaltyError")))
+}
+
+func Test_payProceeds(t *testing.T) {
+	context, Parameters, _, _ := createFeesTestInput(t)
+	royaltyAuxiliary := royalty.AuxiliaryMock.Initialize(nil, nil)
+
+	var payments map[string]sdkTypes.Dec
+
+	pay := func(recipientID ids.ID, value sdkTypes.Dec) helpers.AuxiliaryResponse {
+		payments[recipientID.String()] = value
+		return royaltyAuxiliary.GetKeeper().Help(context, royalty.NewAuxiliaryRequest(baseIDs.NewID("maker")))
+	}
+
+	payments = make(map[string]sdkTypes.Dec)
+	fee, royaltySplit, err := payProceeds(context, Parameters, royaltyAuxiliary, baseIDs.NewID("toID"), baseIDs.NewID("0.2"), sdkTypes.NewDec(10), sdkTypes.NewDecWithPrec(1, 1), pay)
+	require.Nil(t, err)
+	require.Equal(t, sdkTypes.NewDec(1), fee)
+	require.Equal(t, sdkTypes.NewDec(2), royaltySplit)
+	require.Equal(t, sdkTypes.NewDec(7), payments["toID"])
+	require.Equal(t, sdkTypes.NewDec(2), payments["royaltyRecipientID"])
+
+	// a fee and a royalty adding up to more than the split leave the royalty what the fee does not take and the seller nothing
+	payments = make(map[string]sdkTypes.Dec)
+	fee, royaltySplit, err = payProceeds(context, Parameters, royaltyAuxiliary, baseIDs.NewID("toID"), baseIDs.NewID("0.5"), sdkTypes.NewDec(10), sdkTypes.NewDecWithPrec(6, 1), pay)
+	require.Nil(t, err)
+	require.Equal(t, sdkTypes.NewDec(6), fee)
+	require.Equal(t, sdkTypes.NewDec(4), royaltySplit)
+	require.Equal(t, sdkTypes.NewDec(4), payments["royaltyRecipientID"])
+	require.NotContains(t, payments, "toID")
+	require.Len(t, payments, 2)
+}
@@ -0,0 +1,159 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/scrub"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/modules/orders/internal/module"
+	"github.com/AssetMantle/modules/schema/data"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+// Matcher fills orders against the books of the mapper in price time priority, walking the book index so that only the orders of the book
+// matched are visited
+type Matcher struct {
+	mapper              helpers.Mapper
+	parameters          helpers.Parameters
+	memberAuxiliary     helpers.Auxiliary
+	royaltyAuxiliary    helpers.Auxiliary
+	scrubAuxiliary      helpers.Auxiliary
+	settleAuxiliary     helpers.Auxiliary
+	supplementAuxiliary helpers.Auxiliary
+}
+
+// GetBestOrder returns the order of the book of the classification offering the maker ownable for the taker ownable with the lowest
+// exchange rate, and then the lowest creation height, that is not skipped, orders whose maker ownable split cannot be read are logged and
+// skipped
+func (matcher Matcher) GetBestOrder(context sdkTypes.Context, classificationID ids.ID, makerOwnableID ids.ID, takerOwnableID ids.ID, skippedOrders map[string]bool) (mappables.Order, sdkTypes.Dec, bool) {
+	var bestOrder mappables.Order
+
+	var bestMakerOwnableSplit sdkTypes.Dec
+
+	matcher.mapper.IterateIndex(context, mapper.BookIndex, mapper.GenerateBookKeyBytes(classificationID, makerOwnableID, takerOwnableID), func(mappable helpers.Mappable) bool {
+		order := mappable.(mappables.Order)
+		if skippedOrders[order.GetID().String()] || order.GetClassificationID().Compare(classificationID) != 0 || order.GetMakerOwnableID().Compare(makerOwnableID) != 0 || order.GetTakerOwnableID().Compare(takerOwnableID) != 0 {
+			return false
+		}
+
+		makerOwnableSplit, err := GetMakerOwnableSplit(context, matcher.supplementAuxiliary, order)
+		if err != nil {
+			context.Logger().Error("failed to read order maker ownable split", "module", module.Name, "order", order.GetID().String(), "error", err.Error())
+			skippedOrders[order.GetID().String()] = true
+
+			return false
+		}
+
+		bestOrder, bestMakerOwnableSplit = order, makerOwnableSplit
+
+		return true
+	})
+
+	return bestOrder, bestMakerOwnableSplit, bestOrder != nil
+}
+
+// FillOrder fills the order, holding the maker ownable split given, against the best orders of its opposite book at their exchange rates
+// for as long as they cross and matches are left, it returns the order as last stored, or nil once filled, along with the maker ownable
+// split left in it and the number of matches left
+func (matcher Matcher) FillOrder(context sdkTypes.Context, order mappables.Order, makerOwnableSplit sdkTypes.Dec, remainingMatches int64) (mappables.Order, sdkTypes.Dec, int64, error) {
+	for ; remainingMatches > 0 && makerOwnableSplit.IsPositive(); remainingMatches-- {
+		oppositeOrder, oppositeMakerOwnableSplit, found := matcher.GetBestOrder(context, order.GetClassificationID(), order.GetTakerOwnableID(), order.GetMakerOwnableID(), make(map[string]bool))
+		if !found || !Crosses(order.GetExchangeRate().GetData().(data.DecData).Get(), oppositeOrder.GetExchangeRate().GetData().(data.DecData).Get()) {
+			break
+		}
+
+		if err := matcher.MatchOrders(context, oppositeOrder, oppositeMakerOwnableSplit, order, makerOwnableSplit); err != nil {
+			return order, makerOwnableSplit, remainingMatches, err
+		}
+
+		filledOrder, ok := matcher.mapper.NewCollection(context).Fetch(order.GetKey()).Get(order.GetKey()).(mappables.Order)
+		if !ok {
+			return nil, sdkTypes.ZeroDec(), remainingMatches - 1, nil
+		}
+
+		var err error
+		if makerOwnableSplit, err = GetMakerOwnableSplit(context, matcher.supplementAuxiliary, filledOrder); err != nil {
+			return order, makerOwnableSplit, remainingMatches, err
+		}
+
+		order = filledOrder
+	}
+
+	return order, makerOwnableSplit, remainingMatches, nil
+}
+
+// MatchOrders fills the incoming order against the resting order at the exchange rate of the resting order
+func (matcher Matcher) MatchOrders(context sdkTypes.Context, restingOrder mappables.Order, restingMakerOwnableSplit sdkTypes.Dec, incomingOrder mappables.Order, incomingMakerOwnableSplit sdkTypes.Dec) error {
+	exchangeRate := restingOrder.GetExchangeRate().GetData().(data.DecData).Get()
+	restingTakerOwnableSplitDemanded := restingMakerOwnableSplit.MulTruncate(exchangeRate).MulTruncate(sdkTypes.SmallestDec())
+
+	incomingReceiveSplit, restingReceiveSplit := restingMakerOwnableSplit, restingTakerOwnableSplitDemanded
+	if incomingMakerOwnableSplit.LT(restingTakerOwnableSplitDemanded) {
+		incomingReceiveSplit, restingReceiveSplit = incomingMakerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(exchangeRate), incomingMakerOwnableSplit
+	}
+
+	makerFeeRate, takerFeeRate, err := GetFeeRates(context, matcher.parameters, matcher.memberAuxiliary, matcher.supplementAuxiliary, restingOrder)
+	if err != nil {
+		return err
+	}
+
+	takerFee, takerRoyalty, err := SettleProceeds(context, matcher.parameters, matcher.settleAuxiliary, matcher.royaltyAuxiliary, restingOrder, incomingOrder.GetMakerID(), incomingOrder.GetMakerOwnableID(), incomingReceiveSplit, takerFeeRate)
+	if err != nil {
+		return err
+	}
+
+	makerFee, makerRoyalty, err := SettleProceeds(context, matcher.parameters, matcher.settleAuxiliary, matcher.royaltyAuxiliary, incomingOrder, restingOrder.GetMakerID(), restingOrder.GetMakerOwnableID(), restingReceiveSplit, makerFeeRate)
+	if err != nil {
+		return err
+	}
+
+	if err := matcher.SetMakerOwnableSplit(context, restingOrder, restingMakerOwnableSplit.Sub(incomingReceiveSplit)); err != nil {
+		return err
+	}
+
+	EmitOrderExecutedEvent(context, restingOrder, incomingOrder.GetMakerID(), incomingReceiveSplit, restingReceiveSplit, makerFee, makerRoyalty)
+	RecordTrade(context, matcher.mapper, restingOrder, incomingOrder.GetMakerID(), incomingReceiveSplit, restingReceiveSplit)
+	EmitOrderExecutedEvent(context, incomingOrder, restingOrder.GetMakerID(), restingReceiveSplit, incomingReceiveSplit, takerFee, takerRoyalty)
+
+	return matcher.SetMakerOwnableSplit(context, incomingOrder, incomingMakerOwnableSplit.Sub(restingReceiveSplit))
+}
+
+// SetMakerOwnableSplit updates the maker ownable split left in the order, removing the order once nothing is left
+func (matcher Matcher) SetMakerOwnableSplit(context sdkTypes.Context, order mappables.Order, makerOwnableSplit sdkTypes.Dec) error {
+	orders := matcher.mapper.NewCollection(context)
+
+	if !makerOwnableSplit.IsPositive() {
+		orders.Remove(order)
+		return nil
+	}
+
+	mutableProperties, err := scrub.GetPropertiesFromResponse(matcher.scrubAuxiliary.GetKeeper().Help(context, scrub.NewAuxiliaryRequest(baseProperties.NewMetaProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(makerOwnableSplit)))))
+	if err != nil {
+		return err
+	}
+
+	orders.Mutate(mappable.NewOrder(order.GetID(), order.GetImmutablePropertyList(), order.GetMutablePropertyList().Mutate(mutableProperties.GetList()...)))
+
+	return nil
+}
+
+func NewMatcher(mapper helpers.Mapper, parameters helpers.Parameters, memberAuxiliary helpers.Auxiliary, royaltyAuxiliary helpers.Auxiliary, scrubAuxiliary helpers.Auxiliary, settleAuxiliary helpers.Auxiliary, supplementAuxiliary helpers.Auxiliary) Matcher {
+	return Matcher{
+		mapper:              mapper,
+		parameters:          parameters,
+		memberAuxiliary:     memberAuxiliary,
+		royaltyAuxiliary:    royaltyAuxiliary,
+		scrubAuxiliary:      scrubAuxiliary,
+		settleAuxiliary:     settleAuxiliary,
+		supplementAuxiliary: supplementAuxiliary,
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// GetStop returns the stop that places the order once triggered, if there is one, stops being keyed by their trigger rate they are found
+// through the stop index
+func GetStop(context sdkTypes.Context, Mapper helpers.Mapper, orderID ids.ID) (mappables.Stop, bool) {
+	var stop mappables.Stop
+
+	Mapper.IterateIndex(context, mapper.StopIndex, mapper.GenerateStopKeyBytes(orderID), func(mappable helpers.Mappable) bool {
+		stop = mappable.(mappables.Stop)
+		return true
+	})
+
+	return stop, stop != nil
+}
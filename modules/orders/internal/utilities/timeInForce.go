@@ -0,0 +1,95 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/lists"
+	"github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/properties"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+var (
+	// GoodTillCancelled orders rest in the book until they are filled, cancelled or expire
+	GoodTillCancelled = baseIDs.NewID("GTC")
+	// ImmediateOrCancel orders fill as much as they can on placement and refund the rest
+	ImmediateOrCancel = baseIDs.NewID("IOC")
+	// FillOrKill orders fill completely on placement or are rejected
+	FillOrKill = baseIDs.NewID("FOK")
+	// PostOnly orders are rejected or cancelled instead of taking liquidity from the book
+	PostOnly = baseIDs.NewID("PO")
+)
+
+// IsTimeInForce tells if the ID is one of the supported time in force values
+func IsTimeInForce(timeInForce ids.ID) bool {
+	for _, value := range []ids.ID{GoodTillCancelled, ImmediateOrCancel, FillOrKill, PostOnly} {
+		if timeInForce.Compare(value) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetTimeInForce returns the time in force of the order, orders without one are good till cancelled
+func GetTimeInForce(context sdkTypes.Context, supplementAuxiliary helpers.Auxiliary, order mappables.Order) (ids.ID, error) {
+	timeInForceProperty := order.GetProperty(constants.TimeInForceProperty)
+	if timeInForceProperty == nil {
+		return GoodTillCancelled, nil
+	}
+
+	metaProperties, err := supplement.GetMetaPropertiesFromResponse(supplementAuxiliary.GetKeeper().Help(context, supplement.NewAuxiliaryRequest(timeInForceProperty)))
+	if err != nil {
+		return nil, err
+	}
+
+	timeInForceMetaProperty := metaProperties.GetMetaProperty(constants.TimeInForceProperty)
+	if timeInForceMetaProperty == nil {
+		return nil, errors.MetaDataError
+	}
+
+	return timeInForceMetaProperty.GetData().(data.IDData).Get(), nil
+}
+
+// ValidatePostOnly refuses orders posted only to the book whose exchange rate crosses the best order of their opposite book, as they would
+// take liquidity from it, it applies on placement and whenever an order is re-rated
+func ValidatePostOnly(context sdkTypes.Context, mapper helpers.Mapper, supplementAuxiliary helpers.Auxiliary, order mappables.Order) error {
+	timeInForce, err := GetTimeInForce(context, supplementAuxiliary, order)
+	if err != nil {
+		return err
+	}
+
+	if timeInForce.Compare(PostOnly) != 0 {
+		return nil
+	}
+
+	if oppositeOrder, _, found := (Matcher{mapper: mapper, supplementAuxiliary: supplementAuxiliary}).GetBestOrder(context, order.GetClassificationID(), order.GetTakerOwnableID(), order.GetMakerOwnableID(), make(map[string]bool)); found && Crosses(order.GetExchangeRate().GetData().(data.DecData).Get(), oppositeOrder.GetExchangeRate().GetData().(data.DecData).Get()) {
+		return errors.InvalidRequest
+	}
+
+	return nil
+}
+
+// WithoutTimeInForce returns a new list of the properties other than the time in force, which is kept by the module and not declared
+// by order classifications
+func WithoutTimeInForce(propertyList lists.PropertyList) lists.PropertyList {
+	var propertyArray []properties.Property
+
+	for _, property := range propertyList.GetList() {
+		if property.GetID().Compare(constants.TimeInForceProperty) != 0 {
+			propertyArray = append(propertyArray, property)
+		}
+	}
+
+	return base.NewPropertyList(propertyArray...)
+}
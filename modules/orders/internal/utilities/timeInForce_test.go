@@ -0,0 +1,48 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	"github.com/AssetMantle/modules/schema/properties/constants"
+)
+
+func TestIsTimeInForce(t *testing.T) {
+	require.True(t, IsTimeInForce(GoodTillCancelled))
+	require.True(t, IsTimeInForce(ImmediateOrCancel))
+	require.True(t, IsTimeInForce(FillOrKill))
+	require.True(t, IsTimeInForce(PostOnly))
+	require.False(t, IsTimeInForce(baseIDs.NewID("")))
+	require.False(t, IsTimeInForce(baseIDs.NewID("GTD")))
+}
+
+func TestGetTimeInForce(t *testing.T) {
+	context := sdkTypes.NewContext(nil, abciTypes.Header{}, false, log.NewNopLogger())
+	order := mappable.NewOrder(key.NewOrderID(baseIDs.NewID("classification"), baseIDs.NewID("maker"), baseIDs.NewID("taker"), baseIDs.NewID("2.000000000000000000"), baseIDs.NewID("1"), baseIDs.NewID("makerID"), baseLists.NewPropertyList()), baseLists.NewPropertyList(), baseLists.NewPropertyList())
+
+	timeInForce, err := GetTimeInForce(context, nil, order)
+	require.Nil(t, err)
+	require.Equal(t, GoodTillCancelled, timeInForce)
+}
+
+func TestWithoutTimeInForce(t *testing.T) {
+	makerOwnableSplit := baseProperties.NewProperty(constants.MakerOwnableSplitProperty.GetKey(), baseData.NewDecData(sdkTypes.OneDec()))
+	propertyList := baseLists.NewPropertyList(makerOwnableSplit, baseProperties.NewProperty(constants.TimeInForceProperty.GetKey(), baseData.NewIDData(PostOnly)))
+
+	require.Equal(t, baseLists.NewPropertyList(makerOwnableSplit), WithoutTimeInForce(propertyList))
+	require.NotNil(t, propertyList.GetProperty(constants.TimeInForceProperty))
+	require.Equal(t, baseLists.NewPropertyList(makerOwnableSplit), WithoutTimeInForce(baseLists.NewPropertyList(makerOwnableSplit)))
+}
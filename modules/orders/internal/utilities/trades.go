@@ -0,0 +1,90 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"bytes"
+	"sort"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+// RecordTrade writes the record of a fill of the order in the block, in which its maker gave the maker ownable split to the taker and received
+// the taker ownable split, sequencing it after the last trade recorded in the block, and sets the last prices of the pair in both directions
+func RecordTrade(context sdkTypes.Context, mapper helpers.Mapper, order mappables.Order, takerID ids.ID, makerOwnableSplit sdkTypes.Dec, takerOwnableSplit sdkTypes.Dec) {
+	sequence := int64(1)
+
+	// trade keys order the trades of a height by sequence so the last one recorded is the first in reverse
+	mapper.ReverseIterate(context, key.FromTradeID(key.NewTradeID(context.BlockHeight(), 0)), func(mappable helpers.Mappable) bool {
+		sequence = key.ReadTradeSequence(mappable.(mappables.Trade).GetKey().(ids.ID)) + 1
+		return true
+	})
+
+	trade := mappable.NewTrade(key.NewTradeID(context.BlockHeight(), sequence), order, takerID, makerOwnableSplit, takerOwnableSplit)
+	trades := mapper.NewCollection(context).Add(trade)
+
+	// fills rounded down to nothing on either side set no price
+	if makerOwnableSplit.IsPositive() && takerOwnableSplit.IsPositive() {
+		height := baseTypes.NewHeight(context.BlockHeight())
+		trades.Mutate(mappable.NewLastPrice(order.GetMakerOwnableID(), order.GetTakerOwnableID(), trade.GetExchangeRate(), height))
+		trades.Mutate(mappable.NewLastPrice(order.GetTakerOwnableID(), order.GetMakerOwnableID(), makerOwnableSplit.QuoTruncate(sdkTypes.SmallestDec()).QuoTruncate(takerOwnableSplit), height))
+	}
+}
+
+// GetLastPrice returns the last price at which the maker ownable was traded for the taker ownable, if it ever was
+func GetLastPrice(context sdkTypes.Context, mapper helpers.Mapper, makerOwnableID ids.ID, takerOwnableID ids.ID) (mappables.LastPrice, bool) {
+	lastPriceKey := key.FromLastPriceID(key.NewLastPriceID(makerOwnableID, takerOwnableID))
+	lastPrice, ok := mapper.NewCollection(context).Fetch(lastPriceKey).Get(lastPriceKey).(mappables.LastPrice)
+
+	return lastPrice, ok
+}
+
+// GetLatestTrades returns the latest trades found under the index key bytes in any of the indexes, latest first, trades found in several of
+// the indexes are returned once and at most limit trades are returned, the limit being bounded as pages are
+func GetLatestTrades(context sdkTypes.Context, mapper helpers.Mapper, indexList []helpers.Index, indexKeyBytes []byte, limit int) []mappables.Trade {
+	if limit <= 0 {
+		limit = constants.DefaultPaginationLimit
+	} else if limit > constants.MaxPaginationLimit {
+		limit = constants.MaxPaginationLimit
+	}
+
+	tradeMap := make(map[string]mappables.Trade)
+
+	for _, index := range indexList {
+		var tradeList []mappables.Trade
+
+		// index entries follow the trade keys so only the first limit trades of each index in reverse can be among the latest
+		mapper.ReverseIterateIndex(context, index, indexKeyBytes, func(mappable helpers.Mappable) bool {
+			tradeList = append(tradeList, mappable.(mappables.Trade))
+			return len(tradeList) >= limit
+		})
+
+		for _, trade := range tradeList {
+			tradeMap[string(trade.GetKey().GenerateStoreKeyBytes())] = trade
+		}
+	}
+
+	tradeList := make([]mappables.Trade, 0, len(tradeMap))
+	for _, trade := range tradeMap {
+		tradeList = append(tradeList, trade)
+	}
+
+	sort.Slice(tradeList, func(i, j int) bool {
+		return bytes.Compare(tradeList[i].GetKey().GenerateStoreKeyBytes(), tradeList[j].GetKey().GenerateStoreKeyBytes()) > 0
+	})
+
+	if len(tradeList) > limit {
+		tradeList = tradeList[:limit]
+	}
+
+	return tradeList
+}
@@ -0,0 +1,80 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/orders/internal/key"
+	"github.com/AssetMantle/modules/modules/orders/internal/mappable"
+	"github.com/AssetMantle/modules/modules/orders/internal/mapper"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/utilities/test/schema/helpers/base"
+)
+
+func newTradesTestOrder(makerOwnableID string, takerOwnableID string, makerID string) mappables.Order {
+	return mappable.NewOrder(key.NewOrderID(baseIDs.NewID("classification"), baseIDs.NewID(makerOwnableID), baseIDs.NewID(takerOwnableID), baseIDs.NewID("2.000000000000000000"), baseIDs.NewID("1"), baseIDs.NewID(makerID), baseLists.NewPropertyList()), baseLists.NewPropertyList(), baseLists.NewPropertyList())
+}
+
+func TestRecordTrade(t *testing.T) {
+	context, storeKey, _ := base.SetupTest(t)
+	Mapper := mapper.Prototype().Initialize(storeKey)
+
+	context = context.WithBlockHeight(5)
+	RecordTrade(context, Mapper, newTradesTestOrder("a", "b", "alice"), baseIDs.NewID("bob"), sdkTypes.NewDec(1), sdkTypes.NewDec(2))
+	RecordTrade(context, Mapper, newTradesTestOrder("b", "a", "bob"), baseIDs.NewID("carol"), sdkTypes.NewDec(2), sdkTypes.NewDec(1))
+
+	context = context.WithBlockHeight(6)
+	RecordTrade(context, Mapper, newTradesTestOrder("a", "c", "carol"), baseIDs.NewID("alice"), sdkTypes.NewDec(3), sdkTypes.NewDec(3))
+
+	getTradeKeys := func(tradeList []mappables.Trade) []helpers.Key {
+		var tradeKeyList []helpers.Key
+		for _, trade := range tradeList {
+			tradeKeyList = append(tradeKeyList, trade.GetKey())
+		}
+
+		return tradeKeyList
+	}
+
+	// trades of a pair in either direction, latest first
+	require.Equal(t, []helpers.Key{key.FromTradeID(key.NewTradeID(5, 2)), key.FromTradeID(key.NewTradeID(5, 1))}, getTradeKeys(GetLatestTrades(context, Mapper, []helpers.Index{mapper.PairIndex}, mapper.GeneratePairKeyBytes(baseIDs.NewID("b"), baseIDs.NewID("a")), 0)))
+	require.Equal(t, []helpers.Key{key.FromTradeID(key.NewTradeID(5, 2))}, getTradeKeys(GetLatestTrades(context, Mapper, []helpers.Index{mapper.PairIndex}, mapper.GeneratePairKeyBytes(baseIDs.NewID("a"), baseIDs.NewID("b")), 1)))
+
+	// trades an identity made or took
+	require.Equal(t, []helpers.Key{key.FromTradeID(key.NewTradeID(6, 1)), key.FromTradeID(key.NewTradeID(5, 1))}, getTradeKeys(GetLatestTrades(context, Mapper, []helpers.Index{mapper.MakerIndex, mapper.TakerIndex}, mapper.GenerateIdentityKeyBytes(baseIDs.NewID("alice")), 0)))
+	require.Equal(t, []helpers.Key{key.FromTradeID(key.NewTradeID(6, 1)), key.FromTradeID(key.NewTradeID(5, 2))}, getTradeKeys(GetLatestTrades(context, Mapper, []helpers.Index{mapper.MakerIndex, mapper.TakerIndex}, mapper.GenerateIdentityKeyBytes(baseIDs.NewID("carol")), 0)))
+	require.Equal(t, 0, len(GetLatestTrades(context, Mapper, []helpers.Index{mapper.MakerIndex, mapper.TakerIndex}, mapper.GenerateIdentityKeyBytes(baseIDs.NewID("dave")), 0)))
+
+	// last prices of each direction of a pair, set by the latest trade of the pair
+	lastPrice, found := GetLastPrice(context, Mapper, baseIDs.NewID("a"), baseIDs.NewID("b"))
+	require.Equal(t, true, found)
+	require.Equal(t, sdkTypes.NewDec(2).QuoTruncate(sdkTypes.SmallestDec()), lastPrice.GetExchangeRate())
+	require.Equal(t, int64(5), lastPrice.GetHeight().Get())
+
+	lastPrice, found = GetLastPrice(context, Mapper, baseIDs.NewID("b"), baseIDs.NewID("a"))
+	require.Equal(t, true, found)
+	require.Equal(t, sdkTypes.NewDecWithPrec(5, 1).QuoTruncate(sdkTypes.SmallestDec()), lastPrice.GetExchangeRate())
+
+	lastPrice, found = GetLastPrice(context, Mapper, baseIDs.NewID("c"), baseIDs.NewID("a"))
+	require.Equal(t, true, found)
+	require.Equal(t, sdkTypes.OneDec().QuoTruncate(sdkTypes.SmallestDec()), lastPrice.GetExchangeRate())
+	require.Equal(t, int64(6), lastPrice.GetHeight().Get())
+
+	_, found = GetLastPrice(context, Mapper, baseIDs.NewID("b"), baseIDs.NewID("c"))
+	require.Equal(t, false, found)
+
+	// sequences follow the last trade of the height beyond what a byte holds
+	context = context.WithBlockHeight(7)
+	for i := 0; i < 300; i++ {
+		RecordTrade(context, Mapper, newTradesTestOrder("d", "e", "dave"), baseIDs.NewID("erin"), sdkTypes.NewDec(1), sdkTypes.NewDec(2))
+	}
+
+	require.Equal(t, []helpers.Key{key.FromTradeID(key.NewTradeID(7, 300)), key.FromTradeID(key.NewTradeID(7, 299))}, getTradeKeys(GetLatestTrades(context, Mapper, []helpers.Index{mapper.MakerIndex, mapper.TakerIndex}, mapper.GenerateIdentityKeyBytes(baseIDs.NewID("dave")), 2)))
+}
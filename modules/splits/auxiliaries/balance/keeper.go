@@ -0,0 +1,38 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package balance
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+type auxiliaryKeeper struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeper)(nil)
+
+func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request helpers.AuxiliaryRequest) helpers.AuxiliaryResponse {
+	auxiliaryRequest := auxiliaryRequestFromInterface(request)
+
+	splitID := key.NewSplitID(auxiliaryRequest.OwnerID, auxiliaryRequest.OwnableID)
+
+	if split, ok := auxiliaryKeeper.mapper.NewCollection(context).Fetch(key.FromID(splitID)).Get(key.FromID(splitID)).(mappables.Split); ok {
+		return newAuxiliaryResponse(split.GetValue(), nil)
+	}
+
+	return newAuxiliaryResponse(sdkTypes.ZeroDec(), nil)
+}
+
+func (auxiliaryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
+	return auxiliaryKeeper{mapper: mapper}
+}
+
+func keeperPrototype() helpers.AuxiliaryKeeper {
+	return auxiliaryKeeper{}
+}
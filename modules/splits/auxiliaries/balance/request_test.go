@@ -0,0 +1,24 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package balance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_Balance_Request(t *testing.T) {
+	ownerID := baseIDs.NewID("ownerID")
+	ownableID := baseIDs.NewID("ownableID")
+
+	testAuxiliaryRequest := NewAuxiliaryRequest(ownerID, ownableID)
+
+	require.Equal(t, auxiliaryRequest{OwnerID: ownerID, OwnableID: ownableID}, testAuxiliaryRequest)
+	require.Equal(t, nil, testAuxiliaryRequest.Validate())
+	require.Equal(t, testAuxiliaryRequest, auxiliaryRequestFromInterface(testAuxiliaryRequest))
+	require.Equal(t, auxiliaryRequest{}, auxiliaryRequestFromInterface(nil))
+}
@@ -0,0 +1,52 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package balance
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+type auxiliaryResponse struct {
+	Success bool         `json:"success"`
+	Error   error        `json:"error"`
+	Value   sdkTypes.Dec `json:"value"`
+}
+
+var _ helpers.AuxiliaryResponse = (*auxiliaryResponse)(nil)
+
+func (auxiliaryResponse auxiliaryResponse) IsSuccessful() bool {
+	return auxiliaryResponse.Success
+}
+func (auxiliaryResponse auxiliaryResponse) GetError() error {
+	return auxiliaryResponse.Error
+}
+func newAuxiliaryResponse(value sdkTypes.Dec, error error) helpers.AuxiliaryResponse {
+	if error != nil {
+		return auxiliaryResponse{
+			Success: false,
+			Error:   error,
+		}
+	}
+
+	return auxiliaryResponse{
+		Success: true,
+		Value:   value,
+	}
+}
+
+func GetValueFromResponse(response helpers.AuxiliaryResponse) (sdkTypes.Dec, error) {
+	switch value := response.(type) {
+	case auxiliaryResponse:
+		if value.IsSuccessful() {
+			return value.Value, nil
+		}
+
+		return sdkTypes.Dec{}, value.GetError()
+	default:
+		return sdkTypes.Dec{}, errors.InvalidRequest
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package balance
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants/errors"
+)
+
+func Test_Balance_Response(t *testing.T) {
+	testAuxiliaryResponse := newAuxiliaryResponse(sdkTypes.NewDec(10), nil)
+	require.Equal(t, auxiliaryResponse{Success: true, Error: nil, Value: sdkTypes.NewDec(10)}, testAuxiliaryResponse)
+	require.Equal(t, true, testAuxiliaryResponse.IsSuccessful())
+	require.Equal(t, nil, testAuxiliaryResponse.GetError())
+
+	testAuxiliaryResponse2 := newAuxiliaryResponse(sdkTypes.NewDec(10), errors.IncorrectFormat)
+	require.Equal(t, auxiliaryResponse{Success: false, Error: errors.IncorrectFormat}, testAuxiliaryResponse2)
+	require.Equal(t, false, testAuxiliaryResponse2.IsSuccessful())
+	require.Equal(t, errors.IncorrectFormat, testAuxiliaryResponse2.GetError())
+
+	value, err := GetValueFromResponse(testAuxiliaryResponse)
+	require.Equal(t, sdkTypes.NewDec(10), value)
+	require.Nil(t, err)
+
+	_, err = GetValueFromResponse(testAuxiliaryResponse2)
+	require.Equal(t, errors.IncorrectFormat, err)
+}
@@ -7,9 +7,12 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
 	"github.com/AssetMantle/modules/schema/helpers"
 	"github.com/AssetMantle/modules/schema/mappables"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
 )
 
 type auxiliaryKeeper struct {
@@ -20,23 +23,36 @@ var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeper)(nil)
 
 func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request helpers.AuxiliaryRequest) helpers.AuxiliaryResponse {
 	auxiliaryRequest := auxiliaryRequestFromInterface(request)
-	splitID := key.NewSplitID(auxiliaryRequest.OwnerID, auxiliaryRequest.OwnableID)
-	splits := auxiliaryKeeper.mapper.NewCollection(context).Fetch(key.FromID(splitID))
+	splits := auxiliaryKeeper.mapper.NewCollection(context)
+	splitsKey := key.FromID(key.NewSplitID(auxiliaryRequest.OwnerID, auxiliaryRequest.OwnableID))
 
-	split := splits.Get(key.FromID(splitID))
-	if split == nil {
+	split, ok := splits.Fetch(splitsKey).Get(splitsKey).(mappables.Split)
+	if !ok {
 		return newAuxiliaryResponse(errors.EntityNotFound)
 	}
 
-	switch split = split.(mappables.Split).Send(auxiliaryRequest.Value).(mappables.Split); {
-	case split.(mappables.Split).GetValue().LT(sdkTypes.ZeroDec()):
+	if split.GetValue().LT(auxiliaryRequest.Value) {
 		return newAuxiliaryResponse(errors.InsufficientBalance)
-	case split.(mappables.Split).GetValue().Equal(sdkTypes.ZeroDec()):
-		splits.Remove(split)
-	default:
-		splits.Mutate(split)
 	}
 
+	// holdings locked by vesting schedules cannot be burnt, as they cannot be sent
+	if _, err := utilities.SubtractUnlockedSplits(splits, auxiliaryRequest.OwnerID, auxiliaryRequest.OwnableID, auxiliaryRequest.Value, baseTypes.NewHeight(context.BlockHeight())); err != nil {
+		return newAuxiliaryResponse(err)
+	}
+
+	if _, err := utilities.DecreaseSupply(splits, auxiliaryRequest.OwnableID, auxiliaryRequest.Value); err != nil {
+		return newAuxiliaryResponse(err)
+	}
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitBurned,
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, auxiliaryRequest.OwnerID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, auxiliaryRequest.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, auxiliaryRequest.Value.String()),
+		),
+	)
+
 	return newAuxiliaryResponse(nil)
 }
 
@@ -25,6 +25,7 @@ import (
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
 )
 
 type TestKeepers struct {
@@ -84,7 +85,7 @@ func Test_Burn_Aux_Keeper_Help(t *testing.T) {
 	splitID2 := key.NewSplitID(ownerID2, ownableID2)
 	splits := sdkTypes.NewDec(10)
 
-	keepers.SplitsKeeper.(auxiliaryKeeper).mapper.NewCollection(context).Add(mappable.NewSplit(splitID, splits)).Add(mappable.NewSplit(splitID2, splits))
+	keepers.SplitsKeeper.(auxiliaryKeeper).mapper.NewCollection(context).Add(mappable.NewSplit(splitID, splits)).Add(mappable.NewSplit(splitID2, splits)).Add(mappable.NewSupply(ownableID, splits)).Add(mappable.NewSupply(ownableID2, splits))
 
 	t.Run("PositiveCase- mutate split", func(t *testing.T) {
 		want := newAuxiliaryResponse(nil)
@@ -100,6 +101,21 @@ func Test_Burn_Aux_Keeper_Help(t *testing.T) {
 		}
 	})
 
+	t.Run("NegativeCase-Vesting Locked", func(t *testing.T) {
+		vestedID := baseIDs.NewID("vestedID")
+		keepers.SplitsKeeper.(auxiliaryKeeper).mapper.NewCollection(context).Add(mappable.NewSplit(key.NewSplitID(vestedID, ownableID), splits)).Add(mappable.NewVesting(vestedID, ownableID, ownerID, mappable.CliffVesting, sdkTypes.NewDec(6), baseTypes.NewHeight(0), baseTypes.NewHeight(100), 0))
+
+		want := newAuxiliaryResponse(errors.InsufficientBalance)
+		if got := keepers.SplitsKeeper.Help(context, NewAuxiliaryRequest(vestedID, ownableID, sdkTypes.NewDec(5))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		want = newAuxiliaryResponse(nil)
+		if got := keepers.SplitsKeeper.Help(context, NewAuxiliaryRequest(vestedID, ownableID, sdkTypes.NewDec(4))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
 	t.Run("NegativeCase-Nil Value", func(t *testing.T) {
 		t.Parallel()
 		want := newAuxiliaryResponse(errors.EntityNotFound)
@@ -0,0 +1,48 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package lock
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type auxiliaryKeeper struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeper)(nil)
+
+func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request helpers.AuxiliaryRequest) helpers.AuxiliaryResponse {
+	auxiliaryRequest := auxiliaryRequestFromInterface(request)
+
+	if _, err := utilities.LockEscrow(auxiliaryKeeper.mapper.NewCollection(context), auxiliaryRequest.HolderID, auxiliaryRequest.ReferenceID, auxiliaryRequest.OwnerID, auxiliaryRequest.OwnableID, auxiliaryRequest.Value, baseTypes.NewHeight(context.BlockHeight())); err != nil {
+		return newAuxiliaryResponse(err)
+	}
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.EscrowLocked,
+			sdkTypes.NewAttribute(events.AttributeKeyHolderID, auxiliaryRequest.HolderID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyReferenceID, auxiliaryRequest.ReferenceID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, auxiliaryRequest.OwnerID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, auxiliaryRequest.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, auxiliaryRequest.Value.String()),
+		),
+	)
+
+	return newAuxiliaryResponse(nil)
+}
+
+func (auxiliaryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
+	return auxiliaryKeeper{mapper: mapper}
+}
+
+func keeperPrototype() helpers.AuxiliaryKeeper {
+	return auxiliaryKeeper{}
+}
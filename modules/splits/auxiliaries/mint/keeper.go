@@ -4,6 +4,8 @@
 package mint
 
 import (
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/modules/splits/internal/key"
@@ -21,7 +23,7 @@ var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeper)(nil)
 func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request helpers.AuxiliaryRequest) helpers.AuxiliaryResponse {
 	auxiliaryRequest := auxiliaryRequestFromInterface(request)
 	splitID := key.NewSplitID(auxiliaryRequest.OwnerID, auxiliaryRequest.OwnableID)
-	splits := auxiliaryKeeper.mapper.NewCollection(context).Fetch(key.FromID(splitID))
+	splits := utilities.Checkpoint(auxiliaryKeeper.mapper.NewCollection(context), auxiliaryRequest.OwnerID, auxiliaryRequest.OwnableID).Fetch(key.FromID(splitID))
 
 	split := splits.Get(key.FromID(splitID))
 	if split == nil {
@@ -30,6 +32,19 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 		splits.Mutate(split.(mappables.Split).Receive(auxiliaryRequest.Value).(mappables.Split))
 	}
 
+	if _, err := utilities.IncreaseSupply(splits, auxiliaryRequest.OwnableID, auxiliaryRequest.Value); err != nil {
+		return newAuxiliaryResponse(err)
+	}
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitMinted,
+			sdkTypes.NewAttribute(events.AttributeKeyToID, auxiliaryRequest.OwnerID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, auxiliaryRequest.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, auxiliaryRequest.Value.String()),
+		),
+	)
+
 	return newAuxiliaryResponse(nil)
 }
 
@@ -20,6 +20,7 @@ import (
 	"github.com/AssetMantle/modules/modules/splits/internal/key"
 	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
 	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
 	"github.com/AssetMantle/modules/schema"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
@@ -95,4 +96,7 @@ func Test_Burn_Aux_Keeper_Help(t *testing.T) {
 		}
 	})
 
+	t.Run("PositiveCase - Supply Increased", func(t *testing.T) {
+		require.Equal(t, sdkTypes.NewDec(12), utilities.GetSupply(keepers.SplitsKeeper.(auxiliaryKeeper).mapper.NewCollection(context), baseIDs.NewID("ownableID1")))
+	})
 }
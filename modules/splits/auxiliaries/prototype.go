@@ -4,9 +4,13 @@
 package auxiliaries
 
 import (
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/balance"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/burn"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/mint"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/renumerate"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/settle"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
@@ -14,9 +18,13 @@ import (
 
 func Prototype() helpers.Auxiliaries {
 	return baseHelpers.NewAuxiliaries(
+		balance.Auxiliary,
 		burn.Auxiliary,
+		lock.Auxiliary,
 		mint.Auxiliary,
+		release.Auxiliary,
 		renumerate.Auxiliary,
+		settle.Auxiliary,
 		transfer.Auxiliary,
 	)
 }
@@ -4,22 +4,30 @@
 package auxiliaries
 
 import (
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/balance"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/burn"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/mint"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/renumerate"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/settle"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 )
 
 func TestPrototype(t *testing.T) {
 	require.Equal(t, Prototype().Get("transfer").GetName(), baseHelpers.NewAuxiliaries(
+		balance.Auxiliary,
 		burn.Auxiliary,
+		lock.Auxiliary,
 		mint.Auxiliary,
+		release.Auxiliary,
 		renumerate.Auxiliary,
+		settle.Auxiliary,
 		transfer.Auxiliary,
 	).Get("transfer").GetName())
 }
@@ -0,0 +1,42 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"github.com/asaskevich/govalidator"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+)
+
+type auxiliaryRequest struct {
+	HolderID    ids.ID       `json:"holderID" valid:"required~required field holderID missing"`
+	ReferenceID ids.ID       `json:"referenceID" valid:"required~required field referenceID missing"`
+	Value       sdkTypes.Dec `json:"value" valid:"required~required field value missing"`
+}
+
+var _ helpers.AuxiliaryRequest = (*auxiliaryRequest)(nil)
+
+func (auxiliaryRequest auxiliaryRequest) Validate() error {
+	_, err := govalidator.ValidateStruct(auxiliaryRequest)
+	return err
+}
+
+func auxiliaryRequestFromInterface(request helpers.AuxiliaryRequest) auxiliaryRequest {
+	switch value := request.(type) {
+	case auxiliaryRequest:
+		return value
+	default:
+		return auxiliaryRequest{}
+	}
+}
+
+func NewAuxiliaryRequest(holderID ids.ID, referenceID ids.ID, value sdkTypes.Dec) helpers.AuxiliaryRequest {
+	return auxiliaryRequest{
+		HolderID:    holderID,
+		ReferenceID: referenceID,
+		Value:       value,
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_Release_Request(t *testing.T) {
+	holderID := baseIDs.NewID("holderID")
+	referenceID := baseIDs.NewID("referenceID")
+	value := sdkTypes.NewDec(10)
+
+	testAuxiliaryRequest := NewAuxiliaryRequest(holderID, referenceID, value)
+
+	require.Equal(t, auxiliaryRequest{HolderID: holderID, ReferenceID: referenceID, Value: value}, testAuxiliaryRequest)
+	require.Equal(t, nil, testAuxiliaryRequest.Validate())
+	require.Equal(t, testAuxiliaryRequest, auxiliaryRequestFromInterface(testAuxiliaryRequest))
+	require.Equal(t, auxiliaryRequest{}, auxiliaryRequestFromInterface(nil))
+}
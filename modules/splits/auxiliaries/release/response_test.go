@@ -0,0 +1,24 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package release
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants/errors"
+)
+
+func Test_Release_Response(t *testing.T) {
+	testAuxiliaryResponse := newAuxiliaryResponse(nil)
+	require.Equal(t, auxiliaryResponse{Success: true, Error: nil}, testAuxiliaryResponse)
+	require.Equal(t, true, testAuxiliaryResponse.IsSuccessful())
+	require.Equal(t, nil, testAuxiliaryResponse.GetError())
+
+	testAuxiliaryResponse2 := newAuxiliaryResponse(errors.IncorrectFormat)
+	require.Equal(t, auxiliaryResponse{Success: false, Error: errors.IncorrectFormat}, testAuxiliaryResponse2)
+	require.Equal(t, false, testAuxiliaryResponse2.IsSuccessful())
+	require.Equal(t, errors.IncorrectFormat, testAuxiliaryResponse2.GetError())
+}
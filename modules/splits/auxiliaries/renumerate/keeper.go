@@ -7,8 +7,10 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
 	"github.com/AssetMantle/modules/schema/helpers"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
 )
 
 type auxiliaryKeeper struct {
@@ -21,13 +23,21 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 	auxiliaryRequest := auxiliaryRequestFromInterface(request)
 	splits := auxiliaryKeeper.mapper.NewCollection(context)
 
-	switch totalSplitsValue := utilities.GetOwnableTotalSplitsValue(splits, auxiliaryRequest.OwnableID); {
+	switch totalSplitsValue := utilities.GetSupply(splits, auxiliaryRequest.OwnableID); {
 	case totalSplitsValue.LT(auxiliaryRequest.Value):
 		if _, err := utilities.AddSplits(splits, auxiliaryRequest.OwnerID, auxiliaryRequest.OwnableID, auxiliaryRequest.Value.Sub(totalSplitsValue)); err != nil {
 			return newAuxiliaryResponse(err)
 		}
+
+		if _, err := utilities.IncreaseSupply(splits, auxiliaryRequest.OwnableID, auxiliaryRequest.Value.Sub(totalSplitsValue)); err != nil {
+			return newAuxiliaryResponse(err)
+		}
 	case totalSplitsValue.GT(auxiliaryRequest.Value):
-		if _, err := utilities.SubtractSplits(splits, auxiliaryRequest.OwnerID, auxiliaryRequest.OwnableID, totalSplitsValue.Sub(auxiliaryRequest.Value)); err != nil {
+		if _, err := utilities.SubtractUnlockedSplits(splits, auxiliaryRequest.OwnerID, auxiliaryRequest.OwnableID, totalSplitsValue.Sub(auxiliaryRequest.Value), baseTypes.NewHeight(context.BlockHeight())); err != nil {
+			return newAuxiliaryResponse(err)
+		}
+
+		if _, err := utilities.DecreaseSupply(splits, auxiliaryRequest.OwnableID, totalSplitsValue.Sub(auxiliaryRequest.Value)); err != nil {
 			return newAuxiliaryResponse(err)
 		}
 	case totalSplitsValue.IsZero():
@@ -36,6 +46,15 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 		return newAuxiliaryResponse(nil)
 	}
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitRenumerated,
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, auxiliaryRequest.OwnerID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, auxiliaryRequest.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, auxiliaryRequest.Value.String()),
+		),
+	)
+
 	return newAuxiliaryResponse(nil)
 }
 
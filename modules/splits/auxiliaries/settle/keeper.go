@@ -0,0 +1,54 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package settle
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+type auxiliaryKeeper struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.AuxiliaryKeeper = (*auxiliaryKeeper)(nil)
+
+func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request helpers.AuxiliaryRequest) helpers.AuxiliaryResponse {
+	auxiliaryRequest := auxiliaryRequestFromInterface(request)
+	collection := auxiliaryKeeper.mapper.NewCollection(context)
+
+	escrow, found := utilities.GetEscrow(collection, auxiliaryRequest.HolderID, auxiliaryRequest.ReferenceID)
+	if !found {
+		return newAuxiliaryResponse(errors.EntityNotFound)
+	}
+
+	if _, err := utilities.UnlockEscrow(collection, escrow, auxiliaryRequest.ToID, auxiliaryRequest.Value); err != nil {
+		return newAuxiliaryResponse(err)
+	}
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.EscrowSettled,
+			sdkTypes.NewAttribute(events.AttributeKeyHolderID, auxiliaryRequest.HolderID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyReferenceID, auxiliaryRequest.ReferenceID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyToID, auxiliaryRequest.ToID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, escrow.GetOwnableID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, auxiliaryRequest.Value.String()),
+		),
+	)
+
+	return newAuxiliaryResponse(nil)
+}
+
+func (auxiliaryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
+	return auxiliaryKeeper{mapper: mapper}
+}
+
+func keeperPrototype() helpers.AuxiliaryKeeper {
+	return auxiliaryKeeper{}
+}
@@ -0,0 +1,107 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package settle
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type TestKeepers struct {
+	SplitsKeeper helpers.AuxiliaryKeeper
+}
+
+func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	Mapper := baseHelpers.NewMapper(key.Prototype, mappable.Prototype).Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test"))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	keepers := TestKeepers{
+		SplitsKeeper: keeperPrototype().Initialize(Mapper, Parameters, []interface{}{}).(helpers.AuxiliaryKeeper),
+	}
+
+	return context, keepers
+
+}
+
+func Test_Settle_Aux_Keeper_Help(t *testing.T) {
+	context, keepers := CreateTestInput(t)
+
+	holderID := baseIDs.NewID("holderID")
+	referenceID := baseIDs.NewID("referenceID")
+	ownerID := baseIDs.NewID("ownerID")
+	ownableID := baseIDs.NewID("ownableID")
+	toID := baseIDs.NewID("toID")
+
+	keepers.SplitsKeeper.(auxiliaryKeeper).mapper.NewCollection(context).Add(mappable.NewSplit(key.NewSplitID(ownerID, ownableID), sdkTypes.NewDec(123)))
+	_, err := utilities.LockEscrow(keepers.SplitsKeeper.(auxiliaryKeeper).mapper.NewCollection(context), holderID, referenceID, ownerID, ownableID, sdkTypes.NewDec(23), baseTypes.NewHeight(context.BlockHeight()))
+	require.Nil(t, err)
+
+	t.Run("PositiveCase - Escrow Settled", func(t *testing.T) {
+		require.Equal(t, newAuxiliaryResponse(nil), keepers.SplitsKeeper.Help(context, NewAuxiliaryRequest(holderID, referenceID, toID, sdkTypes.NewDec(3))))
+
+		toSplitKey := key.FromID(key.NewSplitID(toID, ownableID))
+		require.Equal(t, sdkTypes.NewDec(3), keepers.SplitsKeeper.(auxiliaryKeeper).mapper.NewCollection(context).Fetch(toSplitKey).Get(toSplitKey).(mappables.Split).GetValue())
+
+		escrow, found := utilities.GetEscrow(keepers.SplitsKeeper.(auxiliaryKeeper).mapper.NewCollection(context), holderID, referenceID)
+		require.True(t, found)
+		require.Equal(t, sdkTypes.NewDec(20), escrow.GetValue())
+	})
+
+	t.Run("NegativeCase - Settle More Than Escrowed", func(t *testing.T) {
+		require.Equal(t, newAuxiliaryResponse(errors.InsufficientBalance), keepers.SplitsKeeper.Help(context, NewAuxiliaryRequest(holderID, referenceID, toID, sdkTypes.NewDec(21))))
+	})
+
+	t.Run("NegativeCase - Escrow Absent", func(t *testing.T) {
+		require.Equal(t, newAuxiliaryResponse(errors.EntityNotFound), keepers.SplitsKeeper.Help(context, NewAuxiliaryRequest(holderID, baseIDs.NewID("referenceID2"), toID, sdkTypes.NewDec(1))))
+	})
+}
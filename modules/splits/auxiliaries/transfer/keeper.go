@@ -7,10 +7,13 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/splits/internal/key"
 	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
 	"github.com/AssetMantle/modules/schema/helpers"
 	"github.com/AssetMantle/modules/schema/mappables"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
 )
 
 type auxiliaryKeeper struct {
@@ -28,6 +31,16 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 	fromSplitID := key.NewSplitID(auxiliaryRequest.FromID, auxiliaryRequest.OwnableID)
 	splits := auxiliaryKeeper.mapper.NewCollection(context)
 
+	// transfers on behalf of a spender other than the owner need to be covered by an allowance of the owner
+	if auxiliaryRequest.SpenderID != nil && len(auxiliaryRequest.SpenderID.Bytes()) != 0 && auxiliaryRequest.SpenderID.Compare(auxiliaryRequest.FromID) != 0 {
+		if _, err := utilities.SpendAllowance(splits, auxiliaryRequest.FromID, auxiliaryRequest.SpenderID, auxiliaryRequest.OwnableID, auxiliaryRequest.Value, baseTypes.NewHeight(context.BlockHeight())); err != nil {
+			return newAuxiliaryResponse(err)
+		}
+	}
+
+	utilities.Checkpoint(splits, auxiliaryRequest.FromID, auxiliaryRequest.OwnableID)
+	utilities.Checkpoint(splits, auxiliaryRequest.ToID, auxiliaryRequest.OwnableID)
+
 	fromSplit := splits.Fetch(key.FromID(fromSplitID)).Get(key.FromID(fromSplitID))
 	if fromSplit == nil {
 		return newAuxiliaryResponse(errors.EntityNotFound)
@@ -36,6 +49,8 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 	switch fromSplit = fromSplit.(mappables.Split).Send(auxiliaryRequest.Value).(mappables.Split); {
 	case fromSplit.(mappables.Split).GetValue().LT(sdkTypes.ZeroDec()):
 		return newAuxiliaryResponse(errors.NotAuthorized)
+	case fromSplit.(mappables.Split).GetValue().LT(utilities.GetLockedValue(splits, auxiliaryRequest.FromID, auxiliaryRequest.OwnableID, baseTypes.NewHeight(context.BlockHeight()))):
+		return newAuxiliaryResponse(errors.InsufficientBalance)
 	case fromSplit.(mappables.Split).GetValue().Equal(sdkTypes.ZeroDec()):
 		splits.Remove(fromSplit)
 	default:
@@ -50,6 +65,16 @@ func (auxiliaryKeeper auxiliaryKeeper) Help(context sdkTypes.Context, request he
 		splits.Mutate(toSplit.Receive(auxiliaryRequest.Value).(mappables.Split))
 	}
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitTransferred,
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, auxiliaryRequest.FromID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyToID, auxiliaryRequest.ToID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, auxiliaryRequest.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, auxiliaryRequest.Value.String()),
+		),
+	)
+
 	return newAuxiliaryResponse(nil)
 }
 
@@ -21,10 +21,12 @@ import (
 	"github.com/AssetMantle/modules/modules/splits/internal/key"
 	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
 	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
 	"github.com/AssetMantle/modules/schema"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
 )
 
 type TestKeepers struct {
@@ -109,6 +111,26 @@ func Test_Transfer_Aux_Keeper_Help(t *testing.T) {
 		}
 	})
 
+	t.Run("NegativeCase-Spender Without Allowance", func(t *testing.T) {
+		want := newAuxiliaryResponse(errors.NotAuthorized)
+		if got := keepers.SplitsKeeper.Help(context, NewSpenderAuxiliaryRequest(baseIDs.NewID("spenderID"), ownerID, toID, ownableID, sdkTypes.NewDec(1))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Positive case-  Value transfer by spender", func(t *testing.T) {
+		spenderID := baseIDs.NewID("spenderID")
+		keepers.SplitsKeeper.(auxiliaryKeeper).mapper.NewCollection(context).Add(mappable.NewAllowance(ownerID, spenderID, ownableID, sdkTypes.NewDec(1), baseTypes.NewHeight(-1)))
+
+		want := newAuxiliaryResponse(nil)
+		if got := keepers.SplitsKeeper.Help(context, NewSpenderAuxiliaryRequest(spenderID, ownerID, toID, ownableID, sdkTypes.NewDec(1))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		_, found := utilities.GetAllowance(keepers.SplitsKeeper.(auxiliaryKeeper).mapper.NewCollection(context), ownerID, spenderID, ownableID)
+		require.Equal(t, false, found)
+	})
+
 	t.Run("NegativeCase-No Value Present", func(t *testing.T) {
 		t.Parallel()
 		want := newAuxiliaryResponse(errors.EntityNotFound)
@@ -16,6 +16,7 @@ type auxiliaryRequest struct {
 	ToID      ids.ID       `json:"toID" valid:"required~required field toID missing"`
 	OwnableID ids.ID       `json:"ownableID" valid:"required~required field ownableID missing"`
 	Value     sdkTypes.Dec `json:"value" valid:"required~required field value missing"`
+	SpenderID ids.ID       `json:"spenderID"`
 }
 
 var _ helpers.AuxiliaryRequest = (*auxiliaryRequest)(nil)
@@ -42,3 +43,15 @@ func NewAuxiliaryRequest(fromID ids.ID, toID ids.ID, ownableID ids.ID, value sdk
 		Value:     value,
 	}
 }
+
+// NewSpenderAuxiliaryRequest creates a request to transfer the value on behalf of the spender, out of the allowance the owner of the split
+// grants it
+func NewSpenderAuxiliaryRequest(spenderID ids.ID, fromID ids.ID, toID ids.ID, ownableID ids.ID, value sdkTypes.Dec) helpers.AuxiliaryRequest {
+	return auxiliaryRequest{
+		FromID:    fromID,
+		ToID:      toID,
+		OwnableID: ownableID,
+		Value:     value,
+		SpenderID: spenderID,
+	}
+}
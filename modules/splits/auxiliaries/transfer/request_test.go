@@ -24,4 +24,7 @@ func Test_Transfer_Request(t *testing.T) {
 	require.Equal(t, testAuxiliaryRequest, auxiliaryRequestFromInterface(testAuxiliaryRequest))
 	require.Equal(t, auxiliaryRequest{}, auxiliaryRequestFromInterface(nil))
 
+	spenderID := baseIDs.NewID("spenderID")
+	require.Equal(t, auxiliaryRequest{FromID: fromID, ToID: toID, OwnableID: ownableID, Value: splits, SpenderID: spenderID}, NewSpenderAuxiliaryRequest(spenderID, fromID, toID, ownableID, splits))
+
 }
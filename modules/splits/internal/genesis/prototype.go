@@ -12,5 +12,5 @@ import (
 )
 
 func Prototype() helpers.Genesis {
-	return baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, []helpers.Mappable{}, parameters.Prototype().GetList())
+	return baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, []helpers.Mappable{}, parameters.Prototype().GetList(), key.SupplyPrototype, key.EscrowPrototype, key.VestingPrototype, key.AllowancePrototype, key.ClaimPrototype, key.DistributionPrototype, key.CheckpointPrototype)
 }
@@ -0,0 +1,71 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"fmt"
+	"sort"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// escrowInvariant checks that the escrows of every holder of an ownable add up to the split the holder pools them in
+type escrowInvariant struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.Invariant = (*escrowInvariant)(nil)
+
+func (escrowInvariant) GetName() string {
+	return "escrow"
+}
+func (escrowInvariant escrowInvariant) Check(context sdkTypes.Context) (string, bool) {
+	var message string
+
+	count := 0
+	collection := escrowInvariant.mapper.NewCollection(context)
+	escrowValues := utilities.GetHolderEscrowValues(collection)
+
+	holderIDList := make([]string, 0, len(escrowValues))
+	for holderID := range escrowValues {
+		holderIDList = append(holderIDList, holderID)
+	}
+
+	sort.Strings(holderIDList)
+
+	for _, holderID := range holderIDList {
+		ownableIDList := make([]string, 0, len(escrowValues[holderID]))
+		for ownableID := range escrowValues[holderID] {
+			ownableIDList = append(ownableIDList, ownableID)
+		}
+
+		sort.Strings(ownableIDList)
+
+		for _, ownableID := range ownableIDList {
+			splitValue := sdkTypes.ZeroDec()
+			splitKey := key.FromID(key.NewSplitID(baseIDs.NewID(holderID), baseIDs.NewID(ownableID)))
+
+			if split, ok := collection.Fetch(splitKey).Get(splitKey).(mappables.Split); ok {
+				splitValue = split.GetValue()
+			}
+
+			if escrowValue := escrowValues[holderID][ownableID]; !escrowValue.Equal(splitValue) {
+				count++
+				message += fmt.Sprintf("\tholder %s ownable %s escrows total %s, pooled %s\n", holderID, ownableID, escrowValue.String(), splitValue.String())
+			}
+		}
+	}
+
+	return fmt.Sprintf("found %d holder pools not matching their escrows\n%s", count, message), count != 0
+}
+func (escrowInvariant escrowInvariant) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ ...interface{}) helpers.Invariant {
+	escrowInvariant.mapper = mapper
+	return escrowInvariant
+}
@@ -0,0 +1,89 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/mapper"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func Test_Escrow_Invariant(t *testing.T) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	require.Nil(t, commitMultiStore.LoadLatestVersion())
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	holderID := baseIDs.NewID("holderID")
+	ownerID := baseIDs.NewID("ownerID")
+	ownableID := baseIDs.NewID("ownableID")
+
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	Mapper.NewCollection(context).Add(mappable.NewSplit(key.NewSplitID(ownerID, ownableID), sdkTypes.NewDec(10)))
+
+	_, err := utilities.LockEscrow(Mapper.NewCollection(context), holderID, baseIDs.NewID("referenceID1"), ownerID, ownableID, sdkTypes.NewDec(4), baseTypes.NewHeight(context.BlockHeight()))
+	require.Nil(t, err)
+	_, err = utilities.LockEscrow(Mapper.NewCollection(context), holderID, baseIDs.NewID("referenceID2"), ownerID, ownableID, sdkTypes.NewDec(3), baseTypes.NewHeight(context.BlockHeight()))
+	require.Nil(t, err)
+
+	invariant := escrowInvariant{}.Initialize(Mapper, nil)
+
+	require.Equal(t, "escrow", invariant.GetName())
+
+	t.Run("PositiveCase - Escrows Match Pool", func(t *testing.T) {
+		_, broken := invariant.Check(context)
+		require.False(t, broken)
+	})
+
+	t.Run("PositiveCase - Escrows Match Pool After Unlocking", func(t *testing.T) {
+		escrow, found := utilities.GetEscrow(Mapper.NewCollection(context), holderID, baseIDs.NewID("referenceID1"))
+		require.True(t, found)
+
+		_, err := utilities.UnlockEscrow(Mapper.NewCollection(context), escrow, baseIDs.NewID("toID"), sdkTypes.NewDec(4))
+		require.Nil(t, err)
+
+		_, found = utilities.GetEscrow(Mapper.NewCollection(context), holderID, baseIDs.NewID("referenceID1"))
+		require.False(t, found)
+
+		_, broken := invariant.Check(context)
+		require.False(t, broken)
+	})
+
+	t.Run("NegativeCase - Pool Does Not Match Escrows", func(t *testing.T) {
+		Mapper.NewCollection(context).Mutate(mappable.NewSplit(key.NewSplitID(holderID, ownableID), sdkTypes.NewDec(2)))
+
+		message, broken := invariant.Check(context)
+		require.True(t, broken)
+		require.Contains(t, message, "holder holderID ownable ownableID escrows total 3")
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// supplyInvariant checks that every supply record equals the sum of the splits of its ownable
+type supplyInvariant struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.Invariant = (*supplyInvariant)(nil)
+
+func (supplyInvariant) GetName() string {
+	return "supply"
+}
+func (supplyInvariant supplyInvariant) Check(context sdkTypes.Context) (string, bool) {
+	var message string
+
+	count := 0
+	collection := supplyInvariant.mapper.NewCollection(context)
+
+	var supplyList []mappables.Supply
+
+	collection.Iterate(
+		key.FromSupplyID(key.NewSupplyID(baseIDs.NewID(""))),
+		func(mappable helpers.Mappable) bool {
+			supplyList = append(supplyList, mappable.(mappables.Supply))
+			return false
+		},
+	)
+
+	for _, supply := range supplyList {
+		if totalSplitsValue := utilities.GetOwnableTotalSplitsValue(collection, supply.GetOwnableID()); !totalSplitsValue.Equal(supply.GetValue()) {
+			count++
+			message += fmt.Sprintf("\townable %s supply %s, splits total %s\n", supply.GetOwnableID().String(), supply.GetValue().String(), totalSplitsValue.String())
+		}
+	}
+
+	return fmt.Sprintf("found %d ownables with supply not matching their splits\n%s", count, message), count != 0
+}
+func (supplyInvariant supplyInvariant) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ ...interface{}) helpers.Invariant {
+	supplyInvariant.mapper = mapper
+	return supplyInvariant
+}
@@ -0,0 +1,68 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/mapper"
+	"github.com/AssetMantle/modules/schema"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_Supply_Invariant(t *testing.T) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	require.Nil(t, commitMultiStore.LoadLatestVersion())
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	ownableID := baseIDs.NewID("ownableID")
+
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	Mapper.NewCollection(context).Add(mappable.NewSplit(key.NewSplitID(baseIDs.NewID("ownerID1"), ownableID), sdkTypes.NewDec(10)))
+	Mapper.NewCollection(context).Add(mappable.NewSplit(key.NewSplitID(baseIDs.NewID("ownerID2"), ownableID), sdkTypes.NewDec(5)))
+	Mapper.NewCollection(context).Add(mappable.NewSupply(ownableID, sdkTypes.NewDec(15)))
+
+	invariant := supplyInvariant{}.Initialize(Mapper, nil)
+
+	require.Equal(t, "supply", invariant.GetName())
+
+	t.Run("PositiveCase - Supply Matches Splits", func(t *testing.T) {
+		_, broken := invariant.Check(context)
+		require.False(t, broken)
+	})
+
+	t.Run("NegativeCase - Supply Does Not Match Splits", func(t *testing.T) {
+		Mapper.NewCollection(context).Add(mappable.NewSplit(key.NewSplitID(baseIDs.NewID("ownerID3"), ownableID), sdkTypes.NewDec(1)))
+
+		message, broken := invariant.Check(context)
+		require.True(t, broken)
+		require.Contains(t, message, "ownable ownableID supply 15")
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package invariants
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// wrappedCoinsInvariant checks that the splits of every wrapped coin add up to the coins held by the module account
+type wrappedCoinsInvariant struct {
+	mapper       helpers.Mapper
+	supplyKeeper supply.Keeper
+}
+
+var _ helpers.Invariant = (*wrappedCoinsInvariant)(nil)
+
+func (wrappedCoinsInvariant) GetName() string {
+	return "wrapped-coins"
+}
+func (wrappedCoinsInvariant wrappedCoinsInvariant) Check(context sdkTypes.Context) (string, bool) {
+	var message string
+
+	count := 0
+	collection := wrappedCoinsInvariant.mapper.NewCollection(context)
+
+	var coins sdkTypes.Coins
+	if moduleAccount := wrappedCoinsInvariant.supplyKeeper.GetModuleAccount(context, module.Name); moduleAccount != nil {
+		coins = moduleAccount.GetCoins()
+	}
+
+	for _, coin := range coins {
+		if wrappedValue := utilities.GetSupply(collection, baseIDs.NewID(coin.Denom)); !wrappedValue.Equal(sdkTypes.NewDecFromInt(coin.Amount)) {
+			count++
+			message += fmt.Sprintf("\tdenom %s module balance %s, splits total %s\n", coin.Denom, coin.Amount.String(), wrappedValue.String())
+		}
+	}
+
+	return fmt.Sprintf("found %d wrapped denoms not matching the module balance\n%s", count, message), count != 0
+}
+func (wrappedCoinsInvariant wrappedCoinsInvariant) Initialize(mapper helpers.Mapper, _ helpers.Parameters, auxiliaries ...interface{}) helpers.Invariant {
+	wrappedCoinsInvariant.mapper = mapper
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case supply.Keeper:
+			wrappedCoinsInvariant.supplyKeeper = value
+		}
+	}
+
+	return wrappedCoinsInvariant
+}
@@ -0,0 +1,61 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/traits"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// allowanceID keys the allowance an owner grants a spender on one of its splits, so that the allowances of an owner are iterated together
+type allowanceID struct {
+	OwnerID   ids.ID `json:"ownerID" valid:"required~required field ownerID missing"`
+	SpenderID ids.ID `json:"spenderID" valid:"required~required field spenderID missing"`
+	OwnableID ids.ID `json:"ownableID" valid:"required~required field ownableID missing"`
+}
+
+var _ ids.ID = (*allowanceID)(nil)
+var _ helpers.Key = (*allowanceID)(nil)
+
+func (allowanceID allowanceID) Bytes() []byte {
+	return append(append(append([]byte{}, allowanceID.OwnerID.Bytes()...), allowanceID.SpenderID.Bytes()...), allowanceID.OwnableID.Bytes()...)
+}
+func (allowanceID allowanceID) String() string {
+	return strings.Join([]string{allowanceID.OwnerID.String(), allowanceID.SpenderID.String(), allowanceID.OwnableID.String()}, constants.SecondOrderCompositeIDSeparator)
+}
+func (allowanceID allowanceID) Compare(listable traits.Listable) int {
+	return bytes.Compare(allowanceID.Bytes(), allowanceIDFromInterface(listable).Bytes())
+}
+func (allowanceID allowanceID) GenerateStoreKeyBytes() []byte {
+	return module.AllowanceStoreKeyPrefix.GenerateStoreKey(allowanceID.Bytes())
+}
+func (allowanceID) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, allowanceID{})
+}
+func (allowanceID allowanceID) IsPartial() bool {
+	return len(allowanceID.SpenderID.Bytes()) == 0 || len(allowanceID.OwnableID.Bytes()) == 0
+}
+func (allowanceID allowanceID) Equals(key helpers.Key) bool {
+	return allowanceID.Compare(allowanceIDFromInterface(key)) == 0
+}
+
+// NewAllowanceID creates the ID of the allowance the owner grants the spender on its split of the ownable, an empty spender ID identifies
+// all the allowances of the owner
+func NewAllowanceID(ownerID ids.ID, spenderID ids.ID, ownableID ids.ID) ids.ID {
+	return allowanceID{
+		OwnerID:   baseIDs.NewID(ownerID.String()),
+		SpenderID: baseIDs.NewID(spenderID.String()),
+		OwnableID: baseIDs.NewID(ownableID.String()),
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_AllowanceID_Methods(t *testing.T) {
+	ownerID := baseIDs.NewID("ownerID")
+	spenderID := baseIDs.NewID("spenderID")
+	ownableID := baseIDs.NewID("ownableID")
+
+	testAllowanceID := NewAllowanceID(ownerID, spenderID, ownableID).(allowanceID)
+	testAllowanceID2 := NewAllowanceID(ownerID, baseIDs.NewID(""), baseIDs.NewID("")).(allowanceID)
+	require.NotPanics(t, func() {
+		require.Equal(t, ownerID.String()+constants.SecondOrderCompositeIDSeparator+spenderID.String()+constants.SecondOrderCompositeIDSeparator+ownableID.String(), testAllowanceID.String())
+		require.Equal(t, true, testAllowanceID.Equals(testAllowanceID))
+		require.Equal(t, false, testAllowanceID.Equals(testAllowanceID2))
+		require.Equal(t, false, testAllowanceID.IsPartial())
+		require.Equal(t, true, testAllowanceID2.IsPartial())
+		require.Equal(t, true, NewAllowanceID(ownerID, spenderID, baseIDs.NewID("")).(allowanceID).IsPartial())
+		require.Equal(t, module.AllowanceStoreKeyPrefix.GenerateStoreKey(ownerID.Bytes()), testAllowanceID2.GenerateStoreKeyBytes())
+		require.Equal(t, testAllowanceID, FromAllowanceID(testAllowanceID))
+		require.Equal(t, testAllowanceID, FromAllowanceID(baseIDs.NewID(testAllowanceID.String())))
+		require.Equal(t, ownerID, ReadAllowanceOwnerID(testAllowanceID))
+		require.Equal(t, spenderID, ReadAllowanceSpenderID(testAllowanceID))
+		require.Equal(t, ownableID, ReadAllowanceOwnableID(testAllowanceID))
+		require.Equal(t, NewAllowanceID(baseIDs.NewID(""), baseIDs.NewID(""), baseIDs.NewID("")), AllowancePrototype())
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/traits"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// checkpointID keys the holding of an owner of an ownable as of a distribution to the holders of the ownable, so that the checkpoints of a
+// holding are iterated together and latest first
+type checkpointID struct {
+	OwnerID   ids.ID `json:"ownerID" valid:"required~required field ownerID missing"`
+	OwnableID ids.ID `json:"ownableID" valid:"required~required field ownableID missing"`
+	Sequence  int64  `json:"sequence"`
+}
+
+var _ ids.ID = (*checkpointID)(nil)
+var _ helpers.Key = (*checkpointID)(nil)
+
+// Bytes leaves out a sequence that is not positive so that partial checkpoint IDs prefix the checkpoints of a holding
+func (checkpointID checkpointID) Bytes() []byte {
+	return append(append(append([]byte{}, checkpointID.OwnerID.Bytes()...), checkpointID.OwnableID.Bytes()...), sequenceBytes(checkpointID.Sequence)...)
+}
+func (checkpointID checkpointID) String() string {
+	return strings.Join([]string{checkpointID.OwnerID.String(), checkpointID.OwnableID.String(), strconv.FormatInt(checkpointID.Sequence, 10)}, constants.SecondOrderCompositeIDSeparator)
+}
+func (checkpointID checkpointID) Compare(listable traits.Listable) int {
+	return bytes.Compare(checkpointID.Bytes(), checkpointIDFromInterface(listable).Bytes())
+}
+func (checkpointID checkpointID) GenerateStoreKeyBytes() []byte {
+	return module.CheckpointStoreKeyPrefix.GenerateStoreKey(checkpointID.Bytes())
+}
+func (checkpointID) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, checkpointID{})
+}
+func (checkpointID checkpointID) IsPartial() bool {
+	return checkpointID.Sequence <= 0
+}
+func (checkpointID checkpointID) Equals(key helpers.Key) bool {
+	return checkpointID.Compare(checkpointIDFromInterface(key)) == 0
+}
+
+// NewCheckpointID creates the ID of the holding of the owner as of the distribution of the sequence to the holders of the ownable, a
+// sequence that is not positive identifies all the checkpoints of the holding
+func NewCheckpointID(ownerID ids.ID, ownableID ids.ID, sequence int64) ids.ID {
+	return checkpointID{
+		OwnerID:   baseIDs.NewID(ownerID.String()),
+		OwnableID: baseIDs.NewID(ownableID.String()),
+		Sequence:  sequence,
+	}
+}
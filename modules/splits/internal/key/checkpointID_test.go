@@ -0,0 +1,38 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_CheckpointID_Methods(t *testing.T) {
+	ownerID := baseIDs.NewID("ownerID")
+	ownableID := baseIDs.NewID("ownableID")
+
+	testCheckpointID := NewCheckpointID(ownerID, ownableID, 10).(checkpointID)
+	testCheckpointID2 := NewCheckpointID(ownerID, ownableID, 0).(checkpointID)
+	require.NotPanics(t, func() {
+		require.Equal(t, ownerID.String()+constants.SecondOrderCompositeIDSeparator+ownableID.String()+constants.SecondOrderCompositeIDSeparator+"10", testCheckpointID.String())
+		require.Equal(t, true, testCheckpointID.Equals(testCheckpointID))
+		require.Equal(t, false, testCheckpointID.Equals(testCheckpointID2))
+		require.Equal(t, false, testCheckpointID.IsPartial())
+		require.Equal(t, true, testCheckpointID2.IsPartial())
+		require.Equal(t, module.CheckpointStoreKeyPrefix.GenerateStoreKey(append(ownerID.Bytes(), ownableID.Bytes()...)), testCheckpointID2.GenerateStoreKeyBytes())
+		require.Equal(t, true, bytes.Compare(NewCheckpointID(ownerID, ownableID, 11).Bytes(), testCheckpointID.Bytes()) < 0)
+		require.Equal(t, testCheckpointID, FromCheckpointID(testCheckpointID))
+		require.Equal(t, testCheckpointID, FromCheckpointID(baseIDs.NewID(testCheckpointID.String())))
+		require.Equal(t, ownerID, ReadCheckpointOwnerID(testCheckpointID))
+		require.Equal(t, ownableID, ReadCheckpointOwnableID(testCheckpointID))
+		require.Equal(t, int64(10), ReadCheckpointSequence(testCheckpointID))
+		require.Equal(t, NewCheckpointID(baseIDs.NewID(""), baseIDs.NewID(""), 0), CheckpointPrototype())
+	})
+}
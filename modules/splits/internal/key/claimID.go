@@ -0,0 +1,59 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/traits"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// claimID keys the share of a distribution an owner has claimed, so that the claims of an owner are iterated together
+type claimID struct {
+	OwnerID        ids.ID `json:"ownerID" valid:"required~required field ownerID missing"`
+	DistributionID ids.ID `json:"distributionID" valid:"required~required field distributionID missing"`
+}
+
+var _ ids.ID = (*claimID)(nil)
+var _ helpers.Key = (*claimID)(nil)
+
+func (claimID claimID) Bytes() []byte {
+	return append(append([]byte{}, claimID.OwnerID.Bytes()...), claimID.DistributionID.Bytes()...)
+}
+func (claimID claimID) String() string {
+	return strings.Join([]string{claimID.OwnerID.String(), claimID.DistributionID.String()}, constants.SecondOrderCompositeIDSeparator)
+}
+func (claimID claimID) Compare(listable traits.Listable) int {
+	return bytes.Compare(claimID.Bytes(), claimIDFromInterface(listable).Bytes())
+}
+func (claimID claimID) GenerateStoreKeyBytes() []byte {
+	return module.ClaimStoreKeyPrefix.GenerateStoreKey(claimID.Bytes())
+}
+func (claimID) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, claimID{})
+}
+func (claimID claimID) IsPartial() bool {
+	return len(claimID.DistributionID.Bytes()) == 0
+}
+func (claimID claimID) Equals(key helpers.Key) bool {
+	return claimID.Compare(claimIDFromInterface(key)) == 0
+}
+
+// NewClaimID creates the ID of the share of the distribution the owner has claimed, an empty distribution ID identifies all the claims of
+// the owner
+func NewClaimID(ownerID ids.ID, distributionID ids.ID) ids.ID {
+	return claimID{
+		OwnerID:        baseIDs.NewID(ownerID.String()),
+		DistributionID: baseIDs.NewID(distributionID.String()),
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_ClaimID_Methods(t *testing.T) {
+	ownerID := baseIDs.NewID("ownerID")
+	distributionID := baseIDs.NewID(NewDistributionID(baseIDs.NewID("heldOwnableID"), 10).String())
+
+	testClaimID := NewClaimID(ownerID, distributionID).(claimID)
+	testClaimID2 := NewClaimID(ownerID, baseIDs.NewID("")).(claimID)
+	require.NotPanics(t, func() {
+		require.Equal(t, ownerID.String()+constants.SecondOrderCompositeIDSeparator+distributionID.String(), testClaimID.String())
+		require.Equal(t, true, testClaimID.Equals(testClaimID))
+		require.Equal(t, false, testClaimID.Equals(testClaimID2))
+		require.Equal(t, false, testClaimID.IsPartial())
+		require.Equal(t, true, testClaimID2.IsPartial())
+		require.Equal(t, module.ClaimStoreKeyPrefix.GenerateStoreKey(ownerID.Bytes()), testClaimID2.GenerateStoreKeyBytes())
+		require.Equal(t, testClaimID, FromClaimID(testClaimID))
+		require.Equal(t, testClaimID, FromClaimID(baseIDs.NewID(testClaimID.String())))
+		require.Equal(t, ownerID, ReadClaimOwnerID(testClaimID))
+		require.Equal(t, distributionID, ReadClaimDistributionID(testClaimID))
+		require.Equal(t, NewClaimID(baseIDs.NewID(""), baseIDs.NewID("")), ClaimPrototype())
+	})
+}
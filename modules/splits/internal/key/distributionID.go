@@ -0,0 +1,75 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/traits"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// distributionID keys a distribution to the holders of an ownable by its sequence among the distributions to the holders of that ownable
+type distributionID struct {
+	HeldOwnableID ids.ID `json:"heldOwnableID" valid:"required~required field heldOwnableID missing"`
+	Sequence      int64  `json:"sequence"`
+}
+
+var _ ids.ID = (*distributionID)(nil)
+var _ helpers.Key = (*distributionID)(nil)
+
+// Bytes leaves out a sequence that is not positive so that partial distribution IDs prefix the distributions of an ownable
+func (distributionID distributionID) Bytes() []byte {
+	return append(append([]byte{}, distributionID.HeldOwnableID.Bytes()...), sequenceBytes(distributionID.Sequence)...)
+}
+func (distributionID distributionID) String() string {
+	return strings.Join([]string{distributionID.HeldOwnableID.String(), strconv.FormatInt(distributionID.Sequence, 10)}, constants.SecondOrderCompositeIDSeparator)
+}
+func (distributionID distributionID) Compare(listable traits.Listable) int {
+	return bytes.Compare(distributionID.Bytes(), distributionIDFromInterface(listable).Bytes())
+}
+func (distributionID distributionID) GenerateStoreKeyBytes() []byte {
+	return module.DistributionStoreKeyPrefix.GenerateStoreKey(distributionID.Bytes())
+}
+func (distributionID) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, distributionID{})
+}
+func (distributionID distributionID) IsPartial() bool {
+	return distributionID.Sequence <= 0
+}
+func (distributionID distributionID) Equals(key helpers.Key) bool {
+	return distributionID.Compare(distributionIDFromInterface(key)) == 0
+}
+
+// NewDistributionID creates the ID of the distribution of the sequence to the holders of the held ownable, a sequence that is not positive
+// identifies all the distributions to its holders
+func NewDistributionID(heldOwnableID ids.ID, sequence int64) ids.ID {
+	return distributionID{
+		HeldOwnableID: baseIDs.NewID(heldOwnableID.String()),
+		Sequence:      sequence,
+	}
+}
+
+// sequenceBytes counts sequences down from the largest one so that iterating over a prefix visits the latest sequence first
+func sequenceBytes(sequence int64) []byte {
+	if sequence <= 0 {
+		return nil
+	}
+
+	Bytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(Bytes, uint64(math.MaxInt64-sequence))
+
+	return Bytes
+}
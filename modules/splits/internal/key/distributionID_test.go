@@ -0,0 +1,36 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_DistributionID_Methods(t *testing.T) {
+	heldOwnableID := baseIDs.NewID("heldOwnableID")
+
+	testDistributionID := NewDistributionID(heldOwnableID, 10).(distributionID)
+	testDistributionID2 := NewDistributionID(heldOwnableID, 0).(distributionID)
+	require.NotPanics(t, func() {
+		require.Equal(t, heldOwnableID.String()+constants.SecondOrderCompositeIDSeparator+"10", testDistributionID.String())
+		require.Equal(t, true, testDistributionID.Equals(testDistributionID))
+		require.Equal(t, false, testDistributionID.Equals(testDistributionID2))
+		require.Equal(t, false, testDistributionID.IsPartial())
+		require.Equal(t, true, testDistributionID2.IsPartial())
+		require.Equal(t, module.DistributionStoreKeyPrefix.GenerateStoreKey(heldOwnableID.Bytes()), testDistributionID2.GenerateStoreKeyBytes())
+		require.Equal(t, true, bytes.Compare(NewDistributionID(heldOwnableID, 11).Bytes(), testDistributionID.Bytes()) < 0)
+		require.Equal(t, testDistributionID, FromDistributionID(testDistributionID))
+		require.Equal(t, testDistributionID, FromDistributionID(baseIDs.NewID(testDistributionID.String())))
+		require.Equal(t, heldOwnableID, ReadDistributionHeldOwnableID(testDistributionID))
+		require.Equal(t, int64(10), ReadDistributionSequence(testDistributionID))
+		require.Equal(t, NewDistributionID(baseIDs.NewID(""), 0), DistributionPrototype())
+	})
+}
@@ -0,0 +1,58 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/traits"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+type escrowID struct {
+	HolderID    ids.ID `json:"holderID" valid:"required~required field holderID missing"`
+	ReferenceID ids.ID `json:"referenceID" valid:"required~required field referenceID missing"`
+}
+
+var _ ids.ID = (*escrowID)(nil)
+var _ helpers.Key = (*escrowID)(nil)
+
+func (escrowID escrowID) Bytes() []byte {
+	return append(append([]byte{}, escrowID.HolderID.Bytes()...), escrowID.ReferenceID.Bytes()...)
+}
+func (escrowID escrowID) String() string {
+	return strings.Join([]string{escrowID.HolderID.String(), escrowID.ReferenceID.String()}, constants.SecondOrderCompositeIDSeparator)
+}
+func (escrowID escrowID) Compare(listable traits.Listable) int {
+	return bytes.Compare(escrowID.Bytes(), escrowIDFromInterface(listable).Bytes())
+}
+func (escrowID escrowID) GenerateStoreKeyBytes() []byte {
+	return module.EscrowStoreKeyPrefix.GenerateStoreKey(escrowID.Bytes())
+}
+func (escrowID) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, escrowID{})
+}
+func (escrowID escrowID) IsPartial() bool {
+	return len(escrowID.ReferenceID.Bytes()) == 0
+}
+func (escrowID escrowID) Equals(key helpers.Key) bool {
+	return escrowID.Compare(escrowIDFromInterface(key)) == 0
+}
+
+// NewEscrowID returns the ID of the escrow the holder keeps under the reference, both are kept by their strings as references are
+// IDs of other modules
+func NewEscrowID(holderID ids.ID, referenceID ids.ID) ids.ID {
+	return escrowID{
+		HolderID:    baseIDs.NewID(holderID.String()),
+		ReferenceID: baseIDs.NewID(referenceID.String()),
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_EscrowID_Methods(t *testing.T) {
+	holderID := baseIDs.NewID("holderID")
+	referenceID := baseIDs.NewID("reference" + constants.SecondOrderCompositeIDSeparator + "ID")
+
+	testEscrowID := NewEscrowID(holderID, referenceID).(escrowID)
+	testEscrowID2 := NewEscrowID(holderID, baseIDs.NewID("")).(escrowID)
+	require.NotPanics(t, func() {
+		require.Equal(t, holderID.String()+constants.SecondOrderCompositeIDSeparator+referenceID.String(), testEscrowID.String())
+		require.Equal(t, true, testEscrowID.Equals(testEscrowID))
+		require.Equal(t, false, testEscrowID.Equals(testEscrowID2))
+		require.Equal(t, false, testEscrowID.IsPartial())
+		require.Equal(t, true, testEscrowID2.IsPartial())
+		require.Equal(t, module.EscrowStoreKeyPrefix.GenerateStoreKey(append(holderID.Bytes(), referenceID.Bytes()...)), testEscrowID.GenerateStoreKeyBytes())
+		require.Equal(t, testEscrowID, FromEscrowID(testEscrowID))
+		require.Equal(t, testEscrowID, FromEscrowID(baseIDs.NewID(testEscrowID.String())))
+		require.Equal(t, holderID, ReadEscrowHolderID(testEscrowID))
+		require.Equal(t, referenceID, ReadEscrowReferenceID(testEscrowID))
+		require.Equal(t, NewEscrowID(baseIDs.NewID(""), baseIDs.NewID("")), EscrowPrototype())
+	})
+}
@@ -11,3 +11,31 @@ import (
 func Prototype() helpers.Key {
 	return splitIDFromInterface(baseIDs.NewID(""))
 }
+
+func SupplyPrototype() helpers.Key {
+	return supplyIDFromInterface(baseIDs.NewID(""))
+}
+
+func EscrowPrototype() helpers.Key {
+	return escrowIDFromInterface(baseIDs.NewID(""))
+}
+
+func VestingPrototype() helpers.Key {
+	return vestingIDFromInterface(baseIDs.NewID(""))
+}
+
+func AllowancePrototype() helpers.Key {
+	return allowanceIDFromInterface(baseIDs.NewID(""))
+}
+
+func ClaimPrototype() helpers.Key {
+	return claimIDFromInterface(baseIDs.NewID(""))
+}
+
+func DistributionPrototype() helpers.Key {
+	return distributionIDFromInterface(baseIDs.NewID(""))
+}
+
+func CheckpointPrototype() helpers.Key {
+	return checkpointIDFromInterface(baseIDs.NewID(""))
+}
@@ -43,8 +43,17 @@ func (splitID splitID) Compare(listable traits.Listable) int {
 func (splitID splitID) GenerateStoreKeyBytes() []byte {
 	return module.StoreKeyPrefix.GenerateStoreKey(splitID.Bytes())
 }
+
+// RegisterCodec registers every key of the splits store, as supplies, escrows, vestings, allowances, claims, distributions and checkpoints are kept alongside splits
 func (splitID) RegisterCodec(codec *codec.Codec) {
 	codecUtilities.RegisterModuleConcrete(codec, splitID{})
+	supplyID{}.RegisterCodec(codec)
+	escrowID{}.RegisterCodec(codec)
+	vestingID{}.RegisterCodec(codec)
+	allowanceID{}.RegisterCodec(codec)
+	claimID{}.RegisterCodec(codec)
+	distributionID{}.RegisterCodec(codec)
+	checkpointID{}.RegisterCodec(codec)
 }
 func (splitID splitID) IsPartial() bool {
 	return len(splitID.OwnableID.Bytes()) == 0
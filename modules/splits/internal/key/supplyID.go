@@ -0,0 +1,51 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/traits"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+type supplyID struct {
+	OwnableID ids.ID `json:"ownableID" valid:"required~required field ownableID missing"`
+}
+
+var _ ids.ID = (*supplyID)(nil)
+var _ helpers.Key = (*supplyID)(nil)
+
+func (supplyID supplyID) Bytes() []byte {
+	return supplyID.OwnableID.Bytes()
+}
+func (supplyID supplyID) String() string {
+	return supplyID.OwnableID.String()
+}
+func (supplyID supplyID) Compare(listable traits.Listable) int {
+	return bytes.Compare(supplyID.Bytes(), supplyIDFromInterface(listable).Bytes())
+}
+func (supplyID supplyID) GenerateStoreKeyBytes() []byte {
+	return module.SupplyStoreKeyPrefix.GenerateStoreKey(supplyID.Bytes())
+}
+func (supplyID) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, supplyID{})
+}
+func (supplyID supplyID) IsPartial() bool {
+	return len(supplyID.OwnableID.Bytes()) == 0
+}
+func (supplyID supplyID) Equals(key helpers.Key) bool {
+	return supplyID.Compare(supplyIDFromInterface(key)) == 0
+}
+
+func NewSupplyID(ownableID ids.ID) ids.ID {
+	return supplyID{
+		OwnableID: ownableID,
+	}
+}
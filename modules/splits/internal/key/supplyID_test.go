@@ -0,0 +1,32 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_SupplyID_Methods(t *testing.T) {
+	ownableID := baseIDs.NewID("ownableID")
+
+	testSupplyID := NewSupplyID(ownableID).(supplyID)
+	testSupplyID2 := NewSupplyID(baseIDs.NewID("")).(supplyID)
+	require.NotPanics(t, func() {
+		require.Equal(t, ownableID.String(), testSupplyID.String())
+		require.Equal(t, true, testSupplyID.Equals(testSupplyID))
+		require.Equal(t, false, testSupplyID.Equals(testSupplyID2))
+		require.Equal(t, false, testSupplyID.IsPartial())
+		require.Equal(t, true, testSupplyID2.IsPartial())
+		require.Equal(t, module.SupplyStoreKeyPrefix.GenerateStoreKey(ownableID.Bytes()), testSupplyID.GenerateStoreKeyBytes())
+		require.Equal(t, testSupplyID, FromSupplyID(testSupplyID))
+		require.Equal(t, testSupplyID, FromSupplyID(ownableID))
+		require.Equal(t, ownableID, ReadSupplyOwnableID(testSupplyID))
+		require.Equal(t, testSupplyID2, SupplyPrototype())
+	})
+}
@@ -4,6 +4,7 @@
 package key
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/AssetMantle/modules/constants"
@@ -50,3 +51,257 @@ func FromID(id ids.ID) helpers.Key {
 func ToID(key helpers.Key) ids.ID {
 	return splitIDFromInterface(key)
 }
+
+func supplyIDFromInterface(i interface{}) supplyID {
+	switch value := i.(type) {
+	case supplyID:
+		return value
+	case ids.ID:
+		return supplyID{OwnableID: baseIDs.NewID(value.String())}
+	default:
+		panic(i)
+	}
+}
+
+func ReadSupplyOwnableID(id ids.ID) ids.ID {
+	return supplyIDFromInterface(id).OwnableID
+}
+
+func FromSupplyID(id ids.ID) helpers.Key {
+	return supplyIDFromInterface(id)
+}
+
+func readEscrowID(escrowIDString string) ids.ID {
+	// the reference ID may itself be composite, so only the holder ID is split off
+	idList := strings.SplitN(escrowIDString, constants.SecondOrderCompositeIDSeparator, 2)
+	if len(idList) == 2 {
+		return escrowID{
+			HolderID:    baseIDs.NewID(idList[0]),
+			ReferenceID: baseIDs.NewID(idList[1]),
+		}
+	}
+
+	return escrowID{HolderID: baseIDs.NewID(escrowIDString), ReferenceID: baseIDs.NewID("")}
+}
+
+func escrowIDFromInterface(i interface{}) escrowID {
+	switch value := i.(type) {
+	case escrowID:
+		return value
+	case ids.ID:
+		return escrowIDFromInterface(readEscrowID(value.String()))
+	default:
+		panic(i)
+	}
+}
+
+func ReadEscrowHolderID(id ids.ID) ids.ID {
+	return escrowIDFromInterface(id).HolderID
+}
+
+func ReadEscrowReferenceID(id ids.ID) ids.ID {
+	return escrowIDFromInterface(id).ReferenceID
+}
+
+func FromEscrowID(id ids.ID) helpers.Key {
+	return escrowIDFromInterface(id)
+}
+
+func readVestingID(vestingIDString string) ids.ID {
+	if idList := strings.Split(vestingIDString, constants.SecondOrderCompositeIDSeparator); len(idList) == 4 {
+		if height, err := strconv.ParseInt(idList[3], 10, 64); err == nil {
+			return vestingID{
+				OwnerID:   baseIDs.NewID(idList[0]),
+				OwnableID: baseIDs.NewID(idList[1]),
+				GranterID: baseIDs.NewID(idList[2]),
+				Height:    height,
+			}
+		}
+	}
+
+	return vestingID{OwnerID: baseIDs.NewID(""), OwnableID: baseIDs.NewID(""), GranterID: baseIDs.NewID(""), Height: 0}
+}
+
+func vestingIDFromInterface(i interface{}) vestingID {
+	switch value := i.(type) {
+	case vestingID:
+		return value
+	case ids.ID:
+		return vestingIDFromInterface(readVestingID(value.String()))
+	default:
+		panic(i)
+	}
+}
+
+func ReadVestingOwnerID(id ids.ID) ids.ID {
+	return vestingIDFromInterface(id).OwnerID
+}
+
+func ReadVestingOwnableID(id ids.ID) ids.ID {
+	return vestingIDFromInterface(id).OwnableID
+}
+
+func ReadVestingGranterID(id ids.ID) ids.ID {
+	return vestingIDFromInterface(id).GranterID
+}
+
+func ReadVestingHeight(id ids.ID) int64 {
+	return vestingIDFromInterface(id).Height
+}
+
+func FromVestingID(id ids.ID) helpers.Key {
+	return vestingIDFromInterface(id)
+}
+
+func readAllowanceID(allowanceIDString string) ids.ID {
+	if idList := strings.Split(allowanceIDString, constants.SecondOrderCompositeIDSeparator); len(idList) == 3 {
+		return allowanceID{
+			OwnerID:   baseIDs.NewID(idList[0]),
+			SpenderID: baseIDs.NewID(idList[1]),
+			OwnableID: baseIDs.NewID(idList[2]),
+		}
+	}
+
+	return allowanceID{OwnerID: baseIDs.NewID(""), SpenderID: baseIDs.NewID(""), OwnableID: baseIDs.NewID("")}
+}
+
+func allowanceIDFromInterface(i interface{}) allowanceID {
+	switch value := i.(type) {
+	case allowanceID:
+		return value
+	case ids.ID:
+		return allowanceIDFromInterface(readAllowanceID(value.String()))
+	default:
+		panic(i)
+	}
+}
+
+func ReadAllowanceOwnerID(id ids.ID) ids.ID {
+	return allowanceIDFromInterface(id).OwnerID
+}
+
+func ReadAllowanceSpenderID(id ids.ID) ids.ID {
+	return allowanceIDFromInterface(id).SpenderID
+}
+
+func ReadAllowanceOwnableID(id ids.ID) ids.ID {
+	return allowanceIDFromInterface(id).OwnableID
+}
+
+func FromAllowanceID(id ids.ID) helpers.Key {
+	return allowanceIDFromInterface(id)
+}
+
+func readClaimID(claimIDString string) ids.ID {
+	// the distribution ID is itself composite, so only the owner ID is split off
+	idList := strings.SplitN(claimIDString, constants.SecondOrderCompositeIDSeparator, 2)
+	if len(idList) == 2 {
+		return claimID{
+			OwnerID:        baseIDs.NewID(idList[0]),
+			DistributionID: baseIDs.NewID(idList[1]),
+		}
+	}
+
+	return claimID{OwnerID: baseIDs.NewID(claimIDString), DistributionID: baseIDs.NewID("")}
+}
+
+func claimIDFromInterface(i interface{}) claimID {
+	switch value := i.(type) {
+	case claimID:
+		return value
+	case ids.ID:
+		return claimIDFromInterface(readClaimID(value.String()))
+	default:
+		panic(i)
+	}
+}
+
+func ReadClaimOwnerID(id ids.ID) ids.ID {
+	return claimIDFromInterface(id).OwnerID
+}
+
+func ReadClaimDistributionID(id ids.ID) ids.ID {
+	return claimIDFromInterface(id).DistributionID
+}
+
+func FromClaimID(id ids.ID) helpers.Key {
+	return claimIDFromInterface(id)
+}
+
+func readDistributionID(distributionIDString string) ids.ID {
+	// the held ownable ID is split off the last separator, as the sequence never holds one
+	if index := strings.LastIndex(distributionIDString, constants.SecondOrderCompositeIDSeparator); index >= 0 {
+		if sequence, err := strconv.ParseInt(distributionIDString[index+len(constants.SecondOrderCompositeIDSeparator):], 10, 64); err == nil {
+			return distributionID{
+				HeldOwnableID: baseIDs.NewID(distributionIDString[:index]),
+				Sequence:      sequence,
+			}
+		}
+	}
+
+	return distributionID{HeldOwnableID: baseIDs.NewID(""), Sequence: 0}
+}
+
+func distributionIDFromInterface(i interface{}) distributionID {
+	switch value := i.(type) {
+	case distributionID:
+		return value
+	case ids.ID:
+		return distributionIDFromInterface(readDistributionID(value.String()))
+	default:
+		panic(i)
+	}
+}
+
+func ReadDistributionHeldOwnableID(id ids.ID) ids.ID {
+	return distributionIDFromInterface(id).HeldOwnableID
+}
+
+func ReadDistributionSequence(id ids.ID) int64 {
+	return distributionIDFromInterface(id).Sequence
+}
+
+func FromDistributionID(id ids.ID) helpers.Key {
+	return distributionIDFromInterface(id)
+}
+
+func readCheckpointID(checkpointIDString string) ids.ID {
+	if idList := strings.Split(checkpointIDString, constants.SecondOrderCompositeIDSeparator); len(idList) == 3 {
+		if sequence, err := strconv.ParseInt(idList[2], 10, 64); err == nil {
+			return checkpointID{
+				OwnerID:   baseIDs.NewID(idList[0]),
+				OwnableID: baseIDs.NewID(idList[1]),
+				Sequence:  sequence,
+			}
+		}
+	}
+
+	return checkpointID{OwnerID: baseIDs.NewID(""), OwnableID: baseIDs.NewID(""), Sequence: 0}
+}
+
+func checkpointIDFromInterface(i interface{}) checkpointID {
+	switch value := i.(type) {
+	case checkpointID:
+		return value
+	case ids.ID:
+		return checkpointIDFromInterface(readCheckpointID(value.String()))
+	default:
+		panic(i)
+	}
+}
+
+func ReadCheckpointOwnerID(id ids.ID) ids.ID {
+	return checkpointIDFromInterface(id).OwnerID
+}
+
+func ReadCheckpointOwnableID(id ids.ID) ids.ID {
+	return checkpointIDFromInterface(id).OwnableID
+}
+
+func ReadCheckpointSequence(id ids.ID) int64 {
+	return checkpointIDFromInterface(id).Sequence
+}
+
+func FromCheckpointID(id ids.ID) helpers.Key {
+	return checkpointIDFromInterface(id)
+}
@@ -0,0 +1,79 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/traits"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// vestingID keys a vesting schedule on the split of an owner by the granter of the schedule and the height it starts at, so that the
+// schedules of a split are iterated together
+type vestingID struct {
+	OwnerID   ids.ID `json:"ownerID" valid:"required~required field ownerID missing"`
+	OwnableID ids.ID `json:"ownableID" valid:"required~required field ownableID missing"`
+	GranterID ids.ID `json:"granterID" valid:"required~required field granterID missing"`
+	Height    int64  `json:"height"`
+}
+
+var _ ids.ID = (*vestingID)(nil)
+var _ helpers.Key = (*vestingID)(nil)
+
+// Bytes leaves out an empty granter ID or a height that is not positive so that partial vesting IDs prefix the schedules of a split
+func (vestingID vestingID) Bytes() []byte {
+	Bytes := append(append([]byte{}, vestingID.OwnerID.Bytes()...), vestingID.OwnableID.Bytes()...)
+
+	if len(vestingID.GranterID.Bytes()) != 0 {
+		Bytes = append(Bytes, vestingID.GranterID.Bytes()...)
+
+		if vestingID.Height > 0 {
+			heightBytes := make([]byte, 8)
+			binary.BigEndian.PutUint64(heightBytes, uint64(vestingID.Height))
+			Bytes = append(Bytes, heightBytes...)
+		}
+	}
+
+	return Bytes
+}
+func (vestingID vestingID) String() string {
+	return strings.Join([]string{vestingID.OwnerID.String(), vestingID.OwnableID.String(), vestingID.GranterID.String(), strconv.FormatInt(vestingID.Height, 10)}, constants.SecondOrderCompositeIDSeparator)
+}
+func (vestingID vestingID) Compare(listable traits.Listable) int {
+	return bytes.Compare(vestingID.Bytes(), vestingIDFromInterface(listable).Bytes())
+}
+func (vestingID vestingID) GenerateStoreKeyBytes() []byte {
+	return module.VestingStoreKeyPrefix.GenerateStoreKey(vestingID.Bytes())
+}
+func (vestingID) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, vestingID{})
+}
+func (vestingID vestingID) IsPartial() bool {
+	return len(vestingID.GranterID.Bytes()) == 0 || vestingID.Height <= 0
+}
+func (vestingID vestingID) Equals(key helpers.Key) bool {
+	return vestingID.Compare(vestingIDFromInterface(key)) == 0
+}
+
+// NewVestingID creates the ID of the schedule the granter started at the height on the split of the owner, an empty granter ID identifies
+// all the schedules of the split
+func NewVestingID(ownerID ids.ID, ownableID ids.ID, granterID ids.ID, height int64) ids.ID {
+	return vestingID{
+		OwnerID:   baseIDs.NewID(ownerID.String()),
+		OwnableID: baseIDs.NewID(ownableID.String()),
+		GranterID: baseIDs.NewID(granterID.String()),
+		Height:    height,
+	}
+}
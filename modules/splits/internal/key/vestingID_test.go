@@ -0,0 +1,39 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_VestingID_Methods(t *testing.T) {
+	ownerID := baseIDs.NewID("ownerID")
+	ownableID := baseIDs.NewID("ownableID")
+	granterID := baseIDs.NewID("granterID")
+
+	testVestingID := NewVestingID(ownerID, ownableID, granterID, 10).(vestingID)
+	testVestingID2 := NewVestingID(ownerID, ownableID, baseIDs.NewID(""), 0).(vestingID)
+	require.NotPanics(t, func() {
+		require.Equal(t, ownerID.String()+constants.SecondOrderCompositeIDSeparator+ownableID.String()+constants.SecondOrderCompositeIDSeparator+granterID.String()+constants.SecondOrderCompositeIDSeparator+"10", testVestingID.String())
+		require.Equal(t, true, testVestingID.Equals(testVestingID))
+		require.Equal(t, false, testVestingID.Equals(testVestingID2))
+		require.Equal(t, false, testVestingID.IsPartial())
+		require.Equal(t, true, testVestingID2.IsPartial())
+		require.Equal(t, true, NewVestingID(ownerID, ownableID, granterID, 0).(vestingID).IsPartial())
+		require.Equal(t, module.VestingStoreKeyPrefix.GenerateStoreKey(append(ownerID.Bytes(), ownableID.Bytes()...)), testVestingID2.GenerateStoreKeyBytes())
+		require.Equal(t, testVestingID, FromVestingID(testVestingID))
+		require.Equal(t, testVestingID, FromVestingID(baseIDs.NewID(testVestingID.String())))
+		require.Equal(t, ownerID, ReadVestingOwnerID(testVestingID))
+		require.Equal(t, ownableID, ReadVestingOwnableID(testVestingID))
+		require.Equal(t, granterID, ReadVestingGranterID(testVestingID))
+		require.Equal(t, int64(10), ReadVestingHeight(testVestingID))
+		require.Equal(t, NewVestingID(baseIDs.NewID(""), baseIDs.NewID(""), baseIDs.NewID(""), 0), VestingPrototype())
+	})
+}
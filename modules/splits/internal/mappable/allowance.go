@@ -0,0 +1,67 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/types"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// allowance is the value of the split of its owner that a spender may move on the owner's behalf, until the height it expires at if any
+type allowance struct {
+	ID     ids.ID       `json:"id" valid:"required~required field id missing"`
+	Value  sdkTypes.Dec `json:"value" valid:"required~required field value missing"`
+	Expiry int64        `json:"expiry"`
+}
+
+var _ mappables.Allowance = (*allowance)(nil)
+
+func (allowance allowance) GetOwnerID() ids.ID {
+	return key.ReadAllowanceOwnerID(allowance.ID)
+}
+func (allowance allowance) GetSpenderID() ids.ID {
+	return key.ReadAllowanceSpenderID(allowance.ID)
+}
+func (allowance allowance) GetOwnableID() ids.ID {
+	return key.ReadAllowanceOwnableID(allowance.ID)
+}
+func (allowance allowance) GetValue() sdkTypes.Dec {
+	return allowance.Value
+}
+func (allowance allowance) GetExpiry() types.Height {
+	return baseTypes.NewHeight(allowance.Expiry)
+}
+
+// IsExpired tells if the allowance can no longer be spent at the height, allowances without a positive expiry never expire
+func (allowance allowance) IsExpired(height types.Height) bool {
+	return allowance.Expiry > 0 && height.Get() >= allowance.Expiry
+}
+func (allowance allowance) Decrease(value sdkTypes.Dec) mappables.Allowance {
+	allowance.Value = allowance.Value.Sub(value)
+	return allowance
+}
+func (allowance allowance) GetKey() helpers.Key {
+	return key.FromAllowanceID(allowance.ID)
+}
+func (allowance) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, allowance{})
+}
+
+// NewAllowance creates the allowance the owner grants the spender on its split of the ownable, an expiry that is not positive keeps it
+// spendable until it is used up or revoked
+func NewAllowance(ownerID ids.ID, spenderID ids.ID, ownableID ids.ID, value sdkTypes.Dec, expiry types.Height) mappables.Allowance {
+	return allowance{
+		ID:     key.NewAllowanceID(ownerID, spenderID, ownableID),
+		Value:  value,
+		Expiry: expiry.Get(),
+	}
+}
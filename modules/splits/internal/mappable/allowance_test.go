@@ -0,0 +1,37 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func Test_Allowance_Methods(t *testing.T) {
+	ownerID := baseIDs.NewID("ownerID")
+	spenderID := baseIDs.NewID("spenderID")
+	ownableID := baseIDs.NewID("ownableID")
+
+	testValue := sdkTypes.NewDec(12)
+	testAllowance := NewAllowance(ownerID, spenderID, ownableID, testValue, baseTypes.NewHeight(20)).(allowance)
+	testAllowance2 := NewAllowance(ownerID, spenderID, ownableID, testValue, baseTypes.NewHeight(-1)).(allowance)
+
+	require.Equal(t, allowance{ID: key.NewAllowanceID(ownerID, spenderID, ownableID), Value: testValue, Expiry: 20}, testAllowance)
+	require.Equal(t, ownerID, testAllowance.GetOwnerID())
+	require.Equal(t, spenderID, testAllowance.GetSpenderID())
+	require.Equal(t, ownableID, testAllowance.GetOwnableID())
+	require.Equal(t, testValue, testAllowance.GetValue())
+	require.Equal(t, baseTypes.NewHeight(20), testAllowance.GetExpiry())
+	require.Equal(t, false, testAllowance.IsExpired(baseTypes.NewHeight(19)))
+	require.Equal(t, true, testAllowance.IsExpired(baseTypes.NewHeight(20)))
+	require.Equal(t, false, testAllowance2.IsExpired(baseTypes.NewHeight(1000)))
+	require.Equal(t, NewAllowance(ownerID, spenderID, ownableID, sdkTypes.NewDec(11), baseTypes.NewHeight(20)), testAllowance.Decrease(sdkTypes.NewDec(1)))
+	require.Equal(t, key.NewAllowanceID(ownerID, spenderID, ownableID), testAllowance.GetKey())
+}
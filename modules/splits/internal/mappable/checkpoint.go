@@ -0,0 +1,50 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// checkpoint is the holding of an owner of an ownable as of a distribution to the holders of the ownable, kept from the first change to the
+// holding after the distribution
+type checkpoint struct {
+	ID    ids.ID       `json:"id" valid:"required~required field id missing"`
+	Value sdkTypes.Dec `json:"value" valid:"required~required field value missing"`
+}
+
+var _ mappables.Checkpoint = (*checkpoint)(nil)
+
+func (checkpoint checkpoint) GetOwnerID() ids.ID {
+	return key.ReadCheckpointOwnerID(checkpoint.ID)
+}
+func (checkpoint checkpoint) GetOwnableID() ids.ID {
+	return key.ReadCheckpointOwnableID(checkpoint.ID)
+}
+func (checkpoint checkpoint) GetSequence() int64 {
+	return key.ReadCheckpointSequence(checkpoint.ID)
+}
+func (checkpoint checkpoint) GetValue() sdkTypes.Dec {
+	return checkpoint.Value
+}
+func (checkpoint checkpoint) GetKey() helpers.Key {
+	return key.FromCheckpointID(checkpoint.ID)
+}
+func (checkpoint) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, checkpoint{})
+}
+
+func NewCheckpoint(ownerID ids.ID, ownableID ids.ID, sequence int64, value sdkTypes.Dec) mappables.Checkpoint {
+	return checkpoint{
+		ID:    key.NewCheckpointID(ownerID, ownableID, sequence),
+		Value: value,
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_Checkpoint_Methods(t *testing.T) {
+	ownerID := baseIDs.NewID("ownerID")
+	ownableID := baseIDs.NewID("ownableID")
+
+	testValue := sdkTypes.NewDec(12)
+	testCheckpoint := NewCheckpoint(ownerID, ownableID, 3, testValue).(checkpoint)
+
+	require.Equal(t, checkpoint{ID: key.NewCheckpointID(ownerID, ownableID, 3), Value: testValue}, testCheckpoint)
+	require.Equal(t, ownerID, testCheckpoint.GetOwnerID())
+	require.Equal(t, ownableID, testCheckpoint.GetOwnableID())
+	require.Equal(t, int64(3), testCheckpoint.GetSequence())
+	require.Equal(t, testValue, testCheckpoint.GetValue())
+	require.Equal(t, key.NewCheckpointID(ownerID, ownableID, 3), testCheckpoint.GetKey())
+}
@@ -0,0 +1,52 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// claim is the share of a distribution its owner has claimed out of the escrow of the distribution, so that it is not claimed twice
+type claim struct {
+	ID        ids.ID       `json:"id" valid:"required~required field id missing"`
+	OwnableID ids.ID       `json:"ownableID" valid:"required~required field ownableID missing"`
+	Value     sdkTypes.Dec `json:"value" valid:"required~required field value missing"`
+}
+
+var _ mappables.Claim = (*claim)(nil)
+
+func (claim claim) GetOwnerID() ids.ID {
+	return key.ReadClaimOwnerID(claim.ID)
+}
+func (claim claim) GetDistributionID() ids.ID {
+	return key.ReadClaimDistributionID(claim.ID)
+}
+func (claim claim) GetOwnableID() ids.ID {
+	return claim.OwnableID
+}
+func (claim claim) GetValue() sdkTypes.Dec {
+	return claim.Value
+}
+func (claim claim) GetKey() helpers.Key {
+	return key.FromClaimID(claim.ID)
+}
+func (claim) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, claim{})
+}
+
+func NewClaim(ownerID ids.ID, distributionID ids.ID, ownableID ids.ID, value sdkTypes.Dec) mappables.Claim {
+	return claim{
+		ID:        key.NewClaimID(ownerID, distributionID),
+		OwnableID: baseIDs.NewID(ownableID.String()),
+		Value:     value,
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_Claim_Methods(t *testing.T) {
+	ownerID := baseIDs.NewID("ownerID")
+	ownableID := baseIDs.NewID("ownableID")
+	distributionID := baseIDs.NewID(key.NewDistributionID(baseIDs.NewID("heldOwnableID"), 10).String())
+
+	testValue := sdkTypes.NewDec(12)
+	testClaim := NewClaim(ownerID, distributionID, ownableID, testValue).(claim)
+
+	require.Equal(t, claim{ID: key.NewClaimID(ownerID, distributionID), OwnableID: ownableID, Value: testValue}, testClaim)
+	require.Equal(t, ownerID, testClaim.GetOwnerID())
+	require.Equal(t, distributionID, testClaim.GetDistributionID())
+	require.Equal(t, ownableID, testClaim.GetOwnableID())
+	require.Equal(t, testValue, testClaim.GetValue())
+	require.Equal(t, key.NewClaimID(ownerID, distributionID), testClaim.GetKey())
+}
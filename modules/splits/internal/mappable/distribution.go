@@ -0,0 +1,72 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/types"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// distribution is the snapshot of a distribution to the holders of an ownable, its value is held in escrow and every holder claims the part
+// of it its holding as of the distribution makes up of the total held
+type distribution struct {
+	ID            ids.ID       `json:"id" valid:"required~required field id missing"`
+	DistributorID ids.ID       `json:"distributorID" valid:"required~required field distributorID missing"`
+	OwnableID     ids.ID       `json:"ownableID" valid:"required~required field ownableID missing"`
+	Value         sdkTypes.Dec `json:"value" valid:"required~required field value missing"`
+	Total         sdkTypes.Dec `json:"total" valid:"required~required field total missing"`
+	Height        int64        `json:"height"`
+}
+
+var _ mappables.Distribution = (*distribution)(nil)
+
+func (distribution distribution) GetDistributorID() ids.ID {
+	return distribution.DistributorID
+}
+func (distribution distribution) GetHeldOwnableID() ids.ID {
+	return key.ReadDistributionHeldOwnableID(distribution.ID)
+}
+func (distribution distribution) GetSequence() int64 {
+	return key.ReadDistributionSequence(distribution.ID)
+}
+func (distribution distribution) GetOwnableID() ids.ID {
+	return distribution.OwnableID
+}
+func (distribution distribution) GetValue() sdkTypes.Dec {
+	return distribution.Value
+}
+func (distribution distribution) GetTotal() sdkTypes.Dec {
+	return distribution.Total
+}
+func (distribution distribution) GetHeight() types.Height {
+	return baseTypes.NewHeight(distribution.Height)
+}
+func (distribution distribution) GetKey() helpers.Key {
+	return key.FromDistributionID(distribution.ID)
+}
+func (distribution) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, distribution{})
+}
+
+// NewDistribution creates the snapshot of the distribution of the sequence the distributor makes of the value of the ownable at the height
+// to the holders of the total of the held ownable
+func NewDistribution(heldOwnableID ids.ID, sequence int64, distributorID ids.ID, ownableID ids.ID, value sdkTypes.Dec, total sdkTypes.Dec, height types.Height) mappables.Distribution {
+	return distribution{
+		ID:            key.NewDistributionID(heldOwnableID, sequence),
+		DistributorID: baseIDs.NewID(distributorID.String()),
+		OwnableID:     baseIDs.NewID(ownableID.String()),
+		Value:         value,
+		Total:         total,
+		Height:        height.Get(),
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func Test_Distribution_Methods(t *testing.T) {
+	heldOwnableID := baseIDs.NewID("heldOwnableID")
+	distributorID := baseIDs.NewID("distributorID")
+	ownableID := baseIDs.NewID("ownableID")
+
+	testValue := sdkTypes.NewDec(12)
+	testTotal := sdkTypes.NewDec(100)
+	testDistribution := NewDistribution(heldOwnableID, 3, distributorID, ownableID, testValue, testTotal, baseTypes.NewHeight(10)).(distribution)
+
+	require.Equal(t, distribution{ID: key.NewDistributionID(heldOwnableID, 3), DistributorID: distributorID, OwnableID: ownableID, Value: testValue, Total: testTotal, Height: 10}, testDistribution)
+	require.Equal(t, distributorID, testDistribution.GetDistributorID())
+	require.Equal(t, heldOwnableID, testDistribution.GetHeldOwnableID())
+	require.Equal(t, int64(3), testDistribution.GetSequence())
+	require.Equal(t, ownableID, testDistribution.GetOwnableID())
+	require.Equal(t, testValue, testDistribution.GetValue())
+	require.Equal(t, testTotal, testDistribution.GetTotal())
+	require.Equal(t, baseTypes.NewHeight(10), testDistribution.GetHeight())
+	require.Equal(t, key.NewDistributionID(heldOwnableID, 3), testDistribution.GetKey())
+}
@@ -0,0 +1,65 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+// escrow is the part of the split of the holder of an ownable locked under a reference on behalf of its owner
+type escrow struct {
+	ID        ids.ID       `json:"id" valid:"required field key missing"`
+	OwnerID   ids.ID       `json:"ownerID" valid:"required~required field ownerID missing"`
+	OwnableID ids.ID       `json:"ownableID" valid:"required~required field ownableID missing"`
+	Value     sdkTypes.Dec `json:"value" valid:"required~required field value missing"`
+}
+
+var _ mappables.Escrow = (*escrow)(nil)
+
+func (escrow escrow) GetHolderID() ids.ID {
+	return key.ReadEscrowHolderID(escrow.ID)
+}
+func (escrow escrow) GetReferenceID() ids.ID {
+	return key.ReadEscrowReferenceID(escrow.ID)
+}
+func (escrow escrow) GetOwnerID() ids.ID {
+	return escrow.OwnerID
+}
+func (escrow escrow) GetOwnableID() ids.ID {
+	return escrow.OwnableID
+}
+func (escrow escrow) GetValue() sdkTypes.Dec {
+	return escrow.Value
+}
+func (escrow escrow) Increase(value sdkTypes.Dec) mappables.Escrow {
+	escrow.Value = escrow.Value.Add(value)
+	return escrow
+}
+func (escrow escrow) Decrease(value sdkTypes.Dec) mappables.Escrow {
+	escrow.Value = escrow.Value.Sub(value)
+	return escrow
+}
+func (escrow escrow) GetKey() helpers.Key {
+	return key.FromEscrowID(escrow.ID)
+}
+func (escrow) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, escrow{})
+}
+
+func NewEscrow(holderID ids.ID, referenceID ids.ID, ownerID ids.ID, ownableID ids.ID, value sdkTypes.Dec) mappables.Escrow {
+	return escrow{
+		ID:        key.NewEscrowID(holderID, referenceID),
+		OwnerID:   baseIDs.NewID(ownerID.String()),
+		OwnableID: baseIDs.NewID(ownableID.String()),
+		Value:     value,
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_Escrow_Methods(t *testing.T) {
+	holderID := baseIDs.NewID("holderID")
+	referenceID := baseIDs.NewID("referenceID")
+	ownerID := baseIDs.NewID("ownerID")
+	ownableID := baseIDs.NewID("ownableID")
+
+	testValue := sdkTypes.NewDec(12)
+	testEscrow := NewEscrow(holderID, referenceID, ownerID, ownableID, testValue).(escrow)
+
+	require.Equal(t, escrow{ID: key.NewEscrowID(holderID, referenceID), OwnerID: ownerID, OwnableID: ownableID, Value: testValue}, testEscrow)
+	require.Equal(t, holderID, testEscrow.GetHolderID())
+	require.Equal(t, referenceID, testEscrow.GetReferenceID())
+	require.Equal(t, ownerID, testEscrow.GetOwnerID())
+	require.Equal(t, ownableID, testEscrow.GetOwnableID())
+	require.Equal(t, testValue, testEscrow.GetValue())
+	require.Equal(t, NewEscrow(holderID, referenceID, ownerID, ownableID, sdkTypes.NewDec(13)), testEscrow.Increase(sdkTypes.NewDec(1)))
+	require.Equal(t, NewEscrow(holderID, referenceID, ownerID, ownableID, sdkTypes.NewDec(11)), testEscrow.Decrease(sdkTypes.NewDec(1)))
+	require.Equal(t, key.NewEscrowID(holderID, referenceID), testEscrow.GetKey())
+}
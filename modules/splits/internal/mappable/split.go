@@ -48,8 +48,17 @@ func (split split) CanSend(outValue sdkTypes.Dec) bool {
 func (split split) GetKey() helpers.Key {
 	return key.FromID(split.ID)
 }
+
+// RegisterCodec registers every mappable of the splits store, as supplies, escrows, vestings, allowances, claims, distributions and checkpoints are kept alongside splits
 func (split) RegisterCodec(codec *codec.Codec) {
 	codecUtilities.RegisterModuleConcrete(codec, split{})
+	supply{}.RegisterCodec(codec)
+	escrow{}.RegisterCodec(codec)
+	vesting{}.RegisterCodec(codec)
+	allowance{}.RegisterCodec(codec)
+	claim{}.RegisterCodec(codec)
+	distribution{}.RegisterCodec(codec)
+	checkpoint{}.RegisterCodec(codec)
 }
 
 func NewSplit(splitID ids.ID, value sdkTypes.Dec) mappables.Split {
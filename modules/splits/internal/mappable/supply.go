@@ -0,0 +1,50 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+type supply struct {
+	ID    ids.ID       `json:"id" valid:"required field key missing"`
+	Value sdkTypes.Dec `json:"value" valid:"required~required field value missing"`
+}
+
+var _ mappables.Supply = (*supply)(nil)
+
+func (supply supply) GetOwnableID() ids.ID {
+	return key.ReadSupplyOwnableID(supply.ID)
+}
+func (supply supply) GetValue() sdkTypes.Dec {
+	return supply.Value
+}
+func (supply supply) Increase(value sdkTypes.Dec) mappables.Supply {
+	supply.Value = supply.Value.Add(value)
+	return supply
+}
+func (supply supply) Decrease(value sdkTypes.Dec) mappables.Supply {
+	supply.Value = supply.Value.Sub(value)
+	return supply
+}
+func (supply supply) GetKey() helpers.Key {
+	return key.FromSupplyID(supply.ID)
+}
+func (supply) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, supply{})
+}
+
+func NewSupply(ownableID ids.ID, value sdkTypes.Dec) mappables.Supply {
+	return supply{
+		ID:    key.NewSupplyID(ownableID),
+		Value: value,
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_Supply_Methods(t *testing.T) {
+	ownableID := baseIDs.NewID("ownableID")
+
+	testValue := sdkTypes.NewDec(12)
+	testSupply := NewSupply(ownableID, testValue).(supply)
+
+	require.Equal(t, supply{ID: key.NewSupplyID(ownableID), Value: testValue}, testSupply)
+	require.Equal(t, ownableID, testSupply.GetOwnableID())
+	require.Equal(t, testValue, testSupply.GetValue())
+	require.Equal(t, NewSupply(ownableID, sdkTypes.NewDec(13)), testSupply.Increase(sdkTypes.NewDec(1)))
+	require.Equal(t, NewSupply(ownableID, sdkTypes.NewDec(11)), testSupply.Decrease(sdkTypes.NewDec(1)))
+	require.Equal(t, key.NewSupplyID(ownableID), testSupply.GetKey())
+}
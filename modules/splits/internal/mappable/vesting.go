@@ -0,0 +1,116 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/types"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+var (
+	// CliffVesting schedules keep the whole value locked until they end
+	CliffVesting = baseIDs.NewID("cliff")
+	// LinearVesting schedules vest the value block by block between their start and end
+	LinearVesting = baseIDs.NewID("linear")
+	// PeriodicVesting schedules vest an equal part of the value at the end of every period between their start and end
+	PeriodicVesting = baseIDs.NewID("periodic")
+)
+
+// IsVestingKind tells if the ID is one of the supported kinds of vesting schedules
+func IsVestingKind(kind ids.ID) bool {
+	for _, value := range []ids.ID{CliffVesting, LinearVesting, PeriodicVesting} {
+		if kind.Compare(value) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// vesting is a schedule that locks a value of the split of its owner and releases it over the blocks from its start to its end
+type vesting struct {
+	ID     ids.ID       `json:"id" valid:"required~required field id missing"`
+	Kind   ids.ID       `json:"kind" valid:"required~required field kind missing"`
+	Value  sdkTypes.Dec `json:"value" valid:"required~required field value missing"`
+	End    int64        `json:"end"`
+	Period int64        `json:"period"`
+}
+
+var _ mappables.Vesting = (*vesting)(nil)
+
+func (vesting vesting) GetOwnerID() ids.ID {
+	return key.ReadVestingOwnerID(vesting.ID)
+}
+func (vesting vesting) GetOwnableID() ids.ID {
+	return key.ReadVestingOwnableID(vesting.ID)
+}
+func (vesting vesting) GetGranterID() ids.ID {
+	return key.ReadVestingGranterID(vesting.ID)
+}
+func (vesting vesting) GetKind() ids.ID {
+	return vesting.Kind
+}
+func (vesting vesting) GetValue() sdkTypes.Dec {
+	return vesting.Value
+}
+func (vesting vesting) GetStart() types.Height {
+	return baseTypes.NewHeight(key.ReadVestingHeight(vesting.ID))
+}
+func (vesting vesting) GetEnd() types.Height {
+	return baseTypes.NewHeight(vesting.End)
+}
+func (vesting vesting) GetPeriod() int64 {
+	return vesting.Period
+}
+
+// GetVested returns the part of the value released by the height, rounded down so that a schedule never releases more than it has vested
+func (vesting vesting) GetVested(height types.Height) sdkTypes.Dec {
+	start := key.ReadVestingHeight(vesting.ID)
+
+	switch {
+	case height.Get() >= vesting.End:
+		return vesting.Value
+	case height.Get() <= start || vesting.End <= start:
+		return sdkTypes.ZeroDec()
+	}
+
+	switch {
+	case vesting.Kind.Compare(LinearVesting) == 0:
+		return vesting.Value.MulInt64(height.Get() - start).QuoInt64(vesting.End - start)
+	case vesting.Kind.Compare(PeriodicVesting) == 0 && vesting.Period > 0:
+		return vesting.Value.MulInt64((height.Get() - start) / vesting.Period).QuoInt64((vesting.End - start) / vesting.Period)
+	default:
+		return sdkTypes.ZeroDec()
+	}
+}
+func (vesting vesting) GetLocked(height types.Height) sdkTypes.Dec {
+	return vesting.Value.Sub(vesting.GetVested(height))
+}
+func (vesting vesting) GetKey() helpers.Key {
+	return key.FromVestingID(vesting.ID)
+}
+func (vesting) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, vesting{})
+}
+
+// NewVesting creates the schedule the granter starts at the height on the split of the owner, periodic schedules need a period that
+// divides the blocks between their start and end
+func NewVesting(ownerID ids.ID, ownableID ids.ID, granterID ids.ID, kind ids.ID, value sdkTypes.Dec, start types.Height, end types.Height, period int64) mappables.Vesting {
+	return vesting{
+		ID:     key.NewVestingID(ownerID, ownableID, granterID, start.Get()),
+		Kind:   baseIDs.NewID(kind.String()),
+		Value:  value,
+		End:    end.Get(),
+		Period: period,
+	}
+}
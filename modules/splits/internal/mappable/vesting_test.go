@@ -0,0 +1,53 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappable
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func Test_Vesting_Methods(t *testing.T) {
+	ownerID := baseIDs.NewID("ownerID")
+	ownableID := baseIDs.NewID("ownableID")
+	granterID := baseIDs.NewID("granterID")
+
+	testValue := sdkTypes.NewDec(100)
+	testVesting := NewVesting(ownerID, ownableID, granterID, LinearVesting, testValue, baseTypes.NewHeight(10), baseTypes.NewHeight(30), 0).(vesting)
+
+	require.Equal(t, vesting{ID: key.NewVestingID(ownerID, ownableID, granterID, 10), Kind: LinearVesting, Value: testValue, End: 30, Period: 0}, testVesting)
+	require.Equal(t, ownerID, testVesting.GetOwnerID())
+	require.Equal(t, ownableID, testVesting.GetOwnableID())
+	require.Equal(t, granterID, testVesting.GetGranterID())
+	require.Equal(t, LinearVesting, testVesting.GetKind())
+	require.Equal(t, testValue, testVesting.GetValue())
+	require.Equal(t, baseTypes.NewHeight(10), testVesting.GetStart())
+	require.Equal(t, baseTypes.NewHeight(30), testVesting.GetEnd())
+	require.Equal(t, int64(0), testVesting.GetPeriod())
+	require.Equal(t, key.NewVestingID(ownerID, ownableID, granterID, 10), testVesting.GetKey())
+
+	require.Equal(t, sdkTypes.ZeroDec(), testVesting.GetVested(baseTypes.NewHeight(5)))
+	require.Equal(t, sdkTypes.NewDec(35), testVesting.GetVested(baseTypes.NewHeight(17)))
+	require.Equal(t, sdkTypes.NewDec(65), testVesting.GetLocked(baseTypes.NewHeight(17)))
+	require.Equal(t, testValue, testVesting.GetVested(baseTypes.NewHeight(30)))
+	require.True(t, testVesting.GetLocked(baseTypes.NewHeight(31)).IsZero())
+
+	testCliff := NewVesting(ownerID, ownableID, granterID, CliffVesting, testValue, baseTypes.NewHeight(10), baseTypes.NewHeight(30), 0)
+	require.Equal(t, sdkTypes.ZeroDec(), testCliff.GetVested(baseTypes.NewHeight(29)))
+	require.Equal(t, testValue, testCliff.GetVested(baseTypes.NewHeight(30)))
+
+	testPeriodic := NewVesting(ownerID, ownableID, granterID, PeriodicVesting, testValue, baseTypes.NewHeight(10), baseTypes.NewHeight(30), 5)
+	require.Equal(t, sdkTypes.ZeroDec(), testPeriodic.GetVested(baseTypes.NewHeight(14)))
+	require.Equal(t, sdkTypes.NewDec(50), testPeriodic.GetVested(baseTypes.NewHeight(22)))
+	require.Equal(t, sdkTypes.NewDec(25), testPeriodic.GetLocked(baseTypes.NewHeight(27)))
+
+	require.Equal(t, true, IsVestingKind(baseIDs.NewID("periodic")))
+	require.Equal(t, false, IsVestingKind(baseIDs.NewID("monthly")))
+}
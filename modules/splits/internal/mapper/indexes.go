@@ -0,0 +1,55 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mapper
+
+import (
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// OwnableIDIndex indexes splits by the ownable they hold
+var OwnableIDIndex = baseHelpers.NewIndex("ownableID", func(mappable helpers.Mappable) []byte {
+	if split, ok := mappable.(mappables.Split); ok {
+		return split.GetOwnableID().Bytes()
+	}
+
+	return nil
+})
+
+// OwnerIDIndex indexes splits by their owner
+var OwnerIDIndex = baseHelpers.NewIndex("ownerID", func(mappable helpers.Mappable) []byte {
+	if split, ok := mappable.(mappables.Split); ok {
+		return split.GetOwnerID().Bytes()
+	}
+
+	return nil
+})
+
+// EscrowOwnerIDIndex indexes escrows by their owner and the ownable they hold
+var EscrowOwnerIDIndex = baseHelpers.NewIndex("escrowOwnerID", func(mappable helpers.Mappable) []byte {
+	if escrow, ok := mappable.(mappables.Escrow); ok {
+		return append(append([]byte{}, escrow.GetOwnerID().Bytes()...), escrow.GetOwnableID().Bytes()...)
+	}
+
+	return nil
+})
+
+// EscrowHolderIDIndex indexes escrows by their holder and the ownable they hold
+var EscrowHolderIDIndex = baseHelpers.NewIndex("escrowHolderID", func(mappable helpers.Mappable) []byte {
+	if escrow, ok := mappable.(mappables.Escrow); ok {
+		return append(append([]byte{}, escrow.GetHolderID().Bytes()...), escrow.GetOwnableID().Bytes()...)
+	}
+
+	return nil
+})
+
+// EscrowOwnableIDIndex indexes escrows by the ownable they hold
+var EscrowOwnableIDIndex = baseHelpers.NewIndex("escrowOwnableID", func(mappable helpers.Mappable) []byte {
+	if escrow, ok := mappable.(mappables.Escrow); ok {
+		return escrow.GetOwnableID().Bytes()
+	}
+
+	return nil
+})
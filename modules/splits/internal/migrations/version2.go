@@ -0,0 +1,86 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"encoding/json"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/genesis"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// rebuildVersion2 writes the entries of the splits and the escrows in the indexes and the supply records of the ownables, which were added
+// at version 2
+func rebuildVersion2(context sdkTypes.Context, mapper helpers.Mapper, _ []interface{}) error {
+	baseHelpers.RebuildIndexes(context, mapper, key.Prototype(), key.EscrowPrototype())
+
+	var splitList []helpers.Mappable
+
+	mapper.Iterate(context, key.Prototype(), func(mappable helpers.Mappable) bool {
+		splitList = append(splitList, mappable)
+		return false
+	})
+
+	for _, supply := range getSupplyList(splitList) {
+		mapper.Create(context, supply)
+	}
+
+	return nil
+}
+
+// migrateGenesisVersion2 adds the supply records of the ownables to a genesis exported before version 2, index entries are written as the
+// genesis is imported
+func migrateGenesisVersion2(genesisState map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	rawMessage, found := genesisState[module.Name]
+	if !found {
+		return nil, errors.EntityNotFound
+	}
+
+	Genesis := genesis.Prototype().Decode(rawMessage)
+
+	mappableList := Genesis.GetMappableList()
+	for _, supply := range getSupplyList(mappableList) {
+		mappableList = append(mappableList, supply)
+	}
+
+	genesisState[module.Name] = Genesis.Initialize(mappableList, Genesis.GetParameterList()).Encode()
+
+	return genesisState, nil
+}
+
+// getSupplyList returns a supply record for every ownable holding the total value of its splits, in the order the ownables are first met
+func getSupplyList(mappableList []helpers.Mappable) []helpers.Mappable {
+	var ownableIDList []string
+
+	supplies := make(map[string]mappables.Supply)
+
+	for _, Mappable := range mappableList {
+		split, ok := Mappable.(mappables.Split)
+		if !ok || !split.GetValue().IsPositive() {
+			continue
+		}
+
+		if supply, found := supplies[split.GetOwnableID().String()]; found {
+			supplies[split.GetOwnableID().String()] = supply.Increase(split.GetValue())
+		} else {
+			ownableIDList = append(ownableIDList, split.GetOwnableID().String())
+			supplies[split.GetOwnableID().String()] = mappable.NewSupply(split.GetOwnableID(), split.GetValue())
+		}
+	}
+
+	supplyList := make([]helpers.Mappable, len(ownableIDList))
+	for i, ownableID := range ownableIDList {
+		supplyList[i] = supplies[ownableID]
+	}
+
+	return supplyList
+}
@@ -0,0 +1,78 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/genesis"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/mapper"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTestUtilities "github.com/AssetMantle/modules/utilities/test/schema/helpers/base"
+)
+
+var (
+	ownerID        = baseIDs.NewID("ownerID")
+	otherOwnerID   = baseIDs.NewID("otherOwnerID")
+	ownableID      = baseIDs.NewID("ownableID")
+	otherOwnableID = baseIDs.NewID("otherOwnableID")
+	splitList      = []helpers.Mappable{
+		mappable.NewSplit(key.NewSplitID(ownerID, ownableID), sdkTypes.NewDec(10)),
+		mappable.NewSplit(key.NewSplitID(otherOwnerID, ownableID), sdkTypes.NewDec(5)),
+		mappable.NewSplit(key.NewSplitID(ownerID, otherOwnableID), sdkTypes.NewDec(3)),
+	}
+)
+
+func Test_rebuildVersion2(t *testing.T) {
+	context, storeKey, _ := baseTestUtilities.SetupTest(t)
+
+	// splits as written at version 1, without index entries or supply records
+	versionOneMapper := baseHelpers.NewMapper(key.Prototype, mappable.Prototype).Initialize(storeKey)
+	for _, split := range splitList {
+		versionOneMapper.Create(context, split)
+	}
+
+	Mapper := mapper.Prototype().Initialize(storeKey)
+
+	countIndexEntries := func(index helpers.Index, indexKeyBytes []byte) int {
+		count := 0
+
+		Mapper.IterateIndex(context, index, indexKeyBytes, func(helpers.Mappable) bool {
+			count++
+			return false
+		})
+
+		return count
+	}
+
+	require.Equal(t, 0, countIndexEntries(mapper.OwnableIDIndex, ownableID.Bytes()))
+	require.Equal(t, sdkTypes.ZeroDec(), utilities.GetSupply(Mapper.NewCollection(context), ownableID))
+
+	require.Nil(t, Prototype().Migrate(context, Mapper, nil, 1))
+
+	require.Equal(t, 2, countIndexEntries(mapper.OwnableIDIndex, ownableID.Bytes()))
+	require.Equal(t, 1, countIndexEntries(mapper.OwnableIDIndex, otherOwnableID.Bytes()))
+	require.Equal(t, 2, countIndexEntries(mapper.OwnerIDIndex, ownerID.Bytes()))
+	require.Equal(t, 1, countIndexEntries(mapper.OwnerIDIndex, otherOwnerID.Bytes()))
+	require.Equal(t, sdkTypes.NewDec(15), utilities.GetSupply(Mapper.NewCollection(context), ownableID))
+	require.Equal(t, sdkTypes.NewDec(3), utilities.GetSupply(Mapper.NewCollection(context), otherOwnableID))
+}
+
+func Test_migrateGenesisVersion2(t *testing.T) {
+	genesisState, err := Prototype().MigrateGenesis(map[string]json.RawMessage{module.Name: genesis.Prototype().Initialize(splitList, parameters.Prototype().GetList()).Encode()}, 1)
+	require.Nil(t, err)
+
+	require.Equal(t, append(append([]helpers.Mappable{}, splitList...), mappable.NewSupply(ownableID, sdkTypes.NewDec(15)), mappable.NewSupply(otherOwnableID, sdkTypes.NewDec(3))), genesis.Prototype().Decode(genesisState[module.Name]).GetMappableList())
+}
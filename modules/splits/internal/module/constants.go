@@ -9,3 +9,10 @@ import (
 
 const Name = "splits"
 const StoreKeyPrefix = keys.Splits
+const SupplyStoreKeyPrefix = keys.Supplies
+const EscrowStoreKeyPrefix = keys.Escrows
+const VestingStoreKeyPrefix = keys.Vestings
+const AllowanceStoreKeyPrefix = keys.Allowances
+const ClaimStoreKeyPrefix = keys.Claims
+const DistributionStoreKeyPrefix = keys.Distributions
+const CheckpointStoreKeyPrefix = keys.Checkpoints
@@ -0,0 +1,17 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package denoms
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// ID is the parameter holding the registry of the denominations of the coins that can be wrapped into splits, each entry giving the cap
+// on the wrapped total of its denomination along with its decimals and display unit
+var ID = baseIDs.NewID("allowedWrapDenoms")
+
+var DefaultData = baseData.NewListData(baseData.NewStringData(sdkTypes.DefaultBondDenom))
@@ -0,0 +1,74 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package denoms
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+)
+
+// fieldSeparator separates the denomination, cap, decimals and display unit of a registry entry, it is not allowed in denominations
+const fieldSeparator = ":"
+
+// maxDecimals is the precision of splits, coins with more decimals could not be represented by them
+const maxDecimals = sdkTypes.Precision
+
+var displayRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]{0,15}$`)
+
+// Denom is an entry of the wrap denomination registry, a cap of zero leaves the wrapped total of the denomination unbounded
+type Denom struct {
+	Denom    string
+	Cap      sdkTypes.Int
+	Decimals int64
+	Display  string
+}
+
+func (denom Denom) String() string {
+	return strings.Join([]string{denom.Denom, denom.Cap.String(), strconv.FormatInt(denom.Decimals, 10), denom.Display}, fieldSeparator)
+}
+
+// IsCapped tells if the wrapped total of the denomination is bounded
+func (denom Denom) IsCapped() bool {
+	return denom.Cap.IsPositive()
+}
+
+func NewDenom(denom string, cap sdkTypes.Int, decimals int64, display string) Denom {
+	return Denom{
+		Denom:    denom,
+		Cap:      cap,
+		Decimals: decimals,
+		Display:  display,
+	}
+}
+
+// ReadDenom reads a registry entry written as denom:cap:decimals:display, or as a bare denomination, which is uncapped, has no decimals
+// and is displayed as itself. Denominations are valid coin denominations, so they never collide with the composite IDs of assets.
+func ReadDenom(denomString string) (Denom, error) {
+	fieldList := strings.Split(denomString, fieldSeparator)
+
+	if len(fieldList) == 1 {
+		fieldList = []string{fieldList[0], "0", "0", fieldList[0]}
+	}
+
+	if len(fieldList) != 4 || sdkTypes.ValidateDenom(fieldList[0]) != nil || !displayRegexp.MatchString(fieldList[3]) {
+		return Denom{}, errors.IncorrectFormat
+	}
+
+	cap, ok := sdkTypes.NewIntFromString(fieldList[1])
+	if !ok || cap.IsNegative() {
+		return Denom{}, errors.IncorrectFormat
+	}
+
+	decimals, err := strconv.ParseInt(fieldList[2], 10, 64)
+	if err != nil || decimals < 0 || decimals > maxDecimals {
+		return Denom{}, errors.IncorrectFormat
+	}
+
+	return NewDenom(fieldList[0], cap, decimals, fieldList[3]), nil
+}
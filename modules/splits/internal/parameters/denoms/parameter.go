@@ -1,7 +1,7 @@
 // Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
 // SPDX-License-Identifier: Apache-2.0
 
-package dummy
+package denoms
 
 import (
 	baseTypes "github.com/AssetMantle/modules/schema/parameters/base"
@@ -0,0 +1,41 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package denoms
+
+import (
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/parameters"
+)
+
+func validator(i interface{}) error {
+	switch value := i.(type) {
+	case parameters.Parameter:
+		if value.GetID().Compare(ID) != 0 {
+			return errors.InvalidParameter
+		}
+
+		return validator(value.GetData())
+	case data.ListData:
+		denomSet := make(map[string]bool)
+
+		for _, datum := range value.Get() {
+			stringData, ok := datum.(data.StringData)
+			if !ok {
+				return errors.InvalidParameter
+			}
+
+			denom, err := ReadDenom(stringData.Get())
+			if err != nil || denomSet[denom.Denom] {
+				return errors.InvalidParameter
+			}
+
+			denomSet[denom.Denom] = true
+		}
+
+		return nil
+	default:
+		return errors.IncorrectFormat
+	}
+}
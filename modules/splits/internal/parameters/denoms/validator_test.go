@@ -0,0 +1,47 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package denoms
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/parameters/base"
+)
+
+func Test_validator(t *testing.T) {
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+
+		{"+ve", args{Parameter}, false},
+		{"+ve empty list", args{baseData.NewListData()}, false},
+		{"+ve several denoms", args{baseData.NewListData(baseData.NewStringData("stake"), baseData.NewStringData("uatom"))}, false},
+		{"+ve registry entries", args{baseData.NewListData(baseData.NewStringData("stake:1000000:6:STAKE"), baseData.NewStringData("uatom:0:6:atom"))}, false},
+		{"-ve invalid denom", args{baseData.NewListData(baseData.NewStringData("S"))}, true},
+		{"-ve duplicate denom", args{baseData.NewListData(baseData.NewStringData("stake"), baseData.NewStringData("stake:100:0:stake"))}, true},
+		{"-ve missing fields", args{baseData.NewListData(baseData.NewStringData("stake:100"))}, true},
+		{"-ve negative cap", args{baseData.NewListData(baseData.NewStringData("stake:-1:0:stake"))}, true},
+		{"-ve too many decimals", args{baseData.NewListData(baseData.NewStringData("stake:0:19:stake"))}, true},
+		{"-ve invalid display", args{baseData.NewListData(baseData.NewStringData("stake:0:6:st ake"))}, true},
+		{"-ve wrong data in list", args{baseData.NewListData(baseData.NewDecData(sdkTypes.OneDec()))}, true},
+		{"-ve wrong parameter ID", args{baseTypes.NewParameter(baseIDs.NewID("newID"), DefaultData, validator)}, true},
+		{"-ve wrong data type", args{baseTypes.NewParameter(ID, baseData.NewStringData("stake"), validator)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validator(tt.args.i); (err != nil) != tt.wantErr {
+				t.Errorf("validator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
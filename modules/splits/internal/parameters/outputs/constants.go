@@ -1,7 +1,7 @@
 // Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
 // SPDX-License-Identifier: Apache-2.0
 
-package dummy
+package outputs
 
 import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
@@ -10,6 +10,7 @@ import (
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
 )
 
-var ID = baseIDs.NewID("dummy")
+// ID is the parameter bounding the number of outputs a multi-send message can pay out to
+var ID = baseIDs.NewID("maxMultiSendOutputs")
 
-var DefaultData = baseData.NewDecData(sdkTypes.SmallestDec())
+var DefaultData = baseData.NewDecData(sdkTypes.NewDec(100))
@@ -0,0 +1,17 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package payees
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// ID is the parameter bounding the number of splits and escrows a distribution paid out directly can cover, distributions to larger
+// numbers of holders are left to be claimed
+var ID = baseIDs.NewID("maxDistributionPayees")
+
+var DefaultData = baseData.NewDecData(sdkTypes.NewDec(100))
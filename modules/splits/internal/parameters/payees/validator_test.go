@@ -1,14 +1,16 @@
 // Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
 // SPDX-License-Identifier: Apache-2.0
 
-package dummy
+package payees
 
 import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
 	baseData "github.com/AssetMantle/modules/schema/data/base"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
 	baseTypes "github.com/AssetMantle/modules/schema/parameters/base"
-	sdkTypes "github.com/cosmos/cosmos-sdk/types"
-	"testing"
 )
 
 func Test_validator(t *testing.T) {
@@ -21,10 +23,13 @@ func Test_validator(t *testing.T) {
 		wantErr bool
 	}{
 
-		{"+ve with nil", args{Parameter}, false},
-		{"-ve wrong parameter Type", args{baseTypes.NewParameter(baseIDs.NewID("newID"), baseData.NewDecData(sdkTypes.NewDec(-1)), validator)}, true},
-		{"-ve wrong parameter Type", args{baseTypes.NewParameter(baseIDs.NewID("newID"), baseData.NewStringData("newStringData"), validator)}, true},
-		{"+ve empty string", args{baseIDs.NewID("")}, true},
+		{"+ve", args{Parameter}, false},
+		{"+ve single payee", args{baseData.NewDecData(sdkTypes.OneDec())}, false},
+		{"-ve zero", args{baseData.NewDecData(sdkTypes.ZeroDec())}, true},
+		{"-ve negative", args{baseData.NewDecData(sdkTypes.NewDec(-1))}, true},
+		{"-ve fractional", args{baseData.NewDecData(sdkTypes.NewDecWithPrec(15, 1))}, true},
+		{"-ve wrong parameter ID", args{baseTypes.NewParameter(baseIDs.NewID("newID"), DefaultData, validator)}, true},
+		{"-ve wrong data type", args{baseTypes.NewParameter(ID, baseData.NewStringData("100"), validator)}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
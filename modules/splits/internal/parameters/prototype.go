@@ -4,11 +4,15 @@
 package parameters
 
 import (
-	"github.com/AssetMantle/modules/modules/splits/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/denoms"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/outputs"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/payees"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/vestings"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/windows"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 )
 
 func Prototype() helpers.Parameters {
-	return baseHelpers.NewParameters(dummy.Parameter)
+	return baseHelpers.NewParameters(denoms.Parameter, outputs.Parameter, vestings.Parameter, payees.Parameter, windows.Parameter)
 }
@@ -0,0 +1,16 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package vestings
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// ID is the parameter bounding the number of vesting schedules still locking an ownable of an owner
+var ID = baseIDs.NewID("maxVestingSchedules")
+
+var DefaultData = baseData.NewDecData(sdkTypes.NewDec(16))
@@ -0,0 +1,17 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package windows
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// ID is the parameter setting the number of blocks the holders have to claim a distribution before its distributor can take back what
+// is left of it
+var ID = baseIDs.NewID("claimWindow")
+
+var DefaultData = baseData.NewDecData(sdkTypes.NewDec(100800))
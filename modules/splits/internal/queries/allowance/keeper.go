@@ -0,0 +1,49 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package allowance
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+type queryKeeper struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.QueryKeeper = (*queryKeeper)(nil)
+
+func (queryKeeper queryKeeper) Enquire(context sdkTypes.Context, request helpers.QueryRequest) helpers.QueryResponse {
+	queryRequest := queryRequestFromInterface(request)
+	if queryRequest.OwnerID == nil {
+		return newQueryResponse(nil, errors.IncorrectFormat)
+	}
+
+	// without a spender the allowances the owner grants all spenders are returned
+	spenderID := queryRequest.SpenderID
+	if spenderID == nil {
+		spenderID = baseIDs.NewID("")
+	}
+
+	var list []helpers.Mappable
+
+	for _, allowance := range utilities.GetAllowances(queryKeeper.mapper.NewCollection(context), queryRequest.OwnerID, spenderID) {
+		list = append(list, allowance)
+	}
+
+	return newQueryResponse(list, nil)
+}
+
+func (queryKeeper queryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
+	queryKeeper.mapper = mapper
+	return queryKeeper
+}
+
+func keeperPrototype() helpers.QueryKeeper {
+	return queryKeeper{}
+}
@@ -0,0 +1,89 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package allowance
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func CreateTestInput2(t *testing.T) (sdkTypes.Context, helpers.Keeper) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+		Height:  20,
+	}, false, log.NewNopLogger())
+
+	mapper := baseHelpers.NewMapper(key.Prototype, mappable.Prototype).Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test"))
+
+	testQueryKeeper := keeperPrototype().Initialize(mapper, Parameters, []interface{}{})
+
+	return context, testQueryKeeper
+}
+
+func Test_Query_Keeper_Allowance(t *testing.T) {
+	context, keepers := CreateTestInput2(t)
+
+	ownerID := baseIDs.NewID("ownerID")
+	spenderID := baseIDs.NewID("spenderID")
+	spenderID2 := baseIDs.NewID("spenderID2")
+	ownableID := baseIDs.NewID("ownableID")
+	collection := keepers.(queryKeeper).mapper.NewCollection(context)
+	collection.Add(mappable.NewAllowance(ownerID, spenderID, ownableID, sdkTypes.NewDec(10), baseTypes.NewHeight(-1)))
+	collection.Add(mappable.NewAllowance(ownerID, spenderID, baseIDs.NewID("ownableID2"), sdkTypes.NewDec(20), baseTypes.NewHeight(30)))
+	collection.Add(mappable.NewAllowance(ownerID, spenderID2, ownableID, sdkTypes.NewDec(5), baseTypes.NewHeight(-1)))
+	collection.Add(mappable.NewAllowance(baseIDs.NewID("otherID"), spenderID, ownableID, sdkTypes.NewDec(1), baseTypes.NewHeight(-1)))
+
+	response := keepers.(queryKeeper).Enquire(context, newQueryRequest(ownerID, spenderID)).(queryResponse)
+	require.Equal(t, true, response.IsSuccessful())
+	// allowances of spenders whose IDs merely start with the same bytes are left out
+	require.Equal(t, 2, len(response.List))
+
+	response = keepers.(queryKeeper).Enquire(context, queryRequest{OwnerID: ownerID}).(queryResponse)
+	require.Equal(t, 3, len(response.List))
+
+	require.Equal(t, false, keepers.(queryKeeper).Enquire(context, queryRequest{}).IsSuccessful())
+}
@@ -0,0 +1,43 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package escrow
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+type queryKeeper struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.QueryKeeper = (*queryKeeper)(nil)
+
+func (queryKeeper queryKeeper) Enquire(context sdkTypes.Context, request helpers.QueryRequest) helpers.QueryResponse {
+	queryRequest := queryRequestFromInterface(request)
+	if queryRequest.HolderID == nil {
+		return newQueryResponse(queryKeeper.mapper.NewCollection(context), errors.IncorrectFormat)
+	}
+
+	// without a reference all the escrows of the holder are returned
+	referenceID := queryRequest.ReferenceID
+	if referenceID == nil {
+		referenceID = baseIDs.NewID("")
+	}
+
+	return newQueryResponse(queryKeeper.mapper.NewCollection(context).Fetch(key.FromEscrowID(key.NewEscrowID(queryRequest.HolderID, referenceID))), nil)
+}
+
+func (queryKeeper queryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
+	queryKeeper.mapper = mapper
+	return queryKeeper
+}
+
+func keeperPrototype() helpers.QueryKeeper {
+	return queryKeeper{}
+}
@@ -0,0 +1,81 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package escrow
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func CreateTestInput2(t *testing.T) (sdkTypes.Context, helpers.Keeper) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	mapper := baseHelpers.NewMapper(key.Prototype, mappable.Prototype).Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test"))
+
+	testQueryKeeper := keeperPrototype().Initialize(mapper, Parameters, []interface{}{})
+
+	return context, testQueryKeeper
+}
+
+func Test_Query_Keeper_Escrow(t *testing.T) {
+	context, keepers := CreateTestInput2(t)
+
+	holderID := baseIDs.NewID("holderID")
+	ownerID := baseIDs.NewID("ownerID")
+	ownableID := baseIDs.NewID("ownableID")
+	collection := keepers.(queryKeeper).mapper.NewCollection(context)
+	collection.Add(mappable.NewEscrow(holderID, baseIDs.NewID("reference1"), ownerID, ownableID, sdkTypes.NewDec(123)))
+	collection.Add(mappable.NewEscrow(holderID, baseIDs.NewID("reference2"), ownerID, ownableID, sdkTypes.NewDec(7)))
+	collection.Add(mappable.NewEscrow(baseIDs.NewID("otherID"), baseIDs.NewID("reference1"), ownerID, ownableID, sdkTypes.NewDec(1)))
+
+	escrowID := key.NewEscrowID(holderID, baseIDs.NewID("reference1"))
+	require.Equal(t, queryResponse{Success: true, Error: nil, List: keepers.(queryKeeper).mapper.NewCollection(context).Fetch(key.FromEscrowID(escrowID)).GetList()}, keepers.(queryKeeper).Enquire(context, newQueryRequest(holderID, baseIDs.NewID("reference1"))))
+	require.Equal(t, 2, len(keepers.(queryKeeper).Enquire(context, newQueryRequest(holderID, baseIDs.NewID(""))).(queryResponse).List))
+	require.Equal(t, 2, len(keepers.(queryKeeper).Enquire(context, queryRequest{HolderID: holderID}).(queryResponse).List))
+	require.Equal(t, false, keepers.(queryKeeper).Enquire(context, queryRequest{}).IsSuccessful())
+}
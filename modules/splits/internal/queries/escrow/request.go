@@ -0,0 +1,74 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package escrow
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/common"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+type queryRequest struct {
+	HolderID    ids.ID `json:"holderID" valid:"required~required field holderID missing"`
+	ReferenceID ids.ID `json:"referenceID"`
+}
+
+var _ helpers.QueryRequest = (*queryRequest)(nil)
+
+// Validate godoc
+// @Summary Query escrows using holder id and reference id
+// @Description Able to query the escrows a holder keeps, or the one it keeps under a reference
+// @Accept json
+// @Produce json
+// @Tags Splits
+// @Param holderID path string true "holder ID"
+// @Param referenceID query string false "reference ID"
+// @Success 200 {object} queryResponse "Message for a successful query response"
+// @Failure default  {object}  queryResponse "Message for an unexpected error response."
+// @Router /splits/escrows/{holderID} [get]
+func (queryRequest queryRequest) Validate() error {
+	_, err := govalidator.ValidateStruct(queryRequest)
+	return err
+}
+
+func (queryRequest queryRequest) FromCLI(cliCommand helpers.CLICommand, _ context.CLIContext) helpers.QueryRequest {
+	return newQueryRequest(baseIDs.NewID(cliCommand.ReadString(constants.HolderID)), baseIDs.NewID(cliCommand.ReadString(constants.ReferenceID)))
+}
+func (queryRequest queryRequest) FromMap(vars map[string]string) helpers.QueryRequest {
+	holderID, ok := vars[Query.GetName()]
+	if !ok {
+		holderID = vars[constants.HolderID.GetName()]
+	}
+
+	return newQueryRequest(baseIDs.NewID(holderID), baseIDs.NewID(vars[constants.ReferenceID.GetName()]))
+}
+func (queryRequest queryRequest) Encode() ([]byte, error) {
+	return common.Codec.MarshalJSON(queryRequest)
+}
+func (queryRequest queryRequest) Decode(bytes []byte) (helpers.QueryRequest, error) {
+	if err := common.Codec.UnmarshalJSON(bytes, &queryRequest); err != nil {
+		return nil, err
+	}
+
+	return queryRequest, nil
+}
+func requestPrototype() helpers.QueryRequest {
+	return queryRequest{}
+}
+func queryRequestFromInterface(request helpers.QueryRequest) queryRequest {
+	switch value := request.(type) {
+	case queryRequest:
+		return value
+	default:
+		return queryRequest{}
+	}
+}
+func newQueryRequest(holderID ids.ID, referenceID ids.ID) helpers.QueryRequest {
+	return queryRequest{HolderID: holderID, ReferenceID: referenceID}
+}
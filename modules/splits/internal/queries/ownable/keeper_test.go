@@ -18,10 +18,10 @@ import (
 
 	"github.com/AssetMantle/modules/modules/splits/internal/key"
 	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/mapper"
 	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
 	"github.com/AssetMantle/modules/schema"
 	"github.com/AssetMantle/modules/schema/helpers"
-	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
 )
 
@@ -49,7 +49,7 @@ func CreateTestInput2(t *testing.T) (sdkTypes.Context, helpers.Keeper) {
 		ChainID: "test",
 	}, false, log.NewNopLogger())
 
-	mapper := baseHelpers.NewMapper(key.Prototype, mappable.Prototype).Initialize(storeKey)
+	Mapper := mapper.Prototype().Initialize(storeKey)
 	paramsKeeper := params.NewKeeper(
 		Codec,
 		paramsStoreKey,
@@ -57,7 +57,7 @@ func CreateTestInput2(t *testing.T) (sdkTypes.Context, helpers.Keeper) {
 	)
 	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test"))
 
-	testQueryKeeper := keeperPrototype().Initialize(mapper, Parameters, []interface{}{})
+	testQueryKeeper := keeperPrototype().Initialize(Mapper, Parameters, []interface{}{})
 
 	return context, testQueryKeeper
 }
@@ -68,11 +68,9 @@ func Test_Query_Keeper_Split(t *testing.T) {
 	ownerID := baseIDs.NewID("ownerID")
 	ownableID := baseIDs.NewID("ownableID")
 	splitID := key.NewSplitID(ownerID, ownableID)
-	keepers.(queryKeeper).mapper.NewCollection(context).Add(mappable.NewSplit(splitID, sdkTypes.NewDec(123)))
+	keepers.(queryKeeper).mapper.NewCollection(context).Add(mappable.NewSplit(splitID, sdkTypes.NewDec(123))).Add(mappable.NewSupply(ownableID, sdkTypes.NewDec(123)))
 
-	testQueryRequest := newQueryRequest(splitID)
-	require.Panics(t, func() {
-		require.Equal(t, queryResponse{Success: true, Value: sdkTypes.NewDec(123)}, keepers.(queryKeeper).Enquire(context, testQueryRequest))
-	})
+	testQueryRequest := newQueryRequest(ownableID)
+	require.Equal(t, queryResponse{Success: true, Value: sdkTypes.NewDec(123)}, keepers.(queryKeeper).Enquire(context, testQueryRequest))
 
 }
@@ -4,8 +4,13 @@
 package queries
 
 import (
+	"github.com/AssetMantle/modules/modules/splits/internal/queries/allowance"
+	"github.com/AssetMantle/modules/modules/splits/internal/queries/escrow"
+	"github.com/AssetMantle/modules/modules/splits/internal/queries/list"
 	"github.com/AssetMantle/modules/modules/splits/internal/queries/ownable"
 	"github.com/AssetMantle/modules/modules/splits/internal/queries/split"
+	"github.com/AssetMantle/modules/modules/splits/internal/queries/vesting"
+	"github.com/AssetMantle/modules/modules/splits/internal/queries/wrapped"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 )
@@ -14,5 +19,10 @@ func Prototype() helpers.Queries {
 	return baseHelpers.NewQueries(
 		split.Query,
 		ownable.Query,
+		list.Query,
+		escrow.Query,
+		vesting.Query,
+		wrapped.Query,
+		allowance.Query,
 	)
 }
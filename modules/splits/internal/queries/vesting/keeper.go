@@ -0,0 +1,76 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package vesting
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type queryKeeper struct {
+	mapper helpers.Mapper
+}
+
+var _ helpers.QueryKeeper = (*queryKeeper)(nil)
+
+func (queryKeeper queryKeeper) Enquire(context sdkTypes.Context, request helpers.QueryRequest) helpers.QueryResponse {
+	queryRequest := queryRequestFromInterface(request)
+	if queryRequest.OwnerID == nil {
+		return newQueryResponse(nil, nil, errors.IncorrectFormat)
+	}
+
+	// without an ownable the schedules on all the splits of the owner are returned
+	ownableID := queryRequest.OwnableID
+	if ownableID == nil {
+		ownableID = baseIDs.NewID("")
+	}
+
+	height := baseTypes.NewHeight(context.BlockHeight())
+	collection := queryKeeper.mapper.NewCollection(context)
+
+	var list []helpers.Mappable
+
+	var holdings []holding
+
+	holdingIndexes := make(map[string]int)
+
+	for _, vesting := range utilities.GetVestings(collection, queryRequest.OwnerID, ownableID) {
+		list = append(list, vesting)
+
+		index, ok := holdingIndexes[vesting.GetOwnableID().String()]
+		if !ok {
+			value := sdkTypes.ZeroDec()
+
+			splitKey := key.FromID(key.NewSplitID(queryRequest.OwnerID, vesting.GetOwnableID()))
+			if split, ok := collection.Fetch(splitKey).Get(splitKey).(mappables.Split); ok {
+				value = split.GetValue()
+			}
+
+			index = len(holdings)
+			holdingIndexes[vesting.GetOwnableID().String()] = index
+			holdings = append(holdings, holding{OwnableID: vesting.GetOwnableID(), Value: value, Vested: sdkTypes.ZeroDec(), Locked: sdkTypes.ZeroDec()})
+		}
+
+		holdings[index].Vested = holdings[index].Vested.Add(vesting.GetVested(height))
+		holdings[index].Locked = holdings[index].Locked.Add(vesting.GetLocked(height))
+	}
+
+	return newQueryResponse(list, holdings, nil)
+}
+
+func (queryKeeper queryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
+	queryKeeper.mapper = mapper
+	return queryKeeper
+}
+
+func keeperPrototype() helpers.QueryKeeper {
+	return queryKeeper{}
+}
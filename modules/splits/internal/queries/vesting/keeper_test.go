@@ -0,0 +1,93 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package vesting
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func CreateTestInput2(t *testing.T) (sdkTypes.Context, helpers.Keeper) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+		Height:  20,
+	}, false, log.NewNopLogger())
+
+	mapper := baseHelpers.NewMapper(key.Prototype, mappable.Prototype).Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test"))
+
+	testQueryKeeper := keeperPrototype().Initialize(mapper, Parameters, []interface{}{})
+
+	return context, testQueryKeeper
+}
+
+func Test_Query_Keeper_Vesting(t *testing.T) {
+	context, keepers := CreateTestInput2(t)
+
+	ownerID := baseIDs.NewID("ownerID")
+	granterID := baseIDs.NewID("granterID")
+	ownableID := baseIDs.NewID("ownableID")
+	ownableID2 := baseIDs.NewID("ownableID2")
+	collection := keepers.(queryKeeper).mapper.NewCollection(context)
+	collection.Add(mappable.NewSplit(key.NewSplitID(ownerID, ownableID), sdkTypes.NewDec(150)))
+	collection.Add(mappable.NewVesting(ownerID, ownableID, granterID, mappable.LinearVesting, sdkTypes.NewDec(100), baseTypes.NewHeight(10), baseTypes.NewHeight(30), 0))
+	collection.Add(mappable.NewVesting(ownerID, ownableID, granterID, mappable.CliffVesting, sdkTypes.NewDec(20), baseTypes.NewHeight(15), baseTypes.NewHeight(40), 0))
+	collection.Add(mappable.NewVesting(ownerID, ownableID2, granterID, mappable.CliffVesting, sdkTypes.NewDec(5), baseTypes.NewHeight(15), baseTypes.NewHeight(20), 0))
+	collection.Add(mappable.NewVesting(baseIDs.NewID("otherID"), ownableID, granterID, mappable.CliffVesting, sdkTypes.NewDec(1), baseTypes.NewHeight(15), baseTypes.NewHeight(40), 0))
+
+	response := keepers.(queryKeeper).Enquire(context, newQueryRequest(ownerID, ownableID)).(queryResponse)
+	require.Equal(t, true, response.IsSuccessful())
+	require.Equal(t, 2, len(response.List))
+	require.Equal(t, []holding{{OwnableID: ownableID, Value: sdkTypes.NewDec(150), Vested: sdkTypes.NewDec(50), Locked: sdkTypes.NewDec(70)}}, response.Holdings)
+
+	response = keepers.(queryKeeper).Enquire(context, queryRequest{OwnerID: ownerID}).(queryResponse)
+	require.Equal(t, 3, len(response.List))
+	require.Equal(t, 2, len(response.Holdings))
+	// schedules are iterated by their key bytes, which place ownableID2 ahead of ownableID
+	require.Equal(t, holding{OwnableID: ownableID2, Value: sdkTypes.ZeroDec(), Vested: sdkTypes.NewDec(5), Locked: sdkTypes.ZeroDec()}, response.Holdings[0])
+
+	require.Equal(t, false, keepers.(queryKeeper).Enquire(context, queryRequest{}).IsSuccessful())
+}
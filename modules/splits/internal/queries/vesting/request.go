@@ -0,0 +1,74 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package vesting
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/common"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+type queryRequest struct {
+	OwnerID   ids.ID `json:"ownerID" valid:"required~required field ownerID missing"`
+	OwnableID ids.ID `json:"ownableID"`
+}
+
+var _ helpers.QueryRequest = (*queryRequest)(nil)
+
+// Validate godoc
+// @Summary Query vesting schedules using owner id and ownable id
+// @Description Able to query the vesting schedules on the splits of an owner, or on its split of an ownable, with the vested and locked values
+// @Accept json
+// @Produce json
+// @Tags Splits
+// @Param ownerID path string true "owner ID"
+// @Param ownableID query string false "ownable ID"
+// @Success 200 {object} queryResponse "Message for a successful query response"
+// @Failure default  {object}  queryResponse "Message for an unexpected error response."
+// @Router /splits/vestings/{ownerID} [get]
+func (queryRequest queryRequest) Validate() error {
+	_, err := govalidator.ValidateStruct(queryRequest)
+	return err
+}
+
+func (queryRequest queryRequest) FromCLI(cliCommand helpers.CLICommand, _ context.CLIContext) helpers.QueryRequest {
+	return newQueryRequest(baseIDs.NewID(cliCommand.ReadString(constants.OwnerID)), baseIDs.NewID(cliCommand.ReadString(constants.OwnableID)))
+}
+func (queryRequest queryRequest) FromMap(vars map[string]string) helpers.QueryRequest {
+	ownerID, ok := vars[Query.GetName()]
+	if !ok {
+		ownerID = vars[constants.OwnerID.GetName()]
+	}
+
+	return newQueryRequest(baseIDs.NewID(ownerID), baseIDs.NewID(vars[constants.OwnableID.GetName()]))
+}
+func (queryRequest queryRequest) Encode() ([]byte, error) {
+	return common.Codec.MarshalJSON(queryRequest)
+}
+func (queryRequest queryRequest) Decode(bytes []byte) (helpers.QueryRequest, error) {
+	if err := common.Codec.UnmarshalJSON(bytes, &queryRequest); err != nil {
+		return nil, err
+	}
+
+	return queryRequest, nil
+}
+func requestPrototype() helpers.QueryRequest {
+	return queryRequest{}
+}
+func queryRequestFromInterface(request helpers.QueryRequest) queryRequest {
+	switch value := request.(type) {
+	case queryRequest:
+		return value
+	default:
+		return queryRequest{}
+	}
+}
+func newQueryRequest(ownerID ids.ID, ownableID ids.ID) helpers.QueryRequest {
+	return queryRequest{OwnerID: ownerID, OwnableID: ownableID}
+}
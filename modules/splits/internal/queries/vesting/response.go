@@ -0,0 +1,62 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package vesting
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/common"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+)
+
+// holding sums up the vesting schedules on a split of the owner, the value of the split less the locked value can be moved
+type holding struct {
+	OwnableID ids.ID       `json:"ownableID"`
+	Value     sdkTypes.Dec `json:"value"`
+	Vested    sdkTypes.Dec `json:"vested"`
+	Locked    sdkTypes.Dec `json:"locked"`
+}
+
+type queryResponse struct {
+	Success  bool               `json:"success"`
+	Error    error              `json:"error" swaggertype:"string"`
+	List     []helpers.Mappable `json:"list"`
+	Holdings []holding          `json:"holdings"`
+}
+
+var _ helpers.QueryResponse = (*queryResponse)(nil)
+
+func (queryResponse queryResponse) IsSuccessful() bool {
+	return queryResponse.Success
+}
+func (queryResponse queryResponse) GetError() error {
+	return queryResponse.Error
+}
+func (queryResponse queryResponse) Encode() ([]byte, error) {
+	return common.Codec.MarshalJSON(queryResponse)
+}
+func (queryResponse queryResponse) Decode(bytes []byte) (helpers.QueryResponse, error) {
+	if err := common.Codec.UnmarshalJSON(bytes, &queryResponse); err != nil {
+		return nil, err
+	}
+
+	return queryResponse, nil
+}
+func responsePrototype() helpers.QueryResponse {
+	return queryResponse{}
+}
+func newQueryResponse(list []helpers.Mappable, holdings []holding, error error) helpers.QueryResponse {
+	success := true
+	if error != nil {
+		success = false
+	}
+
+	return queryResponse{
+		Success:  success,
+		Error:    error,
+		List:     list,
+		Holdings: holdings,
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package wrapped
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+type queryKeeper struct {
+	mapper     helpers.Mapper
+	parameters helpers.Parameters
+}
+
+var _ helpers.QueryKeeper = (*queryKeeper)(nil)
+
+func (queryKeeper queryKeeper) Enquire(context sdkTypes.Context, request helpers.QueryRequest) helpers.QueryResponse {
+	queryRequest := queryRequestFromInterface(request)
+	collection := queryKeeper.mapper.NewCollection(context)
+
+	var list []wrappedDenom
+
+	listed := make(map[string]bool)
+
+	for _, denom := range utilities.GetWrapDenoms(context, queryKeeper.parameters) {
+		listed[denom.Denom] = true
+
+		if queryRequest.Denom == "" || queryRequest.Denom == denom.Denom {
+			list = append(list, wrappedDenom{Denom: denom.Denom, Listed: true, Cap: denom.Cap, Decimals: denom.Decimals, Display: denom.Display, Wrapped: utilities.GetSupply(collection, baseIDs.NewID(denom.Denom))})
+		}
+	}
+
+	// supplies of valid coin denominations are only ever made by wrapping, so the ones off the registry were wrapped before it dropped them
+	queryKeeper.mapper.Iterate(context, key.SupplyPrototype(), func(mappable helpers.Mappable) bool {
+		if supply, ok := mappable.(mappables.Supply); ok {
+			denom := supply.GetOwnableID().String()
+
+			if !listed[denom] && sdkTypes.ValidateDenom(denom) == nil && (queryRequest.Denom == "" || queryRequest.Denom == denom) {
+				list = append(list, wrappedDenom{Denom: denom, Listed: false, Cap: sdkTypes.ZeroInt(), Decimals: 0, Display: denom, Wrapped: supply.GetValue()})
+			}
+		}
+
+		return false
+	})
+
+	return newQueryResponse(list, nil)
+}
+
+func (queryKeeper queryKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, _ []interface{}) helpers.Keeper {
+	queryKeeper.mapper, queryKeeper.parameters = mapper, parameters
+	return queryKeeper
+}
+
+func keeperPrototype() helpers.QueryKeeper {
+	return queryKeeper{}
+}
@@ -0,0 +1,87 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package wrapped
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/denoms"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func CreateTestInput(t *testing.T) (sdkTypes.Context, helpers.Keeper) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	mapper := baseHelpers.NewMapper(key.Prototype, mappable.Prototype).Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test").WithKeyTable(parameters.Prototype().GetKeyTable()))
+	Parameters.Mutate(context, denoms.Parameter.Mutate(baseData.NewListData(baseData.NewStringData("stake:1000:6:STAKE"), baseData.NewStringData("uatom"))))
+
+	testQueryKeeper := keeperPrototype().Initialize(mapper, Parameters, []interface{}{})
+
+	return context, testQueryKeeper
+}
+
+func Test_Query_Keeper_Wrapped(t *testing.T) {
+	context, keepers := CreateTestInput(t)
+
+	collection := keepers.(queryKeeper).mapper.NewCollection(context)
+	collection.Add(mappable.NewSupply(baseIDs.NewID("stake"), sdkTypes.NewDec(400)))
+	collection.Add(mappable.NewSupply(baseIDs.NewID("delisted"), sdkTypes.NewDec(7)))
+	collection.Add(mappable.NewSupply(baseIDs.NewID("classificationID|hashID"), sdkTypes.NewDec(1)))
+
+	response := keepers.(queryKeeper).Enquire(context, newQueryRequest("")).(queryResponse)
+	require.Equal(t, true, response.IsSuccessful())
+	require.Equal(t, []wrappedDenom{
+		{Denom: "stake", Listed: true, Cap: sdkTypes.NewInt(1000), Decimals: 6, Display: "STAKE", Wrapped: sdkTypes.NewDec(400)},
+		{Denom: "uatom", Listed: true, Cap: sdkTypes.ZeroInt(), Decimals: 0, Display: "uatom", Wrapped: sdkTypes.ZeroDec()},
+		{Denom: "delisted", Listed: false, Cap: sdkTypes.ZeroInt(), Decimals: 0, Display: "delisted", Wrapped: sdkTypes.NewDec(7)},
+	}, response.List)
+
+	response = keepers.(queryKeeper).Enquire(context, newQueryRequest("delisted")).(queryResponse)
+	require.Equal(t, 1, len(response.List))
+	require.Equal(t, sdkTypes.NewDec(7), response.List[0].Wrapped)
+}
@@ -0,0 +1,61 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package wrapped
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/common"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+// wrappedDenom describes a denomination of the registry, or one taken off it that still has wrapped coins, along with its wrapped total
+type wrappedDenom struct {
+	Denom    string       `json:"denom"`
+	Listed   bool         `json:"listed"`
+	Cap      sdkTypes.Int `json:"cap"`
+	Decimals int64        `json:"decimals"`
+	Display  string       `json:"display"`
+	Wrapped  sdkTypes.Dec `json:"wrapped"`
+}
+
+type queryResponse struct {
+	Success bool           `json:"success"`
+	Error   error          `json:"error" swaggertype:"string"`
+	List    []wrappedDenom `json:"list"`
+}
+
+var _ helpers.QueryResponse = (*queryResponse)(nil)
+
+func (queryResponse queryResponse) IsSuccessful() bool {
+	return queryResponse.Success
+}
+func (queryResponse queryResponse) GetError() error {
+	return queryResponse.Error
+}
+func (queryResponse queryResponse) Encode() ([]byte, error) {
+	return common.Codec.MarshalJSON(queryResponse)
+}
+func (queryResponse queryResponse) Decode(bytes []byte) (helpers.QueryResponse, error) {
+	if err := common.Codec.UnmarshalJSON(bytes, &queryResponse); err != nil {
+		return nil, err
+	}
+
+	return queryResponse, nil
+}
+func responsePrototype() helpers.QueryResponse {
+	return queryResponse{}
+}
+func newQueryResponse(list []wrappedDenom, error error) helpers.QueryResponse {
+	success := true
+	if error != nil {
+		success = false
+	}
+
+	return queryResponse{
+		Success: success,
+		Error:   error,
+		List:    list,
+	}
+}
@@ -6,5 +6,59 @@ package simulator
 const OpWeightSubmitTextProposal = "op_weight_submit_text_proposal"
 const DefaultWeightTextProposal = 1
 
-const OpWeightMsg = "OpWeightMsg"
-const DefaultWeightMsg = 1
+const OpWeightSubmitParameterChangeProposal = "op_weight_submit_parameter_change_proposal"
+const DefaultWeightParameterChangeProposal = 1
+
+const (
+	OpWeightWrapMsg            = "op_weight_wrap_msg"
+	OpWeightUnwrapMsg          = "op_weight_unwrap_msg"
+	OpWeightSendMsg            = "op_weight_send_msg"
+	OpWeightMultiSendMsg       = "op_weight_multi_send_msg"
+	OpWeightVestMsg            = "op_weight_vest_msg"
+	OpWeightApproveMsg         = "op_weight_approve_msg"
+	OpWeightRevokeAllowanceMsg = "op_weight_revoke_allowance_msg"
+	OpWeightTransferFromMsg    = "op_weight_transfer_from_msg"
+	OpWeightDistributeMsg      = "op_weight_distribute_msg"
+	OpWeightClaimMsg           = "op_weight_claim_msg"
+	OpWeightReclaimMsg         = "op_weight_reclaim_msg"
+)
+
+const (
+	DefaultWeightWrapMsg            = 40
+	DefaultWeightUnwrapMsg          = 10
+	DefaultWeightSendMsg            = 30
+	DefaultWeightMultiSendMsg       = 10
+	DefaultWeightVestMsg            = 10
+	DefaultWeightApproveMsg         = 10
+	DefaultWeightRevokeAllowanceMsg = 5
+	DefaultWeightTransferFromMsg    = 10
+	DefaultWeightDistributeMsg      = 5
+	DefaultWeightClaimMsg           = 10
+	DefaultWeightReclaimMsg         = 5
+)
+
+// maxSimulatedExtraDenoms bounds the number of denominations besides the bond denomination simulated genesis allows to be wrapped
+const maxSimulatedExtraDenoms = 2
+
+// maxSimulatedWrapCap bounds the caps simulated genesis sets on the wrapped totals of the registered denominations, a cap of zero leaving
+// the total unbounded
+const maxSimulatedWrapCap = 1000000000
+
+// maxSimulatedOutputs bounds the number of outputs of simulated multi-send messages and of the output cap simulated genesis sets
+const maxSimulatedOutputs = 8
+
+// maxSimulatedVestingPeriods bounds the number of periods, of at most as many blocks each, that simulated vesting schedules run for
+const maxSimulatedVestingPeriods = 4
+
+// maxSimulatedVestings bounds the cap simulated genesis sets on the number of schedules still locking a split
+const maxSimulatedVestings = 4
+
+// maxSimulatedAllowanceExpiry bounds the number of blocks simulated allowances that expire stay spendable for
+const maxSimulatedAllowanceExpiry = 10
+
+// maxSimulatedPayees bounds the cap simulated genesis sets on the number of splits and escrows a distribution paid out directly can cover
+const maxSimulatedPayees = 8
+
+// maxSimulatedClaimWindow bounds the number of blocks simulated genesis gives holders to claim a distribution, kept short so that simulated
+// distributors get to take back what is left
+const maxSimulatedClaimWindow = 5
@@ -5,6 +5,7 @@ package simulator
 
 import (
 	"math/rand"
+	"strings"
 
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -15,33 +16,113 @@ import (
 	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
 	splitsModule "github.com/AssetMantle/modules/modules/splits/internal/module"
 	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
-	"github.com/AssetMantle/modules/modules/splits/internal/parameters/dummy"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/denoms"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/outputs"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/payees"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/vestings"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/windows"
 	"github.com/AssetMantle/modules/schema/data"
 	"github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	parameters2 "github.com/AssetMantle/modules/schema/parameters"
-	baseSimulation "github.com/AssetMantle/modules/simulation/schema/types/base"
 )
 
 func (simulator) RandomizedGenesisState(simulationState *module.SimulationState) {
-	var Data data.Data
+	var denomsData data.Data
 
 	simulationState.AppParams.GetOrGenerate(
 		simulationState.Cdc,
-		dummy.ID.String(),
-		&Data,
+		denoms.ID.String(),
+		&denomsData,
 		simulationState.Rand,
-		func(rand *rand.Rand) { Data = base.NewDecData(sdkTypes.NewDecWithPrec(int64(rand.Intn(99)), 2)) },
+		func(rand *rand.Rand) { denomsData = randomAllowedDenoms(rand) },
 	)
 
-	mappableList := make([]helpers.Mappable, simulationState.Rand.Intn(99))
+	var outputsData data.Data
 
-	for i := range mappableList {
-		mappableList[i] = mappable.NewSplit(key.NewSplitID(baseSimulation.GenerateRandomID(simulationState.Rand), baseSimulation.GenerateRandomID(simulationState.Rand)), simulation.RandomDecAmount(simulationState.Rand, sdkTypes.NewDec(9999999999)))
-	}
+	simulationState.AppParams.GetOrGenerate(
+		simulationState.Cdc,
+		outputs.ID.String(),
+		&outputsData,
+		simulationState.Rand,
+		func(rand *rand.Rand) { outputsData = randomMaxOutputs(rand) },
+	)
+
+	var vestingsData data.Data
+
+	simulationState.AppParams.GetOrGenerate(
+		simulationState.Cdc,
+		vestings.ID.String(),
+		&vestingsData,
+		simulationState.Rand,
+		func(rand *rand.Rand) { vestingsData = randomMaxVestings(rand) },
+	)
 
-	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{dummy.Parameter.Mutate(Data)})
+	var payeesData data.Data
+
+	simulationState.AppParams.GetOrGenerate(
+		simulationState.Cdc,
+		payees.ID.String(),
+		&payeesData,
+		simulationState.Rand,
+		func(rand *rand.Rand) { payeesData = randomMaxPayees(rand) },
+	)
+
+	var windowsData data.Data
+
+	simulationState.AppParams.GetOrGenerate(
+		simulationState.Cdc,
+		windows.ID.String(),
+		&windowsData,
+		simulationState.Rand,
+		func(rand *rand.Rand) { windowsData = randomClaimWindow(rand) },
+	)
+
+	// documents referencing other modules are created by the simulated transactions so that genesis satisfies the invariants
+	var mappableList []helpers.Mappable
+
+	genesisState := baseHelpers.NewGenesis(key.Prototype, mappable.Prototype, nil, parameters.Prototype().GetList()).Initialize(mappableList, []parameters2.Parameter{denoms.Parameter.Mutate(denomsData), outputs.Parameter.Mutate(outputsData), vestings.Parameter.Mutate(vestingsData), payees.Parameter.Mutate(payeesData), windows.Parameter.Mutate(windowsData)})
 
 	simulationState.GenState[splitsModule.Name] = common.Codec.MustMarshalJSON(genesisState)
 }
+
+// randomAllowedDenoms always registers the bond denomination, which is the one simulation accounts are funded with, along with a few
+// random ones, each entry being either a bare denomination or one with a random cap, decimals and display unit
+func randomAllowedDenoms(rand *rand.Rand) data.Data {
+	denomList := []data.Data{base.NewStringData(randomWrapDenom(rand, sdkTypes.DefaultBondDenom))}
+
+	for i := rand.Intn(maxSimulatedExtraDenoms + 1); i > 0; i-- {
+		denomList = append(denomList, base.NewStringData(randomWrapDenom(rand, "sim"+strings.ToLower(simulation.RandStringOfLength(rand, 3+rand.Intn(8))))))
+	}
+
+	return base.NewListData(denomList...)
+}
+
+func randomWrapDenom(rand *rand.Rand, denom string) string {
+	if rand.Intn(2) == 0 {
+		return denom
+	}
+
+	return denoms.NewDenom(denom, sdkTypes.NewInt(rand.Int63n(maxSimulatedWrapCap)), int64(rand.Intn(sdkTypes.Precision+1)), strings.ToUpper(denom)).String()
+}
+
+// randomMaxOutputs returns an output cap of at least one output, up to what simulated multi-send messages use
+func randomMaxOutputs(rand *rand.Rand) data.Data {
+	return base.NewDecData(sdkTypes.NewDec(int64(rand.Intn(maxSimulatedOutputs) + 1)))
+}
+
+// randomMaxVestings returns a cap of at least one schedule still locking a split
+func randomMaxVestings(rand *rand.Rand) data.Data {
+	return base.NewDecData(sdkTypes.NewDec(int64(rand.Intn(maxSimulatedVestings) + 1)))
+}
+
+// randomMaxPayees returns a cap of at least one split or escrow a distribution paid out directly can cover
+func randomMaxPayees(rand *rand.Rand) data.Data {
+	return base.NewDecData(sdkTypes.NewDec(int64(rand.Intn(maxSimulatedPayees) + 1)))
+}
+
+// randomClaimWindow returns a claim window of at least one block
+func randomClaimWindow(rand *rand.Rand) data.Data {
+	return base.NewDecData(sdkTypes.NewDec(int64(rand.Intn(maxSimulatedClaimWindow) + 1)))
+}
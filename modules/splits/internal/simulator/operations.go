@@ -10,27 +10,587 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/AssetMantle/modules/modules/identities"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/mapper"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/outputs"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/payees"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/vestings"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/windows"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/approve"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/claim"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/distribute"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/multisend"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/reclaim"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/revokeallowance"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/send"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/transferfrom"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/unwrap"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/vest"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/wrap"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+	simulationUtilities "github.com/AssetMantle/modules/simulation"
 )
 
-func (simulator) WeightedOperations(appParams simulation.AppParams, codec *codec.Codec) simulation.WeightedOperations {
-	var weightMsg int
+func (simulator simulator) WeightedOperations(appParams simulation.AppParams, codec *codec.Codec) simulation.WeightedOperations {
+	weightedOperations := simulation.WeightedOperations{}
+
+	for _, operation := range []struct {
+		appParamsKey  string
+		defaultWeight int
+		operation     simulation.Operation
+	}{
+		{OpWeightWrapMsg, DefaultWeightWrapMsg, simulator.simulateWrapMsg(codec)},
+		{OpWeightUnwrapMsg, DefaultWeightUnwrapMsg, simulator.simulateUnwrapMsg(codec)},
+		{OpWeightSendMsg, DefaultWeightSendMsg, simulator.simulateSendMsg(codec)},
+		{OpWeightMultiSendMsg, DefaultWeightMultiSendMsg, simulator.simulateMultiSendMsg(codec)},
+		{OpWeightVestMsg, DefaultWeightVestMsg, simulator.simulateVestMsg(codec)},
+		{OpWeightApproveMsg, DefaultWeightApproveMsg, simulator.simulateApproveMsg(codec)},
+		{OpWeightRevokeAllowanceMsg, DefaultWeightRevokeAllowanceMsg, simulator.simulateRevokeAllowanceMsg(codec)},
+		{OpWeightTransferFromMsg, DefaultWeightTransferFromMsg, simulator.simulateTransferFromMsg(codec)},
+		{OpWeightDistributeMsg, DefaultWeightDistributeMsg, simulator.simulateDistributeMsg(codec)},
+		{OpWeightClaimMsg, DefaultWeightClaimMsg, simulator.simulateClaimMsg(codec)},
+		{OpWeightReclaimMsg, DefaultWeightReclaimMsg, simulator.simulateReclaimMsg(codec)},
+	} {
+		var weight int
+
+		appParams.GetOrGenerate(codec, operation.appParamsKey, &weight, nil,
+			func(_ *rand.Rand) {
+				weight = operation.defaultWeight
+			},
+		)
+
+		weightedOperations = append(weightedOperations, simulation.NewWeightedOperation(weight, operation.operation))
+	}
+
+	return weightedOperations
+}
+
+// simulateWrapMsg wraps up to a tenth of every spendable coin of an account into splits of an identity it controls, within the caps of
+// the registry, and schedules an unwrap for the next block
+func (simulator simulator) simulateWrapMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		identityIDList, err := simulationUtilities.GetIdentityIDList(context, baseApp, codec, identities.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		fromID, simulationAccount, found := simulationUtilities.RandomControlledIdentity(rand, context, simulator.authenticateAuxiliary, identityIDList, simulationAccountList)
+		if !found {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		account, err := simulationUtilities.GetAccount(context, baseApp, codec, simulationAccount.Address)
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		var coins sdkTypes.Coins
+
+		for _, coin := range account.SpendableCoins(context.BlockTime()) {
+			denom, found := utilities.GetWrapDenom(context, simulator.parameters, coin.Denom)
+			if !found {
+				continue
+			}
+
+			maximum := coin.Amount.QuoRaw(10)
+			if denom.IsCapped() {
+				maximum = sdkTypes.MinInt(maximum, denom.Cap.Sub(utilities.GetSupply(simulator.mapper.NewCollection(context), baseIDs.NewID(coin.Denom)).TruncateInt()))
+			}
+
+			if maximum.IsPositive() {
+				coins = coins.Add(sdkTypes.NewCoin(coin.Denom, simulationUtilities.RandomPositiveInt(rand, maximum)))
+			}
+		}
+
+		if coins.Empty() {
+			return simulation.NoOpMsg(module.Name), nil, nil
+		}
+
+		operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, wrap.NewSimulationMessage(simulationAccount.Address, fromID, coins))
+		if err != nil {
+			return operationMsg, nil, err
+		}
+
+		return operationMsg, []simulation.FutureOperation{{BlockHeight: int(context.BlockHeight()) + 1, Op: simulator.simulateUnwrapMsg(codec)}}, nil
+	}
+}
+
+// simulateUnwrapMsg unwraps part of a split of a wrapped coin, bounded by the coins held by the module account
+func (simulator simulator) simulateUnwrapMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		moduleCoins := simulator.supplyKeeper.GetModuleAccount(context, module.Name).GetCoins()
+		splitList := simulator.getSplitList(context)
+
+		for _, i := range rand.Perm(len(splitList)) {
+			denom := splitList[i].GetOwnableID().String()
+			if sdkTypes.ValidateDenom(denom) != nil {
+				continue
+			}
+
+			maximum := sdkTypes.MinInt(splitList[i].GetValue().TruncateInt(), moduleCoins.AmountOf(denom))
+			if !maximum.IsPositive() {
+				continue
+			}
+
+			if simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, splitList[i].GetOwnerID(), simulationAccountList); found {
+				operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, unwrap.NewSimulationMessage(
+					simulationAccount.Address,
+					baseIDs.NewID(splitList[i].GetOwnerID().String()),
+					baseIDs.NewID(denom),
+					simulationUtilities.RandomPositiveInt(rand, maximum),
+				))
+
+				return operationMsg, nil, err
+			}
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+// simulateSendMsg sends a whole number of units of a split to a random identity, or the entire split when it is smaller than a unit
+func (simulator simulator) simulateSendMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		identityIDList, err := simulationUtilities.GetIdentityIDList(context, baseApp, codec, identities.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		splitList := simulator.getSplitList(context)
+
+		for _, i := range rand.Perm(len(splitList)) {
+			if simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, splitList[i].GetOwnerID(), simulationAccountList); found {
+				operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, send.NewSimulationMessage(
+					simulationAccount.Address,
+					baseIDs.NewID(splitList[i].GetOwnerID().String()),
+					baseIDs.NewID(identityIDList[rand.Intn(len(identityIDList))].String()),
+					baseIDs.NewID(splitList[i].GetOwnableID().String()),
+					simulationUtilities.RandomSplitValue(rand, splitList[i].GetValue()),
+				))
+
+				return operationMsg, nil, err
+			}
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+// simulateMultiSendMsg pays parts of a split out to a few random identities, as many as the output cap allows, the parts adding up to at
+// most the split
+func (simulator simulator) simulateMultiSendMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		identityIDList, err := simulationUtilities.GetIdentityIDList(context, baseApp, codec, identities.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		maxOutputs := simulator.parameters.Fetch(context, outputs.ID).Get(outputs.ID).GetData().(data.DecData).Get().TruncateInt64()
+		if maxOutputs > maxSimulatedOutputs {
+			maxOutputs = maxSimulatedOutputs
+		}
+
+		splitList := simulator.getSplitList(context)
+
+		for _, i := range rand.Perm(len(splitList)) {
+			simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, splitList[i].GetOwnerID(), simulationAccountList)
+			if !found {
+				continue
+			}
+
+			var outputList []multisend.Output
+
+			remaining := splitList[i].GetValue()
 
-	appParams.GetOrGenerate(codec, OpWeightMsg, &weightMsg, nil,
-		func(_ *rand.Rand) {
-			weightMsg = DefaultWeightMsg
-		},
-	)
+			for j := rand.Int63n(maxOutputs) + 1; j > 0 && remaining.IsPositive(); j-- {
+				value := simulationUtilities.RandomSplitValue(rand, remaining)
+				outputList = append(outputList, multisend.NewOutput(baseIDs.NewID(identityIDList[rand.Intn(len(identityIDList))].String()), baseIDs.NewID(splitList[i].GetOwnableID().String()), value))
+				remaining = remaining.Sub(value)
+			}
 
-	return simulation.WeightedOperations{
-		simulation.NewWeightedOperation(
-			weightMsg,
-			simulateMsg(),
-		),
+			operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, multisend.NewSimulationMessage(
+				simulationAccount.Address,
+				baseIDs.NewID(splitList[i].GetOwnerID().String()),
+				outputList...,
+			))
+
+			return operationMsg, nil, err
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
 	}
 }
 
-func simulateMsg() simulation.Operation {
+// simulateVestMsg sends part of a split to a random identity under a schedule of a random kind that runs for a few periods of a few blocks,
+// skipping recipients the owner has already started a schedule for in the block and recipients whose split takes no more schedules
+func (simulator simulator) simulateVestMsg(codec *codec.Codec) simulation.Operation {
 	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
-		return simulation.NewOperationMsg(nil, true, ""), nil, nil
+		identityIDList, err := simulationUtilities.GetIdentityIDList(context, baseApp, codec, identities.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		maxVestings := simulator.parameters.Fetch(context, vestings.ID).Get(vestings.ID).GetData().(data.DecData).Get().TruncateInt64()
+		splitList := simulator.getSplitList(context)
+		collection := simulator.mapper.NewCollection(context)
+
+		for _, i := range rand.Perm(len(splitList)) {
+			simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, splitList[i].GetOwnerID(), simulationAccountList)
+			if !found {
+				continue
+			}
+
+			toID := baseIDs.NewID(identityIDList[rand.Intn(len(identityIDList))].String())
+
+			vestingKey := key.FromVestingID(key.NewVestingID(toID, splitList[i].GetOwnableID(), splitList[i].GetOwnerID(), context.BlockHeight()))
+			if collection.Fetch(vestingKey).Get(vestingKey) != nil {
+				continue
+			}
+
+			if int64(len(utilities.GetVestings(collection, toID, splitList[i].GetOwnableID()))) >= maxVestings {
+				continue
+			}
+
+			kind := []ids.ID{mappable.CliffVesting, mappable.LinearVesting, mappable.PeriodicVesting}[rand.Intn(3)]
+			period := int64(rand.Intn(maxSimulatedVestingPeriods) + 1)
+
+			operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, vest.NewSimulationMessage(
+				simulationAccount.Address,
+				baseIDs.NewID(splitList[i].GetOwnerID().String()),
+				toID,
+				baseIDs.NewID(splitList[i].GetOwnableID().String()),
+				simulationUtilities.RandomSplitValue(rand, splitList[i].GetValue()),
+				baseIDs.NewID(kind.String()),
+				baseTypes.NewHeight(period*int64(rand.Intn(maxSimulatedVestingPeriods)+1)),
+				period,
+			))
+
+			return operationMsg, nil, err
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
 	}
 }
+
+// simulateApproveMsg lets a random identity spend part of a split on behalf of its owner, either until revoked or for a few blocks, and
+// schedules a transfer out of the allowances for the next block
+func (simulator simulator) simulateApproveMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		identityIDList, err := simulationUtilities.GetIdentityIDList(context, baseApp, codec, identities.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		splitList := simulator.getSplitList(context)
+
+		for _, i := range rand.Perm(len(splitList)) {
+			spenderID := baseIDs.NewID(identityIDList[rand.Intn(len(identityIDList))].String())
+			if spenderID.Compare(splitList[i].GetOwnerID()) == 0 {
+				continue
+			}
+
+			simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, splitList[i].GetOwnerID(), simulationAccountList)
+			if !found {
+				continue
+			}
+
+			expiresIn := int64(-1)
+			if rand.Intn(2) == 0 {
+				expiresIn = rand.Int63n(maxSimulatedAllowanceExpiry) + 1
+			}
+
+			operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, approve.NewSimulationMessage(
+				simulationAccount.Address,
+				baseIDs.NewID(splitList[i].GetOwnerID().String()),
+				spenderID,
+				baseIDs.NewID(splitList[i].GetOwnableID().String()),
+				simulationUtilities.RandomSplitValue(rand, splitList[i].GetValue()),
+				baseTypes.NewHeight(expiresIn),
+			))
+			if err != nil {
+				return operationMsg, nil, err
+			}
+
+			return operationMsg, []simulation.FutureOperation{{BlockHeight: int(context.BlockHeight()) + 1, Op: simulator.simulateTransferFromMsg(codec)}}, nil
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+// simulateRevokeAllowanceMsg withdraws a random allowance
+func (simulator simulator) simulateRevokeAllowanceMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		allowanceList := simulator.getAllowanceList(context)
+
+		for _, i := range rand.Perm(len(allowanceList)) {
+			if simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, allowanceList[i].GetOwnerID(), simulationAccountList); found {
+				operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, revokeallowance.NewSimulationMessage(
+					simulationAccount.Address,
+					baseIDs.NewID(allowanceList[i].GetOwnerID().String()),
+					baseIDs.NewID(allowanceList[i].GetSpenderID().String()),
+					baseIDs.NewID(allowanceList[i].GetOwnableID().String()),
+				))
+
+				return operationMsg, nil, err
+			}
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+// simulateTransferFromMsg has a spender move part of a split to a random identity, bounded by both its unexpired allowance and the part of
+// the split the owner can move
+func (simulator simulator) simulateTransferFromMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		identityIDList, err := simulationUtilities.GetIdentityIDList(context, baseApp, codec, identities.Prototype().Name())
+		if err != nil {
+			return simulation.NoOpMsg(module.Name), nil, err
+		}
+
+		splitValues := make(map[string]sdkTypes.Dec)
+		for _, split := range simulator.getSplitList(context) {
+			splitValues[key.NewSplitID(split.GetOwnerID(), split.GetOwnableID()).String()] = split.GetValue()
+		}
+
+		allowanceList := simulator.getAllowanceList(context)
+
+		for _, i := range rand.Perm(len(allowanceList)) {
+			if allowanceList[i].IsExpired(baseTypes.NewHeight(context.BlockHeight())) {
+				continue
+			}
+
+			splitValue, ok := splitValues[key.NewSplitID(allowanceList[i].GetOwnerID(), allowanceList[i].GetOwnableID()).String()]
+			if !ok {
+				continue
+			}
+
+			simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, allowanceList[i].GetSpenderID(), simulationAccountList)
+			if !found {
+				continue
+			}
+
+			operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, transferfrom.NewSimulationMessage(
+				simulationAccount.Address,
+				baseIDs.NewID(allowanceList[i].GetSpenderID().String()),
+				baseIDs.NewID(allowanceList[i].GetOwnerID().String()),
+				baseIDs.NewID(identityIDList[rand.Intn(len(identityIDList))].String()),
+				baseIDs.NewID(allowanceList[i].GetOwnableID().String()),
+				simulationUtilities.RandomSplitValue(rand, sdkTypes.MinDec(splitValue, allowanceList[i].GetValue())),
+			))
+
+			return operationMsg, nil, err
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+// simulateDistributeMsg pays part of a split out to the holders of another ownable, either directly or as a claimable distribution, skipping
+// direct distributions too small to leave any holder a share and turning those to more holders than the payee cap allows claimable, and
+// schedules claims for the next block and a reclaim for once the claim window has passed
+func (simulator simulator) simulateDistributeMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		splitList := simulator.getSplitList(context)
+		collection := simulator.mapper.NewCollection(context)
+		maxPayees := simulator.parameters.Fetch(context, payees.ID).Get(payees.ID).GetData().(data.DecData).Get().TruncateInt64()
+		claimWindow := simulator.parameters.Fetch(context, windows.ID).Get(windows.ID).GetData().(data.DecData).Get().TruncateInt64()
+
+		for _, i := range rand.Perm(len(splitList)) {
+			heldOwnableID := splitList[rand.Intn(len(splitList))].GetOwnableID()
+			if heldOwnableID.Compare(splitList[i].GetOwnableID()) == 0 {
+				continue
+			}
+
+			value := simulationUtilities.RandomSplitValue(rand, splitList[i].GetValue())
+
+			claimable := rand.Intn(2) == 0
+			if !claimable {
+				if holdings, ok := utilities.GetHoldings(collection, heldOwnableID, int(maxPayees)); ok {
+					distributed := sdkTypes.ZeroDec()
+					for _, share := range utilities.GetShares(holdings, value) {
+						distributed = distributed.Add(share)
+					}
+
+					if !distributed.IsPositive() {
+						continue
+					}
+				} else {
+					claimable = true
+				}
+			}
+
+			if claimable && !utilities.GetSupply(collection, heldOwnableID).IsPositive() {
+				continue
+			}
+
+			simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, splitList[i].GetOwnerID(), simulationAccountList)
+			if !found {
+				continue
+			}
+
+			operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, distribute.NewSimulationMessage(
+				simulationAccount.Address,
+				baseIDs.NewID(splitList[i].GetOwnerID().String()),
+				baseIDs.NewID(heldOwnableID.String()),
+				baseIDs.NewID(splitList[i].GetOwnableID().String()),
+				value,
+				claimable,
+			))
+			if err != nil || !claimable {
+				return operationMsg, nil, err
+			}
+
+			return operationMsg, []simulation.FutureOperation{
+				{BlockHeight: int(context.BlockHeight()) + 1, Op: simulator.simulateClaimMsg(codec)},
+				{BlockHeight: int(context.BlockHeight() + claimWindow), Op: simulator.simulateReclaimMsg(codec)},
+			}, nil
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+// simulateClaimMsg has a current holder of the held ownable of an open distribution claim its share, skipping holders that have claimed
+// already or held nothing as of the distribution
+func (simulator simulator) simulateClaimMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		distributionList := simulator.getOpenDistributionList(context)
+		collection := simulator.mapper.NewCollection(context)
+
+		for _, i := range rand.Perm(len(distributionList)) {
+			distributionID := key.NewDistributionID(distributionList[i].GetHeldOwnableID(), distributionList[i].GetSequence())
+
+			var holderIDList []ids.ID
+
+			collection.IterateIndex(mapper.OwnableIDIndex, distributionList[i].GetHeldOwnableID().Bytes(), func(mappable helpers.Mappable) bool {
+				holderIDList = append(holderIDList, mappable.(mappables.Split).GetOwnerID())
+				return false
+			})
+
+			for _, j := range rand.Perm(len(holderIDList)) {
+				claimKey := key.FromClaimID(key.NewClaimID(holderIDList[j], distributionID))
+				if collection.Fetch(claimKey).Get(claimKey) != nil {
+					continue
+				}
+
+				if !utilities.GetShare(distributionList[i].GetValue(), utilities.GetHoldingAt(collection, holderIDList[j], distributionList[i].GetHeldOwnableID(), distributionList[i].GetSequence()), distributionList[i].GetTotal()).IsPositive() {
+					continue
+				}
+
+				if simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, holderIDList[j], simulationAccountList); found {
+					operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, claim.NewSimulationMessage(
+						simulationAccount.Address,
+						baseIDs.NewID(holderIDList[j].String()),
+						baseIDs.NewID(distributionID.String()),
+					))
+
+					return operationMsg, nil, err
+				}
+			}
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+// simulateReclaimMsg has the distributor of an open distribution whose claim window has passed take back what is left of it
+func (simulator simulator) simulateReclaimMsg(codec *codec.Codec) simulation.Operation {
+	return func(rand *rand.Rand, baseApp *baseapp.BaseApp, context sdkTypes.Context, simulationAccountList []simulation.Account, chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		distributionList := simulator.getOpenDistributionList(context)
+		claimWindow := simulator.parameters.Fetch(context, windows.ID).Get(windows.ID).GetData().(data.DecData).Get().TruncateInt64()
+
+		for _, i := range rand.Perm(len(distributionList)) {
+			if context.BlockHeight() < distributionList[i].GetHeight().Get()+claimWindow {
+				continue
+			}
+
+			if simulationAccount, found := simulationUtilities.RandomProvisionedAccount(rand, context, simulator.authenticateAuxiliary, distributionList[i].GetDistributorID(), simulationAccountList); found {
+				operationMsg, err := simulationUtilities.DeliverMessage(context, baseApp, codec, chainID, simulationAccount, reclaim.NewSimulationMessage(
+					simulationAccount.Address,
+					baseIDs.NewID(distributionList[i].GetDistributorID().String()),
+					baseIDs.NewID(key.NewDistributionID(distributionList[i].GetHeldOwnableID(), distributionList[i].GetSequence()).String()),
+				))
+
+				return operationMsg, nil, err
+			}
+		}
+
+		return simulation.NoOpMsg(module.Name), nil, nil
+	}
+}
+
+// getOpenDistributionList returns every claimable distribution whose distributor has not taken back what is left of it
+func (simulator simulator) getOpenDistributionList(context sdkTypes.Context) []mappables.Distribution {
+	var distributionList []mappables.Distribution
+
+	collection := simulator.mapper.NewCollection(context)
+
+	simulator.mapper.Iterate(context, key.DistributionPrototype(), func(mappable helpers.Mappable) bool {
+		if distribution, ok := mappable.(mappables.Distribution); ok {
+			if _, found := utilities.GetEscrow(collection, baseIDs.NewID(module.Name), key.NewDistributionID(distribution.GetHeldOwnableID(), distribution.GetSequence())); found {
+				distributionList = append(distributionList, distribution)
+			}
+		}
+
+		return false
+	})
+
+	return distributionList
+}
+
+// getAllowanceList returns every allowance
+func (simulator simulator) getAllowanceList(context sdkTypes.Context) []mappables.Allowance {
+	var allowanceList []mappables.Allowance
+
+	simulator.mapper.Iterate(context, key.AllowancePrototype(), func(mappable helpers.Mappable) bool {
+		if allowance, ok := mappable.(mappables.Allowance); ok {
+			allowanceList = append(allowanceList, allowance)
+		}
+
+		return false
+	})
+
+	return allowanceList
+}
+
+// getSplitList returns every split less the part its vesting schedules lock, leaving out the splits that are locked entirely
+func (simulator simulator) getSplitList(context sdkTypes.Context) []mappables.Split {
+	var storedSplitList []mappables.Split
+
+	simulator.mapper.Iterate(context, key.FromID(baseIDs.NewID("")), func(mappable helpers.Mappable) bool {
+		if split, ok := mappable.(mappables.Split); ok {
+			storedSplitList = append(storedSplitList, split)
+		}
+
+		return false
+	})
+
+	splitList := make([]mappables.Split, 0, len(storedSplitList))
+	collection := simulator.mapper.NewCollection(context)
+
+	for _, split := range storedSplitList {
+		if locked := utilities.GetLockedValue(collection, split.GetOwnerID(), split.GetOwnableID(), baseTypes.NewHeight(context.BlockHeight())); locked.IsPositive() {
+			if split.GetValue().LTE(locked) {
+				continue
+			}
+
+			split = split.Send(locked).(mappables.Split)
+		}
+
+		splitList = append(splitList, split)
+	}
+
+	return splitList
+}
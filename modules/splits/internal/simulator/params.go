@@ -6,22 +6,59 @@ package simulator
 import (
 	"math/rand"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
-
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"github.com/AssetMantle/modules/modules/splits/internal/common"
 	"github.com/AssetMantle/modules/modules/splits/internal/module"
-	"github.com/AssetMantle/modules/modules/splits/internal/parameters/dummy"
-	"github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/denoms"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/outputs"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/payees"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/vestings"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/windows"
 )
 
 func (simulator) ParamChangeList(_ *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
 		simulation.NewSimParamChange(module.Name,
-			dummy.ID.String(),
+			denoms.ID.String(),
+			func(r *rand.Rand) string {
+				bytes, err := common.Codec.MarshalJSON(denoms.Parameter.Mutate(randomAllowedDenoms(r)).GetData())
+				if err != nil {
+					panic(err)
+				}
+				return string(bytes)
+			}),
+		simulation.NewSimParamChange(module.Name,
+			outputs.ID.String(),
+			func(r *rand.Rand) string {
+				bytes, err := common.Codec.MarshalJSON(outputs.Parameter.Mutate(randomMaxOutputs(r)).GetData())
+				if err != nil {
+					panic(err)
+				}
+				return string(bytes)
+			}),
+		simulation.NewSimParamChange(module.Name,
+			vestings.ID.String(),
+			func(r *rand.Rand) string {
+				bytes, err := common.Codec.MarshalJSON(vestings.Parameter.Mutate(randomMaxVestings(r)).GetData())
+				if err != nil {
+					panic(err)
+				}
+				return string(bytes)
+			}),
+		simulation.NewSimParamChange(module.Name,
+			payees.ID.String(),
+			func(r *rand.Rand) string {
+				bytes, err := common.Codec.MarshalJSON(payees.Parameter.Mutate(randomMaxPayees(r)).GetData())
+				if err != nil {
+					panic(err)
+				}
+				return string(bytes)
+			}),
+		simulation.NewSimParamChange(module.Name,
+			windows.ID.String(),
 			func(r *rand.Rand) string {
-				bytes, err := common.Codec.MarshalJSON(dummy.Parameter.Mutate(base.NewDecData(sdk.NewDecWithPrec(int64(r.Intn(99)), 2))).GetData())
+				bytes, err := common.Codec.MarshalJSON(windows.Parameter.Mutate(randomClaimWindow(r)).GetData())
 				if err != nil {
 					panic(err)
 				}
@@ -0,0 +1,92 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package approve
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type transactionKeeper struct {
+	mapper                helpers.Mapper
+	parameters            helpers.Parameters
+	authenticateAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
+
+func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
+	message := messageFromInterface(msg)
+	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	if message.Value.LTE(sdkTypes.ZeroDec()) {
+		return newTransactionResponse(errors.NotAuthorized)
+	}
+
+	if message.SpenderID.Compare(message.FromID) == 0 {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
+	// allowances that expire in no positive number of blocks stay spendable until used up or revoked
+	expiry := baseTypes.NewHeight(-1)
+	if message.ExpiresIn.Get() > 0 {
+		expiry = baseTypes.NewHeight(context.BlockHeight() + message.ExpiresIn.Get())
+	}
+
+	splits := transactionKeeper.mapper.NewCollection(context)
+
+	// approving again replaces the allowance instead of adding to it
+	allowance := mappable.NewAllowance(message.FromID, message.SpenderID, message.OwnableID, message.Value, expiry)
+	if _, found := utilities.GetAllowance(splits, message.FromID, message.SpenderID, message.OwnableID); found {
+		splits.Mutate(allowance)
+	} else {
+		splits.Add(allowance)
+	}
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.AllowanceApproved,
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, message.FromID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeySpenderID, message.SpenderID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, message.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, message.Value.String()),
+		),
+	)
+
+	return newTransactionResponse(nil)
+}
+
+func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	transactionKeeper.mapper, transactionKeeper.parameters = mapper, parameters
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case supply.Keeper:
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case authenticate.Auxiliary.GetName():
+				transactionKeeper.authenticateAuxiliary = value
+			default:
+				break
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return transactionKeeper
+}
+func keeperPrototype() helpers.TransactionKeeper {
+	return transactionKeeper{}
+}
@@ -0,0 +1,129 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package approve
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type TestKeepers struct {
+	SplitsKeeper helpers.TransactionKeeper
+}
+
+func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	Mapper := baseHelpers.NewMapper(key.Prototype, mappable.Prototype).Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test"))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+		Height:  10,
+	}, false, log.NewNopLogger())
+
+	authenticateAuxiliary := authenticate.AuxiliaryMock.Initialize(Mapper, Parameters)
+	keepers := TestKeepers{
+		SplitsKeeper: keeperPrototype().Initialize(Mapper, Parameters, []interface{}{authenticateAuxiliary}).(helpers.TransactionKeeper),
+	}
+
+	return context, keepers
+}
+
+func Test_transactionKeeper_Transact(t *testing.T) {
+	context, keepers := CreateTestInput(t)
+	defaultAddr := sdkTypes.AccAddress("addr")
+	verifyMockErrorAddress := sdkTypes.AccAddress("verifyError")
+
+	fromID := baseIDs.NewID("fromID")
+	spenderID := baseIDs.NewID("spenderID")
+	ownableID := baseIDs.NewID("stake")
+	splits := keepers.SplitsKeeper.(transactionKeeper).mapper.NewCollection(context)
+
+	t.Run("PositiveCase", func(t *testing.T) {
+		want := newTransactionResponse(nil)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, spenderID, ownableID, sdkTypes.NewDec(40), baseTypes.NewHeight(20))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		allowance, found := utilities.GetAllowance(splits, fromID, spenderID, ownableID)
+		require.Equal(t, true, found)
+		require.Equal(t, mappable.NewAllowance(fromID, spenderID, ownableID, sdkTypes.NewDec(40), baseTypes.NewHeight(30)), allowance)
+	})
+
+	t.Run("PositiveCase-Approve Again Without Expiry", func(t *testing.T) {
+		want := newTransactionResponse(nil)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, spenderID, ownableID, sdkTypes.NewDec(10), baseTypes.NewHeight(-1))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		allowance, _ := utilities.GetAllowance(splits, fromID, spenderID, ownableID)
+		require.Equal(t, mappable.NewAllowance(fromID, spenderID, ownableID, sdkTypes.NewDec(10), baseTypes.NewHeight(-1)), allowance)
+	})
+
+	t.Run("NegativeCase-Verify Identity Failure", func(t *testing.T) {
+		want := newTransactionResponse(errors.MockError)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(verifyMockErrorAddress, fromID, spenderID, ownableID, sdkTypes.NewDec(1), baseTypes.NewHeight(-1))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Approve Zero Value", func(t *testing.T) {
+		want := newTransactionResponse(errors.NotAuthorized)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, spenderID, ownableID, sdkTypes.ZeroDec(), baseTypes.NewHeight(-1))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Approve Self", func(t *testing.T) {
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, fromID, ownableID, sdkTypes.NewDec(1), baseTypes.NewHeight(-1))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+}
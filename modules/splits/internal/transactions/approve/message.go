@@ -0,0 +1,79 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package approve
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/types"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+	"github.com/AssetMantle/modules/utilities/transaction"
+)
+
+type message struct {
+	From      sdkTypes.AccAddress `json:"from" valid:"required~required field from missing"`
+	FromID    ids.ID              `json:"fromID" valid:"required~required field fromID missing"`
+	SpenderID ids.ID              `json:"spenderID" valid:"required~required field spenderID missing"`
+	OwnableID ids.ID              `json:"ownableID" valid:"required~required field ownableID missing"`
+	Value     sdkTypes.Dec        `json:"value" valid:"required~required field value missing"`
+	ExpiresIn types.Height        `json:"expiresIn" valid:"required~required field expiresIn missing"`
+}
+
+var _ sdkTypes.Msg = message{}
+
+func (message message) Route() string { return module.Name }
+func (message message) Type() string  { return Transaction.GetName() }
+func (message message) ValidateBasic() error {
+	var _, Error = govalidator.ValidateStruct(message)
+	if Error != nil {
+		return sdkErrors.Wrap(errors.IncorrectMessage, Error.Error())
+	}
+
+	return nil
+}
+func (message message) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(transaction.RegisterCodec(messagePrototype).MustMarshalJSON(message))
+}
+func (message message) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{message.From}
+}
+func (message) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, message{})
+}
+func messageFromInterface(msg sdkTypes.Msg) message {
+	switch value := msg.(type) {
+	case message:
+		return value
+	default:
+		return message{}
+	}
+}
+func messagePrototype() helpers.Message {
+	return message{}
+}
+
+// newMessage creates a message that lets the spender move up to the value of the split of the ownable on behalf of the sender, for the
+// given number of blocks or until revoked if that number is not positive
+func newMessage(from sdkTypes.AccAddress, fromID ids.ID, spenderID ids.ID, ownableID ids.ID, value sdkTypes.Dec, expiresIn types.Height) sdkTypes.Msg {
+	return message{
+		From:      from,
+		FromID:    fromID,
+		SpenderID: spenderID,
+		OwnableID: ownableID,
+		Value:     value,
+		ExpiresIn: expiresIn,
+	}
+}
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, spenderID ids.ID, ownableID ids.ID, value sdkTypes.Dec, expiresIn types.Height) sdkTypes.Msg {
+	return newMessage(from, fromID, spenderID, ownableID, value, expiresIn)
+}
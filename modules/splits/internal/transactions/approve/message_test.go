@@ -0,0 +1,40 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package approve
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+	"github.com/AssetMantle/modules/utilities/transaction"
+)
+
+func Test_Approve_Message(t *testing.T) {
+	testFromID := baseIDs.NewID("fromID")
+	testSpenderID := baseIDs.NewID("spenderID")
+	testOwnableID := baseIDs.NewID("ownableID")
+	testValue := sdkTypes.NewDec(2)
+	testExpiresIn := baseTypes.NewHeight(10)
+
+	fromAddress := "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c"
+	fromAccAddress, err := sdkTypes.AccAddressFromBech32(fromAddress)
+	require.Nil(t, err)
+
+	testMessage := newMessage(fromAccAddress, testFromID, testSpenderID, testOwnableID, testValue, testExpiresIn)
+	require.Equal(t, message{From: fromAccAddress, FromID: testFromID, SpenderID: testSpenderID, OwnableID: testOwnableID, Value: testValue, ExpiresIn: testExpiresIn}, testMessage)
+	require.Equal(t, module.Name, testMessage.Route())
+	require.Equal(t, Transaction.GetName(), testMessage.Type())
+	require.Equal(t, nil, testMessage.ValidateBasic())
+	require.NotNil(t, message{}.ValidateBasic())
+	require.Equal(t, sdkTypes.MustSortJSON(transaction.RegisterCodec(messagePrototype).MustMarshalJSON(testMessage)), testMessage.GetSignBytes())
+	require.Equal(t, []sdkTypes.AccAddress{fromAccAddress}, testMessage.GetSigners())
+	require.Equal(t, testMessage, messageFromInterface(testMessage))
+	require.Equal(t, message{}, messageFromInterface(nil))
+	require.Equal(t, message{}, messagePrototype())
+}
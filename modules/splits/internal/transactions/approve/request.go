@@ -0,0 +1,102 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package approve
+
+import (
+	"encoding/json"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+type transactionRequest struct {
+	BaseReq   rest.BaseReq `json:"baseReq"`
+	FromID    string       `json:"fromID" valid:"required~required field fromID missing, matches(^[A-Za-z0-9-_=.|]+$)~invalid field fromID"`
+	SpenderID string       `json:"spenderID" valid:"required~required field spenderID missing, matches(^[A-Za-z0-9-_=.|]+$)~invalid field spenderID"`
+	OwnableID string       `json:"ownableID" valid:"required~required field ownableID missing, matches(^[A-Za-z0-9-_=.|]+$)~invalid field ownableID"`
+	Value     string       `json:"value" valid:"required~required field value missing, matches(^[0-9.]+$)~invalid field value"`
+	ExpiresIn int64        `json:"expiresIn" valid:"matches(^-?[0-9]+$)~invalid field expiresIn"`
+}
+
+var _ helpers.TransactionRequest = (*transactionRequest)(nil)
+
+// Validate godoc
+// @Summary Approve split transaction
+// @Description Approve split transaction
+// @Accept text/plain
+// @Produce json
+// @Tags Splits
+// @Param body body  transactionRequest true "Request body to let a spender move split on the owner's behalf"
+// @Success 200 {object} transactionResponse   "Message for a successful response."
+// @Failure default  {object}  transactionResponse "Message for an unexpected error response."
+// @Router /splits/approve [post]
+func (transactionRequest transactionRequest) Validate() error {
+	_, err := govalidator.ValidateStruct(transactionRequest)
+	return err
+}
+func (transactionRequest transactionRequest) FromCLI(cliCommand helpers.CLICommand, cliContext context.CLIContext) (helpers.TransactionRequest, error) {
+	return newTransactionRequest(
+		cliCommand.ReadBaseReq(cliContext),
+		cliCommand.ReadString(constants.FromID),
+		cliCommand.ReadString(constants.SpenderID),
+		cliCommand.ReadString(constants.OwnableID),
+		cliCommand.ReadString(constants.Value),
+		cliCommand.ReadInt64(constants.ExpiresIn),
+	), nil
+}
+func (transactionRequest transactionRequest) FromJSON(rawMessage json.RawMessage) (helpers.TransactionRequest, error) {
+	if err := json.Unmarshal(rawMessage, &transactionRequest); err != nil {
+		return nil, err
+	}
+
+	return transactionRequest, nil
+}
+func (transactionRequest transactionRequest) GetBaseReq() rest.BaseReq {
+	return transactionRequest.BaseReq
+}
+func (transactionRequest transactionRequest) MakeMsg() (sdkTypes.Msg, error) {
+	from, err := sdkTypes.AccAddressFromBech32(transactionRequest.GetBaseReq().From)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := sdkTypes.NewDecFromStr(transactionRequest.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMessage(
+		from,
+		baseIDs.NewID(transactionRequest.FromID),
+		baseIDs.NewID(transactionRequest.SpenderID),
+		baseIDs.NewID(transactionRequest.OwnableID),
+		value,
+		baseTypes.NewHeight(transactionRequest.ExpiresIn),
+	), nil
+}
+func (transactionRequest) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, transactionRequest{})
+}
+func requestPrototype() helpers.TransactionRequest {
+	return transactionRequest{}
+}
+func newTransactionRequest(baseReq rest.BaseReq, fromID string, spenderID string, ownableID string, value string, expiresIn int64) helpers.TransactionRequest {
+	return transactionRequest{
+		BaseReq:   baseReq,
+		FromID:    fromID,
+		SpenderID: spenderID,
+		OwnableID: ownableID,
+		Value:     value,
+		ExpiresIn: expiresIn,
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package approve
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+func Test_Approve_Request(t *testing.T) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+	cliCommand := baseHelpers.NewCLICommand("", "", "", []helpers.CLIFlag{constants.FromID, constants.SpenderID, constants.OwnableID, constants.Value, constants.ExpiresIn})
+	cliContext := context.NewCLIContext().WithCodec(Codec)
+
+	fromAddress := "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c"
+	fromAccAddress, err := sdkTypes.AccAddressFromBech32(fromAddress)
+	require.Nil(t, err)
+
+	testBaseReq := rest.BaseReq{From: fromAddress, ChainID: "test", Fees: sdkTypes.NewCoins()}
+	testTransactionRequest := newTransactionRequest(testBaseReq, "fromID", "spenderID", "ownableID", "2", -1)
+
+	require.Equal(t, transactionRequest{BaseReq: testBaseReq, FromID: "fromID", SpenderID: "spenderID", OwnableID: "ownableID", Value: "2", ExpiresIn: -1}, testTransactionRequest)
+	require.Equal(t, nil, testTransactionRequest.Validate())
+
+	requestFromCLI, err := transactionRequest{}.FromCLI(cliCommand, cliContext)
+	require.Equal(t, nil, err)
+	require.Equal(t, transactionRequest{BaseReq: rest.BaseReq{From: cliContext.GetFromAddress().String(), ChainID: cliContext.ChainID, Simulate: cliContext.Simulate}, FromID: "", SpenderID: "", OwnableID: "", Value: "", ExpiresIn: 0}, requestFromCLI)
+
+	jsonMessage, _ := json.Marshal(testTransactionRequest)
+	transactionRequestUnmarshalled, err := transactionRequest{}.FromJSON(jsonMessage)
+	require.Equal(t, nil, err)
+	require.Equal(t, testTransactionRequest, transactionRequestUnmarshalled)
+
+	randomUnmarshall, err := transactionRequest{}.FromJSON([]byte{})
+	require.Equal(t, nil, randomUnmarshall)
+	require.NotNil(t, err)
+
+	require.Equal(t, testBaseReq, testTransactionRequest.GetBaseReq())
+
+	msg, err := testTransactionRequest.MakeMsg()
+	require.Equal(t, newMessage(fromAccAddress, baseIDs.NewID("fromID"), baseIDs.NewID("spenderID"), baseIDs.NewID("ownableID"), sdkTypes.NewDec(2), baseTypes.NewHeight(-1)), msg)
+	require.Nil(t, err)
+
+	msg2, err := newTransactionRequest(rest.BaseReq{From: "randomFromAddress", ChainID: "test"}, "fromID", "spenderID", "ownableID", "2", -1).MakeMsg()
+	require.NotNil(t, err)
+	require.Nil(t, msg2)
+
+	msg2, err = newTransactionRequest(testBaseReq, "fromID", "spenderID", "ownableID", "randomString", -1).MakeMsg()
+	require.NotNil(t, err)
+	require.Nil(t, msg2)
+
+	require.Equal(t, transactionRequest{}, requestPrototype())
+	require.NotPanics(t, func() {
+		requestPrototype().RegisterCodec(codec.New())
+	})
+}
@@ -0,0 +1,24 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package approve
+
+import (
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+)
+
+var Transaction = baseHelpers.NewTransaction(
+	"approve",
+	"",
+	"",
+
+	requestPrototype,
+	messagePrototype,
+	keeperPrototype,
+	constants.FromID,
+	constants.SpenderID,
+	constants.OwnableID,
+	constants.Value,
+	constants.ExpiresIn,
+)
@@ -0,0 +1,72 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package claim
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+	"github.com/AssetMantle/modules/utilities/transaction"
+)
+
+type message struct {
+	From           sdkTypes.AccAddress `json:"from" valid:"required~required field from missing"`
+	FromID         ids.ID              `json:"fromID" valid:"required~required field fromID missing"`
+	DistributionID ids.ID              `json:"distributionID" valid:"required~required field distributionID missing"`
+}
+
+var _ sdkTypes.Msg = message{}
+
+func (message message) Route() string { return module.Name }
+func (message message) Type() string  { return Transaction.GetName() }
+func (message message) ValidateBasic() error {
+	var _, Error = govalidator.ValidateStruct(message)
+	if Error != nil {
+		return sdkErrors.Wrap(errors.IncorrectMessage, Error.Error())
+	}
+
+	return nil
+}
+func (message message) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(transaction.RegisterCodec(messagePrototype).MustMarshalJSON(message))
+}
+func (message message) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{message.From}
+}
+func (message) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, message{})
+}
+func messageFromInterface(msg sdkTypes.Msg) message {
+	switch value := msg.(type) {
+	case message:
+		return value
+	default:
+		return message{}
+	}
+}
+func messagePrototype() helpers.Message {
+	return message{}
+}
+
+// newMessage creates a message that pays the sender the share of the claimable distribution its holding as of the distribution makes up,
+// out of the escrow of the distribution
+func newMessage(from sdkTypes.AccAddress, fromID ids.ID, distributionID ids.ID) sdkTypes.Msg {
+	return message{
+		From:           from,
+		FromID:         fromID,
+		DistributionID: distributionID,
+	}
+}
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, distributionID ids.ID) sdkTypes.Msg {
+	return newMessage(from, fromID, distributionID)
+}
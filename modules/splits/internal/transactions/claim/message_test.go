@@ -0,0 +1,37 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package claim
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/utilities/transaction"
+)
+
+func Test_Claim_Message(t *testing.T) {
+	testFromID := baseIDs.NewID("fromID")
+	testDistributionID := baseIDs.NewID(key.NewDistributionID(baseIDs.NewID("heldOwnableID"), 10).String())
+
+	fromAddress := "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c"
+	fromAccAddress, err := sdkTypes.AccAddressFromBech32(fromAddress)
+	require.Nil(t, err)
+
+	testMessage := newMessage(fromAccAddress, testFromID, testDistributionID)
+	require.Equal(t, message{From: fromAccAddress, FromID: testFromID, DistributionID: testDistributionID}, testMessage)
+	require.Equal(t, module.Name, testMessage.Route())
+	require.Equal(t, Transaction.GetName(), testMessage.Type())
+	require.Equal(t, nil, testMessage.ValidateBasic())
+	require.NotNil(t, message{}.ValidateBasic())
+	require.Equal(t, sdkTypes.MustSortJSON(transaction.RegisterCodec(messagePrototype).MustMarshalJSON(testMessage)), testMessage.GetSignBytes())
+	require.Equal(t, []sdkTypes.AccAddress{fromAccAddress}, testMessage.GetSigners())
+	require.Equal(t, testMessage, messageFromInterface(testMessage))
+	require.Equal(t, message{}, messageFromInterface(nil))
+	require.Equal(t, message{}, messagePrototype())
+}
@@ -0,0 +1,84 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package claim
+
+import (
+	"encoding/json"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+type transactionRequest struct {
+	BaseReq        rest.BaseReq `json:"baseReq"`
+	FromID         string       `json:"fromID" valid:"required~required field fromID missing, matches(^[A-Za-z0-9-_=.|]+$)~invalid field fromID"`
+	DistributionID string       `json:"distributionID" valid:"required~required field distributionID missing, matches(^[A-Za-z0-9-_=.|*]+$)~invalid field distributionID"`
+}
+
+var _ helpers.TransactionRequest = (*transactionRequest)(nil)
+
+// Validate godoc
+// @Summary Claim split transaction
+// @Description Claim split transaction
+// @Accept text/plain
+// @Produce json
+// @Tags Splits
+// @Param body body  transactionRequest true "Request body to claim the share of a claimable distribution"
+// @Success 200 {object} transactionResponse   "Message for a successful response."
+// @Failure default  {object}  transactionResponse "Message for an unexpected error response."
+// @Router /splits/claim [post]
+func (transactionRequest transactionRequest) Validate() error {
+	_, err := govalidator.ValidateStruct(transactionRequest)
+	return err
+}
+func (transactionRequest transactionRequest) FromCLI(cliCommand helpers.CLICommand, cliContext context.CLIContext) (helpers.TransactionRequest, error) {
+	return newTransactionRequest(
+		cliCommand.ReadBaseReq(cliContext),
+		cliCommand.ReadString(constants.FromID),
+		cliCommand.ReadString(constants.DistributionID),
+	), nil
+}
+func (transactionRequest transactionRequest) FromJSON(rawMessage json.RawMessage) (helpers.TransactionRequest, error) {
+	if err := json.Unmarshal(rawMessage, &transactionRequest); err != nil {
+		return nil, err
+	}
+
+	return transactionRequest, nil
+}
+func (transactionRequest transactionRequest) GetBaseReq() rest.BaseReq {
+	return transactionRequest.BaseReq
+}
+func (transactionRequest transactionRequest) MakeMsg() (sdkTypes.Msg, error) {
+	from, err := sdkTypes.AccAddressFromBech32(transactionRequest.GetBaseReq().From)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMessage(
+		from,
+		baseIDs.NewID(transactionRequest.FromID),
+		baseIDs.NewID(transactionRequest.DistributionID),
+	), nil
+}
+func (transactionRequest) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, transactionRequest{})
+}
+func requestPrototype() helpers.TransactionRequest {
+	return transactionRequest{}
+}
+func newTransactionRequest(baseReq rest.BaseReq, fromID string, distributionID string) helpers.TransactionRequest {
+	return transactionRequest{
+		BaseReq:        baseReq,
+		FromID:         fromID,
+		DistributionID: distributionID,
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package distribute
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/payees"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/types"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type transactionKeeper struct {
+	mapper                helpers.Mapper
+	parameters            helpers.Parameters
+	authenticateAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
+
+func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
+	message := messageFromInterface(msg)
+	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	if message.Value.LTE(sdkTypes.ZeroDec()) {
+		return newTransactionResponse(errors.NotAuthorized)
+	}
+
+	if message.HeldOwnableID.Compare(message.OwnableID) == 0 {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
+	height := baseTypes.NewHeight(context.BlockHeight())
+	splits := transactionKeeper.mapper.NewCollection(context)
+
+	if !message.Claimable {
+		return transactionKeeper.payOut(context, splits, message, height)
+	}
+
+	// claimable distributions only snapshot the total held and keep the value in escrow under the module, every holder claims its share
+	// in a transaction of its own out of its holding as of the distribution
+	total := utilities.GetSupply(splits, message.HeldOwnableID)
+	if !total.IsPositive() {
+		return newTransactionResponse(errors.EntityNotFound)
+	}
+
+	sequence := int64(1)
+	if latestDistribution, found := utilities.GetLatestDistribution(splits, message.HeldOwnableID); found {
+		sequence = latestDistribution.GetSequence() + 1
+	}
+
+	distributionID := key.NewDistributionID(message.HeldOwnableID, sequence)
+
+	if _, err := utilities.LockEscrow(splits, baseIDs.NewID(module.Name), distributionID, message.FromID, message.OwnableID, message.Value, height); err != nil {
+		return newTransactionResponse(err)
+	}
+
+	splits.Add(mappable.NewDistribution(message.HeldOwnableID, sequence, message.FromID, message.OwnableID, message.Value, total, height))
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitDistributed,
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, message.FromID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyHeldOwnableID, message.HeldOwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, message.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, message.Value.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyReferenceID, distributionID.String()),
+		),
+	)
+
+	return newTransactionResponse(nil)
+}
+
+// payOut pays the shares of the holders out to them right away, which is bounded by the number of splits and escrows a distribution paid
+// out directly can cover
+func (transactionKeeper transactionKeeper) payOut(context sdkTypes.Context, splits helpers.Collection, message message, height types.Height) helpers.TransactionResponse {
+	holdings, ok := utilities.GetHoldings(splits, message.HeldOwnableID, int(transactionKeeper.parameters.Fetch(context, payees.ID).Get(payees.ID).GetData().(data.DecData).Get().TruncateInt64()))
+	if !ok {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
+	if len(holdings) == 0 {
+		return newTransactionResponse(errors.EntityNotFound)
+	}
+
+	shares := utilities.GetShares(holdings, message.Value)
+
+	// shares are rounded down, so only their sum is taken from the sender and the remainder of the value stays with it
+	distributed := sdkTypes.ZeroDec()
+	for _, share := range shares {
+		distributed = distributed.Add(share)
+	}
+
+	if !distributed.IsPositive() {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
+	if _, err := utilities.SubtractUnlockedSplits(splits, message.FromID, message.OwnableID, distributed, height); err != nil {
+		return newTransactionResponse(err)
+	}
+
+	for i, holding := range holdings {
+		if !shares[i].IsPositive() {
+			continue
+		}
+
+		if _, err := utilities.AddSplits(splits, holding.GetOwnerID(), message.OwnableID, shares[i]); err != nil {
+			return newTransactionResponse(err)
+		}
+	}
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitDistributed,
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, message.FromID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyHeldOwnableID, message.HeldOwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, message.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, distributed.String()),
+		),
+	)
+
+	return newTransactionResponse(nil)
+}
+
+func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	transactionKeeper.mapper, transactionKeeper.parameters = mapper, parameters
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case supply.Keeper:
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case authenticate.Auxiliary.GetName():
+				transactionKeeper.authenticateAuxiliary = value
+			default:
+				break
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return transactionKeeper
+}
+func keeperPrototype() helpers.TransactionKeeper {
+	return transactionKeeper{}
+}
@@ -0,0 +1,231 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package distribute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/mapper"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/payees"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type TestKeepers struct {
+	SplitsKeeper helpers.TransactionKeeper
+}
+
+func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test").WithKeyTable(parameters.Prototype().GetKeyTable()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+		Height:  10,
+	}, false, log.NewNopLogger())
+
+	for _, parameter := range Parameters.GetList() {
+		Parameters.Mutate(context, parameter)
+	}
+
+	authenticateAuxiliary := authenticate.AuxiliaryMock.Initialize(Mapper, Parameters)
+	keepers := TestKeepers{
+		SplitsKeeper: keeperPrototype().Initialize(Mapper, Parameters, []interface{}{authenticateAuxiliary}).(helpers.TransactionKeeper),
+	}
+
+	return context, keepers
+}
+
+func Test_transactionKeeper_Transact(t *testing.T) {
+	context, keepers := CreateTestInput(t)
+	defaultAddr := sdkTypes.AccAddress("addr")
+	verifyMockErrorAddress := sdkTypes.AccAddress("verifyError")
+
+	fromID := baseIDs.NewID("fromID")
+	holderID1 := baseIDs.NewID("holderID1")
+	holderID2 := baseIDs.NewID("holderID2")
+	holderID3 := baseIDs.NewID("holderID3")
+	heldOwnableID := baseIDs.NewID("property")
+	ownableID := baseIDs.NewID("stake")
+	splits := keepers.SplitsKeeper.(transactionKeeper).mapper.NewCollection(context)
+
+	splits.Add(mappable.NewSplit(key.NewSplitID(fromID, ownableID), sdkTypes.NewDec(1000)))
+	splits.Add(mappable.NewSplit(key.NewSplitID(holderID1, heldOwnableID), sdkTypes.NewDec(1)))
+	splits.Add(mappable.NewSplit(key.NewSplitID(holderID2, heldOwnableID), sdkTypes.NewDec(1)))
+	splits.Add(mappable.NewSplit(key.NewSplitID(holderID3, heldOwnableID), sdkTypes.NewDec(1)))
+	// holders of ownables that merely start with the same bytes are left out of the snapshot
+	splits.Add(mappable.NewSplit(key.NewSplitID(fromID, baseIDs.NewID("property2")), sdkTypes.NewDec(1)))
+	_, err := utilities.IncreaseSupply(splits, heldOwnableID, sdkTypes.NewDec(3))
+	require.Nil(t, err)
+
+	t.Run("PositiveCase", func(t *testing.T) {
+		want := newTransactionResponse(nil)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, heldOwnableID, ownableID, sdkTypes.NewDec(100), false)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		share := sdkTypes.NewDec(100).QuoTruncate(sdkTypes.NewDec(3))
+		for _, holderID := range []ids.ID{holderID1, holderID2, holderID3} {
+			require.Equal(t, share, splits.Fetch(key.FromID(key.NewSplitID(holderID, ownableID))).Get(key.FromID(key.NewSplitID(holderID, ownableID))).(mappables.Split).GetValue())
+		}
+
+		// the remainder of the rounded down shares stays with the sender
+		require.Equal(t, sdkTypes.NewDec(900).Add(sdkTypes.NewDec(100).Sub(share.MulInt64(3))), splits.Fetch(key.FromID(key.NewSplitID(fromID, ownableID))).Get(key.FromID(key.NewSplitID(fromID, ownableID))).(mappables.Split).GetValue())
+	})
+
+	t.Run("PositiveCase-Claimable", func(t *testing.T) {
+		want := newTransactionResponse(nil)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, heldOwnableID, ownableID, sdkTypes.NewDec(30), true)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		distributionID := key.NewDistributionID(heldOwnableID, 1)
+
+		escrow, found := utilities.GetEscrow(splits, baseIDs.NewID(module.Name), distributionID)
+		require.Equal(t, true, found)
+		require.Equal(t, sdkTypes.NewDec(30), escrow.GetValue())
+
+		distribution, found := utilities.GetDistribution(splits, distributionID)
+		require.Equal(t, true, found)
+		require.Equal(t, mappable.NewDistribution(heldOwnableID, 1, fromID, ownableID, sdkTypes.NewDec(30), sdkTypes.NewDec(3), baseTypes.NewHeight(context.BlockHeight())), distribution)
+
+		// shares are left to be worked out as they are claimed
+		claimCount := 0
+		splits.Iterate(key.ClaimPrototype(), func(helpers.Mappable) bool {
+			claimCount++
+			return false
+		})
+		require.Equal(t, 0, claimCount)
+	})
+
+	t.Run("PositiveCase-Claimable Next Sequence", func(t *testing.T) {
+		want := newTransactionResponse(nil)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, heldOwnableID, ownableID, sdkTypes.NewDec(30), true)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		latestDistribution, found := utilities.GetLatestDistribution(splits, heldOwnableID)
+		require.Equal(t, true, found)
+		require.Equal(t, int64(2), latestDistribution.GetSequence())
+
+		_, found = utilities.GetEscrow(splits, baseIDs.NewID(module.Name), key.NewDistributionID(heldOwnableID, 2))
+		require.Equal(t, true, found)
+	})
+
+	t.Run("NegativeCase-Claimable Without Supply", func(t *testing.T) {
+		want := newTransactionResponse(errors.EntityNotFound)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, baseIDs.NewID("unheld"), ownableID, sdkTypes.NewDec(30), true)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("PositiveCase-Escrowed Holdings", func(t *testing.T) {
+		// the shares of holdings in escrow go to the owners of the escrows
+		_, err := utilities.LockEscrow(splits, baseIDs.NewID("orders"), baseIDs.NewID("orderID"), holderID1, heldOwnableID, sdkTypes.NewDec(1), baseTypes.NewHeight(context.BlockHeight()))
+		require.Nil(t, err)
+
+		holdings, ok := utilities.GetHoldings(splits, heldOwnableID, 100)
+		require.Equal(t, true, ok)
+		require.Equal(t, 3, len(holdings))
+		require.Equal(t, holderID1, holdings[0].GetOwnerID())
+		require.Equal(t, sdkTypes.NewDec(1), holdings[0].GetValue())
+		require.Equal(t, sdkTypes.NewDec(1), utilities.GetHolding(splits, holderID1, heldOwnableID))
+		require.Equal(t, true, utilities.GetHolding(splits, baseIDs.NewID("orders"), heldOwnableID).IsZero())
+	})
+
+	t.Run("NegativeCase-Too Many Payees", func(t *testing.T) {
+		// three splits and an escrow hold the ownable, which is more than a direct distribution can cover
+		Parameters := keepers.SplitsKeeper.(transactionKeeper).parameters
+		Parameters.Mutate(context, payees.Parameter.Mutate(baseData.NewDecData(sdkTypes.NewDec(3))))
+
+		defer Parameters.Mutate(context, payees.Parameter)
+
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, heldOwnableID, ownableID, sdkTypes.NewDec(1), false)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Verify Identity Failure", func(t *testing.T) {
+		want := newTransactionResponse(errors.MockError)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(verifyMockErrorAddress, fromID, heldOwnableID, ownableID, sdkTypes.NewDec(1), false)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-No Holders", func(t *testing.T) {
+		want := newTransactionResponse(errors.EntityNotFound)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, baseIDs.NewID("unheld"), ownableID, sdkTypes.NewDec(1), false)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Distribute Held Ownable", func(t *testing.T) {
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, heldOwnableID, heldOwnableID, sdkTypes.NewDec(1), false)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Value Too Small To Share", func(t *testing.T) {
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, heldOwnableID, ownableID, sdkTypes.SmallestDec(), false)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Distribute More than available splits", func(t *testing.T) {
+		want := newTransactionResponse(errors.NotAuthorized)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, heldOwnableID, ownableID, sdkTypes.NewDec(3000), false)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package multisend
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/outputs"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type transactionKeeper struct {
+	mapper                helpers.Mapper
+	parameters            helpers.Parameters
+	authenticateAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
+
+func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
+	message := messageFromInterface(msg)
+	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	if len(message.Outputs) == 0 || int64(len(message.Outputs)) > transactionKeeper.parameters.Fetch(context, outputs.ID).Get(outputs.ID).GetData().(data.DecData).Get().TruncateInt64() {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
+	// the split of every ownable is debited once by the total it pays out, so that the message is refused as a whole when the sender
+	// cannot cover all of its outputs
+	var ownableIDList []ids.ID
+
+	totals := make(map[string]sdkTypes.Dec)
+
+	for _, output := range message.Outputs {
+		if !output.Value.IsPositive() {
+			return newTransactionResponse(errors.NotAuthorized)
+		}
+
+		total, ok := totals[output.OwnableID.String()]
+		if !ok {
+			ownableIDList = append(ownableIDList, output.OwnableID)
+			total = sdkTypes.ZeroDec()
+		}
+
+		totals[output.OwnableID.String()] = total.Add(output.Value)
+	}
+
+	splits := transactionKeeper.mapper.NewCollection(context)
+
+	for _, ownableID := range ownableIDList {
+		if _, err := utilities.SubtractUnlockedSplits(splits, message.FromID, ownableID, totals[ownableID.String()], baseTypes.NewHeight(context.BlockHeight())); err != nil {
+			return newTransactionResponse(err)
+		}
+	}
+
+	for _, output := range message.Outputs {
+		if _, err := utilities.AddSplits(splits, output.ToID, output.OwnableID, output.Value); err != nil {
+			return newTransactionResponse(err)
+		}
+
+		context.EventManager().EmitEvent(
+			sdkTypes.NewEvent(
+				events.SplitTransferred,
+				sdkTypes.NewAttribute(events.AttributeKeyFromID, message.FromID.String()),
+				sdkTypes.NewAttribute(events.AttributeKeyToID, output.ToID.String()),
+				sdkTypes.NewAttribute(events.AttributeKeyOwnableID, output.OwnableID.String()),
+				sdkTypes.NewAttribute(events.AttributeKeyValue, output.Value.String()),
+			),
+		)
+	}
+
+	return newTransactionResponse(nil)
+}
+
+func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	transactionKeeper.mapper, transactionKeeper.parameters = mapper, parameters
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case supply.Keeper:
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case authenticate.Auxiliary.GetName():
+				transactionKeeper.authenticateAuxiliary = value
+			default:
+				break
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return transactionKeeper
+}
+func keeperPrototype() helpers.TransactionKeeper {
+	return transactionKeeper{}
+}
@@ -0,0 +1,158 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package multisend
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/outputs"
+	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+type TestKeepers struct {
+	SplitsKeeper helpers.TransactionKeeper
+}
+
+func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	Mapper := baseHelpers.NewMapper(key.Prototype, mappable.Prototype).Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test").WithKeyTable(parameters.Prototype().GetKeyTable()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+	Parameters.Mutate(context, outputs.Parameter.Mutate(baseData.NewDecData(sdkTypes.NewDec(3))))
+
+	authenticateAuxiliary := authenticate.AuxiliaryMock.Initialize(Mapper, Parameters)
+	keepers := TestKeepers{
+		SplitsKeeper: keeperPrototype().Initialize(Mapper, Parameters, []interface{}{authenticateAuxiliary}).(helpers.TransactionKeeper),
+	}
+
+	return context, keepers
+}
+
+func Test_transactionKeeper_Transact(t *testing.T) {
+	context, keepers := CreateTestInput(t)
+	defaultAddr := sdkTypes.AccAddress("addr")
+	verifyMockErrorAddress := sdkTypes.AccAddress("verifyError")
+
+	fromID := baseIDs.NewID("fromID")
+	toID := baseIDs.NewID("toID")
+	toID2 := baseIDs.NewID("toID2")
+	ownableID := baseIDs.NewID("stake")
+	ownableID2 := baseIDs.NewID("ownableID2")
+
+	keepers.SplitsKeeper.(transactionKeeper).mapper.NewCollection(context).Add(mappable.NewSplit(key.NewSplitID(fromID, ownableID), sdkTypes.NewDec(100)))
+	keepers.SplitsKeeper.(transactionKeeper).mapper.NewCollection(context).Add(mappable.NewSplit(key.NewSplitID(fromID, ownableID2), sdkTypes.NewDec(10)))
+
+	getValue := func(ownerID ids.ID, ownableID ids.ID) sdkTypes.Dec {
+		splitKey := key.FromID(key.NewSplitID(ownerID, ownableID))
+		if split, ok := keepers.SplitsKeeper.(transactionKeeper).mapper.NewCollection(context).Fetch(splitKey).Get(splitKey).(mappables.Split); ok {
+			return split.GetValue()
+		}
+
+		return sdkTypes.ZeroDec()
+	}
+
+	t.Run("PositiveCase", func(t *testing.T) {
+		want := newTransactionResponse(nil)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, NewOutput(toID, ownableID, sdkTypes.NewDec(10)), NewOutput(toID2, ownableID, sdkTypes.NewDec(20)), NewOutput(toID, ownableID2, sdkTypes.NewDec(5)))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Equal(t, sdkTypes.NewDec(70), getValue(fromID, ownableID))
+		require.Equal(t, sdkTypes.NewDec(5), getValue(fromID, ownableID2))
+		require.Equal(t, sdkTypes.NewDec(10), getValue(toID, ownableID))
+		require.Equal(t, sdkTypes.NewDec(20), getValue(toID2, ownableID))
+		require.Equal(t, sdkTypes.NewDec(5), getValue(toID, ownableID2))
+	})
+
+	t.Run("NegativeCase-Verify Identity Failure", func(t *testing.T) {
+		want := newTransactionResponse(errors.MockError)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(verifyMockErrorAddress, fromID, NewOutput(toID, ownableID, sdkTypes.NewDec(1)))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Outputs over the cap", func(t *testing.T) {
+		want := newTransactionResponse(errors.InvalidRequest)
+		output := NewOutput(toID, ownableID, sdkTypes.NewDec(1))
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, output, output, output, output)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-No outputs", func(t *testing.T) {
+		want := newTransactionResponse(errors.InvalidRequest)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Negative Value", func(t *testing.T) {
+		want := newTransactionResponse(errors.NotAuthorized)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, NewOutput(toID, ownableID, sdkTypes.NewDec(2)), NewOutput(toID2, ownableID, sdkTypes.NewDec(-1)))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Outputs together exceed the split", func(t *testing.T) {
+		want := newTransactionResponse(errors.NotAuthorized)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, NewOutput(toID, ownableID, sdkTypes.NewDec(40)), NewOutput(toID2, ownableID, sdkTypes.NewDec(31)))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Split not found", func(t *testing.T) {
+		want := newTransactionResponse(errors.EntityNotFound)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, baseIDs.NewID("fakeFromID"), NewOutput(toID, ownableID, sdkTypes.NewDec(1)))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+}
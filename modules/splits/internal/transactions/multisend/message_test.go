@@ -0,0 +1,40 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package multisend
+
+import (
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/utilities/transaction"
+)
+
+func Test_MultiSend_Message(t *testing.T) {
+	testFromID := baseIDs.NewID("fromID")
+	testOutput := NewOutput(baseIDs.NewID("toID"), baseIDs.NewID("ownableID"), sdkTypes.NewDec(2))
+	testOutput2 := NewOutput(baseIDs.NewID("toID2"), baseIDs.NewID("ownableID"), sdkTypes.NewDec(3))
+
+	fromAddress := "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c"
+	fromAccAddress, err := sdkTypes.AccAddressFromBech32(fromAddress)
+	require.Nil(t, err)
+
+	testMessage := newMessage(fromAccAddress, testFromID, testOutput, testOutput2)
+	require.Equal(t, message{From: fromAccAddress, FromID: testFromID, Outputs: []Output{testOutput, testOutput2}}, testMessage)
+	require.Equal(t, module.Name, testMessage.Route())
+	require.Equal(t, Transaction.GetName(), testMessage.Type())
+	require.Equal(t, nil, testMessage.ValidateBasic())
+	require.NotNil(t, message{}.ValidateBasic())
+	require.NotNil(t, newMessage(fromAccAddress, testFromID).ValidateBasic())
+	require.NotNil(t, newMessage(fromAccAddress, testFromID, testOutput, NewOutput(baseIDs.NewID("toID"), baseIDs.NewID("ownableID"), sdkTypes.ZeroDec())).ValidateBasic())
+	require.NotNil(t, newMessage(fromAccAddress, testFromID, Output{OwnableID: baseIDs.NewID("ownableID"), Value: sdkTypes.OneDec()}).ValidateBasic())
+	require.Equal(t, sdkTypes.MustSortJSON(transaction.RegisterCodec(messagePrototype).MustMarshalJSON(testMessage)), testMessage.GetSignBytes())
+	require.Equal(t, []sdkTypes.AccAddress{fromAccAddress}, testMessage.GetSigners())
+	require.Equal(t, testMessage, messageFromInterface(testMessage))
+	require.Equal(t, message{}, messageFromInterface(nil))
+	require.Equal(t, message{}, messagePrototype())
+}
@@ -0,0 +1,25 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package multisend
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/ids"
+)
+
+// Output is a split of an ownable paid out to an identity by a multi-send message
+type Output struct {
+	ToID      ids.ID       `json:"toID" valid:"required~required field toID missing"`
+	OwnableID ids.ID       `json:"ownableID" valid:"required~required field ownableID missing"`
+	Value     sdkTypes.Dec `json:"value" valid:"required~required field value missing"`
+}
+
+func NewOutput(toID ids.ID, ownableID ids.ID, value sdkTypes.Dec) Output {
+	return Output{
+		ToID:      toID,
+		OwnableID: ownableID,
+		Value:     value,
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package multisend
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+type outputRequest struct {
+	ToID      string `json:"toID" valid:"required~required field toID missing, matches(^[A-Za-z0-9-_=.|]+$)~invalid field toID"`
+	OwnableID string `json:"ownableID" valid:"required~required field ownableID missing, matches(^[A-Za-z0-9-_=.|]+$)~invalid field ownableID"`
+	Value     string `json:"value" valid:"required~required field value missing, matches(^[0-9.]+$)~invalid field value"`
+}
+
+type transactionRequest struct {
+	BaseReq rest.BaseReq    `json:"baseReq"`
+	FromID  string          `json:"fromID" valid:"required~required field fromID missing, matches(^[A-Za-z0-9-_=.|]+$)~invalid field fromID"`
+	Outputs []outputRequest `json:"outputs" valid:"required~required field outputs missing"`
+}
+
+var _ helpers.TransactionRequest = (*transactionRequest)(nil)
+
+// Validate godoc
+// @Summary Multi-send split transaction
+// @Description Send splits of several ownables to several identities in one transaction
+// @Accept text/plain
+// @Produce json
+// @Tags Splits
+// @Param body body  transactionRequest true "Request body to send splits"
+// @Success 200 {object} transactionResponse   "Message for a successful response."
+// @Failure default  {object}  transactionResponse "Message for an unexpected error response."
+// @Router /splits/multisend [post]
+func (transactionRequest transactionRequest) Validate() error {
+	_, err := govalidator.ValidateStruct(transactionRequest)
+	return err
+}
+func (transactionRequest transactionRequest) FromCLI(cliCommand helpers.CLICommand, cliContext context.CLIContext) (helpers.TransactionRequest, error) {
+	var outputRequestList []outputRequest
+
+	if path := cliCommand.ReadString(constants.Outputs); path != "" {
+		var err error
+		if outputRequestList, err = readOutputFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return newTransactionRequest(
+		cliCommand.ReadBaseReq(cliContext),
+		cliCommand.ReadString(constants.FromID),
+		outputRequestList...,
+	), nil
+}
+func (transactionRequest transactionRequest) FromJSON(rawMessage json.RawMessage) (helpers.TransactionRequest, error) {
+	if err := json.Unmarshal(rawMessage, &transactionRequest); err != nil {
+		return nil, err
+	}
+
+	return transactionRequest, nil
+}
+func (transactionRequest transactionRequest) GetBaseReq() rest.BaseReq {
+	return transactionRequest.BaseReq
+}
+func (transactionRequest transactionRequest) MakeMsg() (sdkTypes.Msg, error) {
+	from, err := sdkTypes.AccAddressFromBech32(transactionRequest.GetBaseReq().From)
+	if err != nil {
+		return nil, err
+	}
+
+	outputList := make([]Output, len(transactionRequest.Outputs))
+
+	for i, outputRequest := range transactionRequest.Outputs {
+		value, err := sdkTypes.NewDecFromStr(outputRequest.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		outputList[i] = NewOutput(baseIDs.NewID(outputRequest.ToID), baseIDs.NewID(outputRequest.OwnableID), value)
+	}
+
+	return newMessage(
+		from,
+		baseIDs.NewID(transactionRequest.FromID),
+		outputList...,
+	), nil
+}
+func (transactionRequest) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, transactionRequest{})
+}
+func requestPrototype() helpers.TransactionRequest {
+	return transactionRequest{}
+}
+
+// readOutputFile reads the outputs from a JSON file holding a list of objects with the toID, ownableID and value fields, or from a CSV
+// file with a toID, ownableID and value record per output, which may start with a header naming those columns
+func readOutputFile(path string) ([]outputRequest, error) {
+	fileBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var outputRequestList []outputRequest
+		if err := json.Unmarshal(fileBytes, &outputRequestList); err != nil {
+			return nil, err
+		}
+
+		return outputRequestList, nil
+	}
+
+	return readOutputCSV(bytes.NewReader(fileBytes))
+}
+func readOutputCSV(reader io.Reader) ([]outputRequest, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = 3
+	csvReader.TrimLeadingSpace = true
+
+	recordList, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recordList) != 0 && strings.EqualFold(recordList[0][0], constants.ToID.GetName()) {
+		recordList = recordList[1:]
+	}
+
+	if len(recordList) == 0 {
+		return nil, errors.IncorrectFormat
+	}
+
+	outputRequestList := make([]outputRequest, len(recordList))
+	for i, record := range recordList {
+		outputRequestList[i] = outputRequest{ToID: strings.TrimSpace(record[0]), OwnableID: strings.TrimSpace(record[1]), Value: strings.TrimSpace(record[2])}
+	}
+
+	return outputRequestList, nil
+}
+func newTransactionRequest(baseReq rest.BaseReq, fromID string, outputs ...outputRequest) helpers.TransactionRequest {
+	return transactionRequest{
+		BaseReq: baseReq,
+		FromID:  fromID,
+		Outputs: outputs,
+	}
+}
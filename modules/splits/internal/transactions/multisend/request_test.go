@@ -0,0 +1,117 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package multisend
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+func Test_MultiSend_Request(t *testing.T) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+	cliCommand := baseHelpers.NewCLICommand("", "", "", []helpers.CLIFlag{constants.FromID, constants.Outputs})
+	cliContext := context.NewCLIContext().WithCodec(Codec)
+
+	fromAddress := "cosmos1pkkayn066msg6kn33wnl5srhdt3tnu2vzasz9c"
+	fromAccAddress, err := sdkTypes.AccAddressFromBech32(fromAddress)
+	require.Nil(t, err)
+
+	testOutputRequest := outputRequest{ToID: "toID", OwnableID: "ownableID", Value: "2"}
+	testOutputRequest2 := outputRequest{ToID: "toID2", OwnableID: "ownableID2", Value: "0.5"}
+	testBaseReq := rest.BaseReq{From: fromAddress, ChainID: "test", Fees: sdkTypes.NewCoins()}
+	testTransactionRequest := newTransactionRequest(testBaseReq, "fromID", testOutputRequest, testOutputRequest2)
+
+	require.Equal(t, transactionRequest{BaseReq: testBaseReq, FromID: "fromID", Outputs: []outputRequest{testOutputRequest, testOutputRequest2}}, testTransactionRequest)
+	require.Equal(t, nil, testTransactionRequest.Validate())
+	require.NotNil(t, newTransactionRequest(testBaseReq, "fromID").Validate())
+	require.NotNil(t, newTransactionRequest(testBaseReq, "fromID", outputRequest{ToID: "toID", OwnableID: "ownableID", Value: "-2"}).Validate())
+
+	requestFromCLI, err := transactionRequest{}.FromCLI(cliCommand, cliContext)
+	require.Equal(t, nil, err)
+	require.Equal(t, transactionRequest{BaseReq: rest.BaseReq{From: cliContext.GetFromAddress().String(), ChainID: cliContext.ChainID, Simulate: cliContext.Simulate}, FromID: ""}, requestFromCLI)
+
+	directory, err := ioutil.TempDir("", "multisend")
+	require.Nil(t, err)
+	defer os.RemoveAll(directory)
+
+	jsonPath := filepath.Join(directory, "outputs.json")
+	require.Nil(t, ioutil.WriteFile(jsonPath, []byte(`[{"toID":"toID","ownableID":"ownableID","value":"2"},{"toID":"toID2","ownableID":"ownableID2","value":"0.5"}]`), 0600))
+
+	viper.Set(constants.Outputs.GetName(), jsonPath)
+	requestFromCLI, err = transactionRequest{}.FromCLI(cliCommand, cliContext)
+	require.Equal(t, nil, err)
+	require.Equal(t, []outputRequest{testOutputRequest, testOutputRequest2}, requestFromCLI.(transactionRequest).Outputs)
+
+	csvPath := filepath.Join(directory, "outputs.csv")
+	require.Nil(t, ioutil.WriteFile(csvPath, []byte("toID,ownableID,value\ntoID, ownableID, 2\ntoID2,ownableID2,0.5\n"), 0600))
+
+	viper.Set(constants.Outputs.GetName(), csvPath)
+	requestFromCLI, err = transactionRequest{}.FromCLI(cliCommand, cliContext)
+	require.Equal(t, nil, err)
+	require.Equal(t, []outputRequest{testOutputRequest, testOutputRequest2}, requestFromCLI.(transactionRequest).Outputs)
+
+	require.Nil(t, ioutil.WriteFile(csvPath, []byte("toID,ownableID\n"), 0600))
+	_, err = transactionRequest{}.FromCLI(cliCommand, cliContext)
+	require.NotNil(t, err)
+
+	require.Nil(t, ioutil.WriteFile(csvPath, []byte("toID,ownableID,value\n"), 0600))
+	_, err = transactionRequest{}.FromCLI(cliCommand, cliContext)
+	require.NotNil(t, err)
+
+	viper.Set(constants.Outputs.GetName(), filepath.Join(directory, "missing.csv"))
+	_, err = transactionRequest{}.FromCLI(cliCommand, cliContext)
+	require.NotNil(t, err)
+	viper.Set(constants.Outputs.GetName(), "")
+
+	jsonMessage, _ := json.Marshal(testTransactionRequest)
+	transactionRequestUnmarshalled, err := transactionRequest{}.FromJSON(jsonMessage)
+	require.Equal(t, nil, err)
+	require.Equal(t, testTransactionRequest, transactionRequestUnmarshalled)
+
+	randomUnmarshall, err := transactionRequest{}.FromJSON([]byte{})
+	require.Equal(t, nil, randomUnmarshall)
+	require.NotNil(t, err)
+
+	require.Equal(t, testBaseReq, testTransactionRequest.GetBaseReq())
+
+	msg, err := testTransactionRequest.MakeMsg()
+	require.Equal(t, newMessage(fromAccAddress, baseIDs.NewID("fromID"), NewOutput(baseIDs.NewID("toID"), baseIDs.NewID("ownableID"), sdkTypes.NewDec(2)), NewOutput(baseIDs.NewID("toID2"), baseIDs.NewID("ownableID2"), sdkTypes.NewDecWithPrec(5, 1))), msg)
+	require.Nil(t, err)
+
+	msg2, err := newTransactionRequest(rest.BaseReq{From: "randomFromAddress", ChainID: "test"}, "fromID", testOutputRequest).MakeMsg()
+	require.NotNil(t, err)
+	require.Nil(t, msg2)
+
+	msg2, err = newTransactionRequest(testBaseReq, "fromID", outputRequest{ToID: "toID", OwnableID: "ownableID", Value: "randomString"}).MakeMsg()
+	require.NotNil(t, err)
+	require.Nil(t, msg2)
+
+	require.Equal(t, transactionRequest{}, requestPrototype())
+	require.NotPanics(t, func() {
+		requestPrototype().RegisterCodec(codec.New())
+	})
+}
@@ -4,8 +4,16 @@
 package transactions
 
 import (
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/approve"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/claim"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/distribute"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/multisend"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/reclaim"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/revokeallowance"
 	"github.com/AssetMantle/modules/modules/splits/internal/transactions/send"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/transferfrom"
 	"github.com/AssetMantle/modules/modules/splits/internal/transactions/unwrap"
+	"github.com/AssetMantle/modules/modules/splits/internal/transactions/vest"
 	"github.com/AssetMantle/modules/modules/splits/internal/transactions/wrap"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
@@ -13,8 +21,16 @@ import (
 
 func Prototype() helpers.Transactions {
 	return baseHelpers.NewTransactions(
+		approve.Transaction,
+		claim.Transaction,
+		distribute.Transaction,
+		multisend.Transaction,
+		reclaim.Transaction,
+		revokeallowance.Transaction,
 		send.Transaction,
+		transferfrom.Transaction,
 		unwrap.Transaction,
+		vest.Transaction,
 		wrap.Transaction,
 	)
 }
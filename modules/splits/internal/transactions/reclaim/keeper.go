@@ -0,0 +1,98 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/windows"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+type transactionKeeper struct {
+	mapper                helpers.Mapper
+	parameters            helpers.Parameters
+	authenticateAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
+
+func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
+	message := messageFromInterface(msg)
+	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	splits := transactionKeeper.mapper.NewCollection(context)
+
+	distribution, found := utilities.GetDistribution(splits, message.DistributionID)
+	if !found {
+		return newTransactionResponse(errors.EntityNotFound)
+	}
+
+	if distribution.GetDistributorID().Compare(message.FromID) != 0 {
+		return newTransactionResponse(errors.NotAuthorized)
+	}
+
+	// holders have the claim window to claim their shares before the distributor can take back what is left, the distribution itself is
+	// kept so that its sequence is not given out again
+	if context.BlockHeight() < distribution.GetHeight().Get()+transactionKeeper.parameters.Fetch(context, windows.ID).Get(windows.ID).GetData().(data.DecData).Get().TruncateInt64() {
+		return newTransactionResponse(errors.NotAuthorized)
+	}
+
+	escrow, found := utilities.GetEscrow(splits, baseIDs.NewID(module.Name), message.DistributionID)
+	if !found {
+		return newTransactionResponse(errors.EntityNotFound)
+	}
+
+	value := escrow.GetValue()
+
+	if _, err := utilities.UnlockEscrow(splits, escrow, message.FromID, value); err != nil {
+		return newTransactionResponse(err)
+	}
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitReclaimed,
+			sdkTypes.NewAttribute(events.AttributeKeyToID, message.FromID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, distribution.GetOwnableID().String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, value.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyReferenceID, message.DistributionID.String()),
+		),
+	)
+
+	return newTransactionResponse(nil)
+}
+
+func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	transactionKeeper.mapper, transactionKeeper.parameters = mapper, parameters
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case supply.Keeper:
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case authenticate.Auxiliary.GetName():
+				transactionKeeper.authenticateAuxiliary = value
+			default:
+				break
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return transactionKeeper
+}
+func keeperPrototype() helpers.TransactionKeeper {
+	return transactionKeeper{}
+}
@@ -0,0 +1,169 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/mapper"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/windows"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type TestKeepers struct {
+	SplitsKeeper helpers.TransactionKeeper
+}
+
+func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	codec.RegisterEvidences(Codec)
+	vesting.RegisterCodec(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	Mapper := mapper.Prototype().Initialize(storeKey)
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test").WithKeyTable(parameters.Prototype().GetKeyTable()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+		Height:  10,
+	}, false, log.NewNopLogger())
+
+	for _, parameter := range Parameters.GetList() {
+		Parameters.Mutate(context, parameter)
+	}
+
+	authenticateAuxiliary := authenticate.AuxiliaryMock.Initialize(Mapper, Parameters)
+	keepers := TestKeepers{
+		SplitsKeeper: keeperPrototype().Initialize(Mapper, Parameters, []interface{}{authenticateAuxiliary}).(helpers.TransactionKeeper),
+	}
+
+	return context, keepers
+}
+
+func Test_transactionKeeper_Transact(t *testing.T) {
+	context, keepers := CreateTestInput(t)
+	defaultAddr := sdkTypes.AccAddress("addr")
+	verifyMockErrorAddress := sdkTypes.AccAddress("verifyError")
+
+	fromID := baseIDs.NewID("fromID")
+	holderID := baseIDs.NewID("holderID")
+	heldOwnableID := baseIDs.NewID("property")
+	ownableID := baseIDs.NewID("stake")
+	distributionID := key.NewDistributionID(heldOwnableID, 1)
+	splits := keepers.SplitsKeeper.(transactionKeeper).mapper.NewCollection(context)
+
+	getValue := func(ownerID ids.ID) sdkTypes.Dec {
+		if split, ok := splits.Fetch(key.FromID(key.NewSplitID(ownerID, ownableID))).Get(key.FromID(key.NewSplitID(ownerID, ownableID))).(mappables.Split); ok {
+			return split.GetValue()
+		}
+
+		return sdkTypes.ZeroDec()
+	}
+
+	splits.Add(mappable.NewSplit(key.NewSplitID(fromID, ownableID), sdkTypes.NewDec(100)))
+	splits.Add(mappable.NewSplit(key.NewSplitID(holderID, heldOwnableID), sdkTypes.NewDec(40)))
+	_, err := utilities.IncreaseSupply(splits, heldOwnableID, sdkTypes.NewDec(40))
+	require.Nil(t, err)
+	_, err = utilities.LockEscrow(splits, baseIDs.NewID(module.Name), distributionID, fromID, ownableID, sdkTypes.NewDec(30), baseTypes.NewHeight(5))
+	require.Nil(t, err)
+	splits.Add(mappable.NewDistribution(heldOwnableID, 1, fromID, ownableID, sdkTypes.NewDec(30), sdkTypes.NewDec(40), baseTypes.NewHeight(5)))
+
+	closedContext := context.WithBlockHeight(5 + windows.DefaultData.(data.DecData).Get().TruncateInt64())
+
+	t.Run("NegativeCase-Verify Identity Failure", func(t *testing.T) {
+		want := newTransactionResponse(errors.MockError)
+		if got := keepers.SplitsKeeper.Transact(closedContext, newMessage(verifyMockErrorAddress, fromID, distributionID)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Distribution Not Found", func(t *testing.T) {
+		want := newTransactionResponse(errors.EntityNotFound)
+		if got := keepers.SplitsKeeper.Transact(closedContext, newMessage(defaultAddr, fromID, key.NewDistributionID(heldOwnableID, 2))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Not Distributor", func(t *testing.T) {
+		want := newTransactionResponse(errors.NotAuthorized)
+		if got := keepers.SplitsKeeper.Transact(closedContext, newMessage(defaultAddr, holderID, distributionID)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("NegativeCase-Claim Window Open", func(t *testing.T) {
+		want := newTransactionResponse(errors.NotAuthorized)
+		if got := keepers.SplitsKeeper.Transact(closedContext.WithBlockHeight(closedContext.BlockHeight()-1), newMessage(defaultAddr, fromID, distributionID)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Equal(t, sdkTypes.NewDec(70), getValue(fromID))
+	})
+
+	t.Run("PositiveCase", func(t *testing.T) {
+		want := newTransactionResponse(nil)
+		if got := keepers.SplitsKeeper.Transact(closedContext, newMessage(defaultAddr, fromID, distributionID)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+
+		require.Equal(t, sdkTypes.NewDec(100), getValue(fromID))
+
+		_, found := utilities.GetEscrow(splits, baseIDs.NewID(module.Name), distributionID)
+		require.Equal(t, false, found)
+
+		// the distribution is kept so that its sequence is not given out again
+		_, found = utilities.GetDistribution(splits, distributionID)
+		require.Equal(t, true, found)
+	})
+
+	t.Run("NegativeCase-Reclaim Twice", func(t *testing.T) {
+		want := newTransactionResponse(errors.EntityNotFound)
+		if got := keepers.SplitsKeeper.Transact(closedContext, newMessage(defaultAddr, fromID, distributionID)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+}
@@ -8,9 +8,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
 	"github.com/AssetMantle/modules/schema/helpers"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
 )
 
 type transactionKeeper struct {
@@ -29,7 +31,7 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 
 	splits := transactionKeeper.mapper.NewCollection(context)
 
-	if _, err := utilities.SubtractSplits(splits, message.FromID, message.OwnableID, message.Value); err != nil {
+	if _, err := utilities.SubtractUnlockedSplits(splits, message.FromID, message.OwnableID, message.Value, baseTypes.NewHeight(context.BlockHeight())); err != nil {
 		return newTransactionResponse(err)
 	}
 
@@ -37,6 +39,16 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		return newTransactionResponse(err)
 	}
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitTransferred,
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, message.FromID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyToID, message.ToID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, message.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, message.Value.String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
@@ -26,6 +26,7 @@ import (
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
 )
 
 type TestKeepers struct {
@@ -83,6 +84,10 @@ func Test_transactionKeeper_Transact(t *testing.T) {
 
 	keepers.SplitsKeeper.(transactionKeeper).mapper.NewCollection(context).Add(mappable.NewSplit(key.NewSplitID(fromID, ownableID), sdkTypes.NewDec(100)))
 
+	vestedID := baseIDs.NewID("vestedID")
+	keepers.SplitsKeeper.(transactionKeeper).mapper.NewCollection(context).Add(mappable.NewSplit(key.NewSplitID(vestedID, ownableID), sdkTypes.NewDec(10)))
+	keepers.SplitsKeeper.(transactionKeeper).mapper.NewCollection(context).Add(mappable.NewVesting(vestedID, ownableID, fromID, mappable.CliffVesting, sdkTypes.NewDec(6), baseTypes.NewHeight(0), baseTypes.NewHeight(100), 0))
+
 	t.Run("PositiveCase", func(t *testing.T) {
 		want := newTransactionResponse(nil)
 		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, fromID, toID, ownableID, sdkTypes.NewDec(1))); !reflect.DeepEqual(got, want) {
@@ -97,6 +102,14 @@ func Test_transactionKeeper_Transact(t *testing.T) {
 		}
 	})
 
+	t.Run("NegativeCase-Send Locked Splits", func(t *testing.T) {
+		t.Parallel()
+		want := newTransactionResponse(errors.InsufficientBalance)
+		if got := keepers.SplitsKeeper.Transact(context, newMessage(defaultAddr, vestedID, toID, ownableID, sdkTypes.NewDec(5))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
 	t.Run("NegativeCase-Verify Identity Failure", func(t *testing.T) {
 		t.Parallel()
 		want := newTransactionResponse(errors.MockError)
@@ -67,3 +67,8 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, toID ids.ID, ownableID
 		Value:     value,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, toID ids.ID, ownableID ids.ID, value sdkTypes.Dec) sdkTypes.Msg {
+	return newMessage(from, fromID, toID, ownableID, value)
+}
@@ -0,0 +1,83 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package transferfrom
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type transactionKeeper struct {
+	mapper                helpers.Mapper
+	parameters            helpers.Parameters
+	authenticateAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
+
+func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
+	message := messageFromInterface(msg)
+	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	height := baseTypes.NewHeight(context.BlockHeight())
+	splits := transactionKeeper.mapper.NewCollection(context)
+
+	if _, err := utilities.SpendAllowance(splits, message.OwnerID, message.FromID, message.OwnableID, message.Value, height); err != nil {
+		return newTransactionResponse(err)
+	}
+
+	if _, err := utilities.SubtractUnlockedSplits(splits, message.OwnerID, message.OwnableID, message.Value, height); err != nil {
+		return newTransactionResponse(err)
+	}
+
+	if _, err := utilities.AddSplits(splits, message.ToID, message.OwnableID, message.Value); err != nil {
+		return newTransactionResponse(err)
+	}
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitTransferred,
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, message.OwnerID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyToID, message.ToID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, message.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, message.Value.String()),
+			sdkTypes.NewAttribute(events.AttributeKeySpenderID, message.FromID.String()),
+		),
+	)
+
+	return newTransactionResponse(nil)
+}
+
+func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	transactionKeeper.mapper, transactionKeeper.parameters = mapper, parameters
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case supply.Keeper:
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case authenticate.Auxiliary.GetName():
+				transactionKeeper.authenticateAuxiliary = value
+			default:
+				break
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return transactionKeeper
+}
+func keeperPrototype() helpers.TransactionKeeper {
+	return transactionKeeper{}
+}
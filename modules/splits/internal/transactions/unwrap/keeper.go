@@ -8,10 +8,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/splits/internal/module"
 	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
 	"github.com/AssetMantle/modules/schema/helpers"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
 )
 
 type transactionKeeper struct {
@@ -29,8 +31,17 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		return newTransactionResponse(auxiliaryResponse.GetError())
 	}
 
+	// only splits of coins can be unwrapped, denominations taken off the registry stay unwrappable so that their splits are not stranded
+	if sdkTypes.ValidateDenom(message.OwnableID.String()) != nil {
+		return newTransactionResponse(errors.NotAuthorized)
+	}
+
 	splits := transactionKeeper.mapper.NewCollection(context)
-	if _, err := utilities.SubtractSplits(splits, message.FromID, message.OwnableID, sdkTypes.NewDecFromInt(message.Value)); err != nil {
+	if _, err := utilities.SubtractUnlockedSplits(splits, message.FromID, message.OwnableID, sdkTypes.NewDecFromInt(message.Value), baseTypes.NewHeight(context.BlockHeight())); err != nil {
+		return newTransactionResponse(err)
+	}
+
+	if _, err := utilities.DecreaseSupply(splits, message.OwnableID, sdkTypes.NewDecFromInt(message.Value)); err != nil {
 		return newTransactionResponse(err)
 	}
 
@@ -38,6 +49,15 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		return newTransactionResponse(err)
 	}
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitUnwrapped,
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, message.FromID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, message.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, message.Value.String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
@@ -65,3 +65,8 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, ownableID ids.ID, value
 		Value:     value,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, ownableID ids.ID, value sdkTypes.Int) sdkTypes.Msg {
+	return newMessage(from, fromID, ownableID, value)
+}
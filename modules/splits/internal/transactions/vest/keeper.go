@@ -0,0 +1,111 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package vest
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/vestings"
+	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+type transactionKeeper struct {
+	mapper                helpers.Mapper
+	parameters            helpers.Parameters
+	authenticateAuxiliary helpers.Auxiliary
+}
+
+var _ helpers.TransactionKeeper = (*transactionKeeper)(nil)
+
+func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, msg sdkTypes.Msg) helpers.TransactionResponse {
+	message := messageFromInterface(msg)
+	if auxiliaryResponse := transactionKeeper.authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(message.From, message.FromID)); !auxiliaryResponse.IsSuccessful() {
+		return newTransactionResponse(auxiliaryResponse.GetError())
+	}
+
+	if !mappable.IsVestingKind(message.Kind) || message.VestsIn.Get() <= 0 || message.Period < 0 {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
+	// periodic schedules vest in whole periods, so the period has to divide the blocks the schedule runs for
+	if message.Kind.Compare(mappable.PeriodicVesting) == 0 && (message.Period == 0 || message.VestsIn.Get()%message.Period != 0) {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
+	start := baseTypes.NewHeight(context.BlockHeight())
+	splits := transactionKeeper.mapper.NewCollection(context)
+
+	vestingKey := key.FromVestingID(key.NewVestingID(message.ToID, message.OwnableID, message.FromID, start.Get()))
+	if splits.Fetch(vestingKey).Get(vestingKey) != nil {
+		return newTransactionResponse(errors.EntityAlreadyExists)
+	}
+
+	// schedules are scanned on every subtraction from the split they lock, so a split takes only as many schedules still locking it as the
+	// module allows
+	scheduleCount := int64(0)
+	for _, vesting := range utilities.GetVestings(splits, message.ToID, message.OwnableID) {
+		if vesting.GetLocked(start).IsPositive() {
+			scheduleCount++
+		}
+	}
+
+	if scheduleCount >= transactionKeeper.parameters.Fetch(context, vestings.ID).Get(vestings.ID).GetData().(data.DecData).Get().TruncateInt64() {
+		return newTransactionResponse(errors.InvalidRequest)
+	}
+
+	if _, err := utilities.SubtractUnlockedSplits(splits, message.FromID, message.OwnableID, message.Value, start); err != nil {
+		return newTransactionResponse(err)
+	}
+
+	if _, err := utilities.AddSplits(splits, message.ToID, message.OwnableID, message.Value); err != nil {
+		return newTransactionResponse(err)
+	}
+
+	splits.Add(mappable.NewVesting(message.ToID, message.OwnableID, message.FromID, message.Kind, message.Value, start, baseTypes.NewHeight(start.Get()+message.VestsIn.Get()), message.Period))
+
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitVested,
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, message.FromID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyToID, message.ToID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyOwnableID, message.OwnableID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyValue, message.Value.String()),
+		),
+	)
+
+	return newTransactionResponse(nil)
+}
+
+func (transactionKeeper transactionKeeper) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaries []interface{}) helpers.Keeper {
+	transactionKeeper.mapper, transactionKeeper.parameters = mapper, parameters
+
+	for _, auxiliary := range auxiliaries {
+		switch value := auxiliary.(type) {
+		case supply.Keeper:
+		case helpers.Auxiliary:
+			switch value.GetName() {
+			case authenticate.Auxiliary.GetName():
+				transactionKeeper.authenticateAuxiliary = value
+			default:
+				break
+			}
+		default:
+			panic(errors.UninitializedUsage)
+		}
+	}
+
+	return transactionKeeper
+}
+func keeperPrototype() helpers.TransactionKeeper {
+	return transactionKeeper{}
+}
@@ -0,0 +1,83 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package vest
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/module"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/types"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+	"github.com/AssetMantle/modules/utilities/transaction"
+)
+
+type message struct {
+	From      sdkTypes.AccAddress `json:"from" valid:"required~required field from missing"`
+	FromID    ids.ID              `json:"fromID" valid:"required~required field fromID missing"`
+	ToID      ids.ID              `json:"toID" valid:"required~required field toID missing"`
+	OwnableID ids.ID              `json:"ownableID" valid:"required~required field ownableID missing"`
+	Value     sdkTypes.Dec        `json:"value" valid:"required~required field value missing"`
+	Kind      ids.ID              `json:"kind" valid:"required~required field kind missing"`
+	VestsIn   types.Height        `json:"vestsIn" valid:"required~required field vestsIn missing"`
+	Period    int64               `json:"period"`
+}
+
+var _ sdkTypes.Msg = message{}
+
+func (message message) Route() string { return module.Name }
+func (message message) Type() string  { return Transaction.GetName() }
+func (message message) ValidateBasic() error {
+	var _, Error = govalidator.ValidateStruct(message)
+	if Error != nil {
+		return sdkErrors.Wrap(errors.IncorrectMessage, Error.Error())
+	}
+
+	return nil
+}
+func (message message) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(transaction.RegisterCodec(messagePrototype).MustMarshalJSON(message))
+}
+func (message message) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{message.From}
+}
+func (message) RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, message{})
+}
+func messageFromInterface(msg sdkTypes.Msg) message {
+	switch value := msg.(type) {
+	case message:
+		return value
+	default:
+		return message{}
+	}
+}
+func messagePrototype() helpers.Message {
+	return message{}
+}
+
+// newMessage creates a message that sends the value to the recipient under a schedule of the kind that starts on delivery and ends after
+// the given number of blocks, periodic schedules vest once every period
+func newMessage(from sdkTypes.AccAddress, fromID ids.ID, toID ids.ID, ownableID ids.ID, value sdkTypes.Dec, kind ids.ID, vestsIn types.Height, period int64) sdkTypes.Msg {
+	return message{
+		From:      from,
+		FromID:    fromID,
+		ToID:      toID,
+		OwnableID: ownableID,
+		Value:     value,
+		Kind:      kind,
+		VestsIn:   vestsIn,
+		Period:    period,
+	}
+}
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, toID ids.ID, ownableID ids.ID, value sdkTypes.Dec, kind ids.ID, vestsIn types.Height, period int64) sdkTypes.Msg {
+	return newMessage(from, fromID, toID, ownableID, value, kind, vestsIn, period)
+}
@@ -7,6 +7,8 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
 	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
 	"github.com/AssetMantle/modules/modules/splits/internal/module"
 	"github.com/AssetMantle/modules/modules/splits/internal/utilities"
@@ -29,6 +31,18 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		return newTransactionResponse(auxiliaryResponse.GetError())
 	}
 
+	for _, coin := range message.Coins {
+		denom, found := utilities.GetWrapDenom(context, transactionKeeper.parameters, coin.Denom)
+		if !found {
+			return newTransactionResponse(errors.NotAuthorized)
+		}
+
+		// the supply of a denomination is only ever changed by wrapping and unwrapping, so it is the wrapped total
+		if denom.IsCapped() && utilities.GetSupply(transactionKeeper.mapper.NewCollection(context), baseIDs.NewID(coin.Denom)).Add(sdkTypes.NewDecFromInt(coin.Amount)).GT(sdkTypes.NewDecFromInt(denom.Cap)) {
+			return newTransactionResponse(errors.NotAuthorized)
+		}
+	}
+
 	if err := transactionKeeper.supplyKeeper.SendCoinsFromAccountToModule(context, message.From, module.Name, message.Coins); err != nil {
 		return newTransactionResponse(err)
 	}
@@ -37,8 +51,20 @@ func (transactionKeeper transactionKeeper) Transact(context sdkTypes.Context, ms
 		if _, err := utilities.AddSplits(transactionKeeper.mapper.NewCollection(context), message.FromID, baseIDs.NewID(coin.Denom), sdkTypes.NewDecFromInt(coin.Amount)); err != nil {
 			return newTransactionResponse(err)
 		}
+
+		if _, err := utilities.IncreaseSupply(transactionKeeper.mapper.NewCollection(context), baseIDs.NewID(coin.Denom), sdkTypes.NewDecFromInt(coin.Amount)); err != nil {
+			return newTransactionResponse(err)
+		}
 	}
 
+	context.EventManager().EmitEvent(
+		sdkTypes.NewEvent(
+			events.SplitWrapped,
+			sdkTypes.NewAttribute(events.AttributeKeyFromID, message.FromID.String()),
+			sdkTypes.NewAttribute(events.AttributeKeyCoins, message.Coins.String()),
+		),
+	)
+
 	return newTransactionResponse(nil)
 }
 
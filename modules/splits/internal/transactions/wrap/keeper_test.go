@@ -26,7 +26,9 @@ import (
 	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
 	"github.com/AssetMantle/modules/modules/splits/internal/module"
 	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/denoms"
 	"github.com/AssetMantle/modules/schema"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
 	"github.com/AssetMantle/modules/schema/helpers"
 	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
 	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
@@ -61,7 +63,7 @@ func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
 		paramsStoreKey,
 		paramsTransientStoreKeys,
 	)
-	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test"))
+	Parameters := parameters.Prototype().Initialize(paramsKeeper.Subspace("test").WithKeyTable(parameters.Prototype().GetKeyTable()))
 
 	memDB := tendermintDB.NewMemDB()
 	commitMultiStore := store.NewCommitMultiStore(memDB)
@@ -76,6 +78,7 @@ func CreateTestInput(t *testing.T) (sdkTypes.Context, TestKeepers) {
 	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
 		ChainID: "test",
 	}, false, log.NewNopLogger())
+	Parameters.Mutate(context, denoms.Parameter)
 
 	accountKeeper := auth.NewAccountKeeper(Codec, authStoreKey, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
 
@@ -127,6 +130,14 @@ func Test_transactionKeeper_Transact(t *testing.T) {
 		}
 	})
 
+	t.Run("NegativeCase-Denom not allowed", func(t *testing.T) {
+		t.Parallel()
+		want := newTransactionResponse(errors.NotAuthorized)
+		if got := keepers.SplitsKeeper.Transact(ctx, newMessage(defaultAddr, fromID, sdkTypes.NewCoins(sdkTypes.NewCoin("unlisted", sdkTypes.NewInt(100))))); !reflect.DeepEqual(got, want) {
+			t.Errorf("Transact() = %v, want %v", got, want)
+		}
+	})
+
 	t.Run("NegativeCase-Wrap Negative coins", func(t *testing.T) {
 		t.Parallel()
 		want := newTransactionResponse(errors.InsufficientBalance)
@@ -136,3 +147,21 @@ func Test_transactionKeeper_Transact(t *testing.T) {
 	})
 
 }
+
+func Test_transactionKeeper_Transact_Cap(t *testing.T) {
+	ctx, keepers := CreateTestInput(t)
+	defaultAddr := sdkTypes.AccAddress("addr")
+	fromID := baseIDs.NewID("fromID")
+	coins := func(amount int64) sdkTypes.Coins {
+		return sdkTypes.NewCoins(sdkTypes.NewCoin("stake", sdkTypes.NewInt(amount)))
+	}
+
+	keepers.SplitsKeeper.(transactionKeeper).parameters.Mutate(ctx, denoms.Parameter.Mutate(baseData.NewListData(baseData.NewStringData("stake:150:6:STAKE"))))
+
+	err := keepers.BankKeeper.SetCoins(ctx, defaultAddr, coins(1000))
+	require.Equal(t, nil, err)
+
+	require.Equal(t, newTransactionResponse(nil), keepers.SplitsKeeper.Transact(ctx, newMessage(defaultAddr, fromID, coins(100))))
+	require.Equal(t, newTransactionResponse(errors.NotAuthorized), keepers.SplitsKeeper.Transact(ctx, newMessage(defaultAddr, fromID, coins(51))))
+	require.Equal(t, newTransactionResponse(nil), keepers.SplitsKeeper.Transact(ctx, newMessage(defaultAddr, fromID, coins(50))))
+}
@@ -63,3 +63,8 @@ func newMessage(from sdkTypes.AccAddress, fromID ids.ID, coins sdkTypes.Coins) s
 		Coins:  coins,
 	}
 }
+
+// NewSimulationMessage creates the message the simulator of the module delivers, outside of simulations messages are made from transaction requests
+func NewSimulationMessage(from sdkTypes.AccAddress, fromID ids.ID, coins sdkTypes.Coins) sdkTypes.Msg {
+	return newMessage(from, fromID, coins)
+}
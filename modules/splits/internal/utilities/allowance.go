@@ -0,0 +1,69 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/types"
+)
+
+func GetAllowance(collection helpers.Collection, ownerID ids.ID, spenderID ids.ID, ownableID ids.ID) (mappables.Allowance, bool) {
+	allowanceKey := key.FromAllowanceID(key.NewAllowanceID(ownerID, spenderID, ownableID))
+
+	allowance, ok := collection.Fetch(allowanceKey).Get(allowanceKey).(mappables.Allowance)
+
+	return allowance, ok
+}
+
+// GetAllowances returns the allowances the owner grants, or only those it grants the spender if the spender ID is not empty
+func GetAllowances(collection helpers.Collection, ownerID ids.ID, spenderID ids.ID) []mappables.Allowance {
+	var allowances []mappables.Allowance
+
+	// the partial key prefixes by bytes, so allowances of owners and spenders that merely start with the same bytes are filtered out
+	collection.Iterate(
+		key.FromAllowanceID(key.NewAllowanceID(ownerID, spenderID, baseIDs.NewID(""))),
+		func(mappable helpers.Mappable) bool {
+			allowance := mappable.(mappables.Allowance)
+
+			if allowance.GetOwnerID().Compare(ownerID) == 0 && (len(spenderID.Bytes()) == 0 || allowance.GetSpenderID().Compare(spenderID) == 0) {
+				allowances = append(allowances, allowance)
+			}
+
+			return false
+		},
+	)
+
+	return allowances
+}
+
+// SpendAllowance takes the value out of the allowance the owner grants the spender on its split of the ownable, removing it once used up,
+// allowances that are missing, expired at the height or smaller than the value do not authorize the spend
+func SpendAllowance(collection helpers.Collection, ownerID ids.ID, spenderID ids.ID, ownableID ids.ID, value sdkTypes.Dec, height types.Height) (helpers.Collection, error) {
+	if value.LTE(sdkTypes.ZeroDec()) {
+		return nil, errors.NotAuthorized
+	}
+
+	allowance, found := GetAllowance(collection, ownerID, spenderID, ownableID)
+	if !found || allowance.IsExpired(height) {
+		return nil, errors.NotAuthorized
+	}
+
+	switch allowance = allowance.Decrease(value); {
+	case allowance.GetValue().LT(sdkTypes.ZeroDec()):
+		return nil, errors.NotAuthorized
+	case allowance.GetValue().IsZero():
+		collection.Remove(allowance)
+	default:
+		collection.Mutate(allowance)
+	}
+
+	return collection, nil
+}
@@ -0,0 +1,90 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/mapper"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// GetHolding returns the value of the ownable the owner holds, the value it has in escrow with a holder being counted for it and the value
+// it holds in escrow for others being left out
+func GetHolding(collection helpers.Collection, ownerID ids.ID, ownableID ids.ID) sdkTypes.Dec {
+	holding := sdkTypes.ZeroDec()
+
+	splitKey := key.FromID(key.NewSplitID(ownerID, ownableID))
+	if split, ok := collection.Fetch(splitKey).Get(splitKey).(mappables.Split); ok {
+		holding = split.GetValue()
+	}
+
+	escrowIndexKeyBytes := append(append([]byte{}, ownerID.Bytes()...), ownableID.Bytes()...)
+
+	collection.IterateIndex(mapper.EscrowOwnerIDIndex, escrowIndexKeyBytes, func(mappable helpers.Mappable) bool {
+		if escrow := mappable.(mappables.Escrow); escrow.GetOwnerID().Compare(ownerID) == 0 && escrow.GetOwnableID().Compare(ownableID) == 0 {
+			holding = holding.Add(escrow.GetValue())
+		}
+
+		return false
+	})
+
+	collection.IterateIndex(mapper.EscrowHolderIDIndex, escrowIndexKeyBytes, func(mappable helpers.Mappable) bool {
+		if escrow := mappable.(mappables.Escrow); escrow.GetHolderID().Compare(ownerID) == 0 && escrow.GetOwnableID().Compare(ownableID) == 0 {
+			holding = holding.Sub(escrow.GetValue())
+		}
+
+		return false
+	})
+
+	return holding
+}
+
+// GetHoldingAt returns the holding of the owner as of the distribution of the sequence to the holders of the ownable, which is kept by the
+// first checkpoint from the distribution on or is the current holding if the holding has not changed since
+func GetHoldingAt(collection helpers.Collection, ownerID ids.ID, ownableID ids.ID, sequence int64) sdkTypes.Dec {
+	var holdingCheckpoint mappables.Checkpoint
+
+	// checkpoints of a holding iterate latest first, so the last one met before the distribution is the first one from it on
+	collection.Iterate(key.FromCheckpointID(key.NewCheckpointID(ownerID, ownableID, 0)), func(mappable helpers.Mappable) bool {
+		checkpoint := mappable.(mappables.Checkpoint)
+		if checkpoint.GetOwnerID().Compare(ownerID) != 0 || checkpoint.GetOwnableID().Compare(ownableID) != 0 {
+			return false
+		}
+
+		if checkpoint.GetSequence() < sequence {
+			return true
+		}
+
+		holdingCheckpoint = checkpoint
+
+		return false
+	})
+
+	if holdingCheckpoint != nil {
+		return holdingCheckpoint.GetValue()
+	}
+
+	return GetHolding(collection, ownerID, ownableID)
+}
+
+// Checkpoint keeps the holding of the owner as of the latest distribution to the holders of the ownable if it has not been kept yet, it is
+// called before every change to the holding so that the holding kept is the one from before the first change after the distribution
+func Checkpoint(collection helpers.Collection, ownerID ids.ID, ownableID ids.ID) helpers.Collection {
+	distribution, found := GetLatestDistribution(collection, ownableID)
+	if !found {
+		return collection
+	}
+
+	checkpointKey := key.FromCheckpointID(key.NewCheckpointID(ownerID, ownableID, distribution.GetSequence()))
+	if collection.Fetch(checkpointKey).Get(checkpointKey) != nil {
+		return collection
+	}
+
+	return collection.Add(mappable.NewCheckpoint(ownerID, ownableID, distribution.GetSequence(), GetHolding(collection, ownerID, ownableID)))
+}
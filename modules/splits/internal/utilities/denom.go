@@ -0,0 +1,38 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/parameters/denoms"
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+// GetWrapDenoms returns the entries of the wrap denomination registry, the validator of the parameter ensures they can be read
+func GetWrapDenoms(context sdkTypes.Context, parameters helpers.Parameters) []denoms.Denom {
+	var denomList []denoms.Denom
+
+	for _, datum := range parameters.Fetch(context, denoms.ID).Get(denoms.ID).GetData().(data.ListData).Get() {
+		if stringData, ok := datum.(data.StringData); ok {
+			if denom, err := denoms.ReadDenom(stringData.Get()); err == nil {
+				denomList = append(denomList, denom)
+			}
+		}
+	}
+
+	return denomList
+}
+
+// GetWrapDenom returns the registry entry of the denomination, coins of denominations without one are not allowed to be wrapped into splits
+func GetWrapDenom(context sdkTypes.Context, parameters helpers.Parameters, denom string) (denoms.Denom, bool) {
+	for _, wrapDenom := range GetWrapDenoms(context, parameters) {
+		if wrapDenom.Denom == denom {
+			return wrapDenom, true
+		}
+	}
+
+	return denoms.Denom{}, false
+}
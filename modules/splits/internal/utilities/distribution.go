@@ -0,0 +1,130 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	"sort"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/modules/splits/internal/mapper"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// GetLatestDistribution returns the latest distribution to the holders of the ownable
+func GetLatestDistribution(collection helpers.Collection, heldOwnableID ids.ID) (mappables.Distribution, bool) {
+	var latestDistribution mappables.Distribution
+
+	// distributions to the holders of an ownable iterate latest first
+	collection.Iterate(key.FromDistributionID(key.NewDistributionID(heldOwnableID, 0)), func(mappable helpers.Mappable) bool {
+		if distribution := mappable.(mappables.Distribution); distribution.GetHeldOwnableID().Compare(heldOwnableID) == 0 {
+			latestDistribution = distribution
+			return true
+		}
+
+		return false
+	})
+
+	return latestDistribution, latestDistribution != nil
+}
+
+func GetDistribution(collection helpers.Collection, distributionID ids.ID) (mappables.Distribution, bool) {
+	distributionKey := key.FromDistributionID(distributionID)
+
+	distribution, ok := collection.Fetch(distributionKey).Get(distributionKey).(mappables.Distribution)
+
+	return distribution, ok
+}
+
+// GetHoldings snapshots the holders of the ownable as splits ordered by the IDs of their owners, the value held in escrow being credited to
+// the owners of the escrows rather than to their holders, it gives up once more than the limit of splits and escrows hold the ownable so
+// that the snapshot is bounded
+func GetHoldings(collection helpers.Collection, ownableID ids.ID, limit int) ([]mappables.Split, bool) {
+	holderIDs := make(map[string]ids.ID)
+	values := make(map[string]sdkTypes.Dec)
+	count := 0
+
+	credit := func(holderID ids.ID, value sdkTypes.Dec) {
+		if _, ok := values[holderID.String()]; !ok {
+			holderIDs[holderID.String()] = holderID
+			values[holderID.String()] = sdkTypes.ZeroDec()
+		}
+
+		values[holderID.String()] = values[holderID.String()].Add(value)
+	}
+
+	collection.IterateIndex(mapper.OwnableIDIndex, ownableID.Bytes(), func(mappable helpers.Mappable) bool {
+		if count++; count > limit {
+			return true
+		}
+
+		split := mappable.(mappables.Split)
+		credit(split.GetOwnerID(), split.GetValue())
+
+		return false
+	})
+
+	collection.IterateIndex(mapper.EscrowOwnableIDIndex, ownableID.Bytes(), func(mappable helpers.Mappable) bool {
+		if count++; count > limit {
+			return true
+		}
+
+		escrow := mappable.(mappables.Escrow)
+		credit(escrow.GetHolderID(), escrow.GetValue().Neg())
+		credit(escrow.GetOwnerID(), escrow.GetValue())
+
+		return false
+	})
+
+	if count > limit {
+		return nil, false
+	}
+
+	holderIDStrings := make([]string, 0, len(values))
+
+	for holderIDString, value := range values {
+		if value.IsPositive() {
+			holderIDStrings = append(holderIDStrings, holderIDString)
+		}
+	}
+
+	sort.Strings(holderIDStrings)
+
+	holdings := make([]mappables.Split, len(holderIDStrings))
+	for i, holderIDString := range holderIDStrings {
+		holdings[i] = mappable.NewSplit(key.NewSplitID(holderIDs[holderIDString], ownableID), values[holderIDString])
+	}
+
+	return holdings, true
+}
+
+// GetShares divides the pool between the holdings in proportion to their values, every share is rounded down so that the shares never add
+// up to more than the pool and the remainder does not depend on the order the holdings are paid in
+func GetShares(holdings []mappables.Split, pool sdkTypes.Dec) []sdkTypes.Dec {
+	total := sdkTypes.ZeroDec()
+	for _, holding := range holdings {
+		total = total.Add(holding.GetValue())
+	}
+
+	shares := make([]sdkTypes.Dec, len(holdings))
+
+	for i, holding := range holdings {
+		shares[i] = GetShare(pool, holding.GetValue(), total)
+	}
+
+	return shares
+}
+
+// GetShare returns the part of the pool the holding makes up of the total, rounded down
+func GetShare(pool sdkTypes.Dec, holding sdkTypes.Dec, total sdkTypes.Dec) sdkTypes.Dec {
+	if !total.IsPositive() || !holding.IsPositive() {
+		return sdkTypes.ZeroDec()
+	}
+
+	return pool.MulTruncate(holding).QuoTruncate(total)
+}
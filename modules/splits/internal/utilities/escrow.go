@@ -0,0 +1,109 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/types"
+)
+
+func GetEscrow(collection helpers.Collection, holderID ids.ID, referenceID ids.ID) (mappables.Escrow, bool) {
+	escrowKey := key.FromEscrowID(key.NewEscrowID(holderID, referenceID))
+
+	escrow, ok := collection.Fetch(escrowKey).Get(escrowKey).(mappables.Escrow)
+
+	return escrow, ok
+}
+
+// LockEscrow moves the value from the part of the split of the owner its vesting schedules no longer lock at the height to the split of the
+// holder and adds it to the escrow of the reference, which holds a single ownable of a single owner, the holding of the holder does not
+// change as the value it gains is held for the owner
+func LockEscrow(collection helpers.Collection, holderID ids.ID, referenceID ids.ID, ownerID ids.ID, ownableID ids.ID, value sdkTypes.Dec, height types.Height) (helpers.Collection, error) {
+	escrow, found := GetEscrow(collection, holderID, referenceID)
+	if found && (escrow.GetOwnerID().Compare(ownerID) != 0 || escrow.GetOwnableID().Compare(ownableID) != 0) {
+		return nil, errors.NotAuthorized
+	}
+
+	if _, err := SubtractUnlockedSplits(collection, ownerID, ownableID, value, height); err != nil {
+		return nil, err
+	}
+
+	if _, err := addSplits(collection, holderID, ownableID, value); err != nil {
+		return nil, err
+	}
+
+	if found {
+		collection.Mutate(escrow.Increase(value))
+	} else {
+		collection.Add(mappable.NewEscrow(holderID, referenceID, ownerID, ownableID, value))
+	}
+
+	return collection, nil
+}
+
+// UnlockEscrow takes the value out of the escrow of the reference, removing it once empty, and moves it from the split of the holder to
+// the split of the recipient, it is the holding of the owner of the escrow rather than that of the holder which changes
+func UnlockEscrow(collection helpers.Collection, escrow mappables.Escrow, toID ids.ID, value sdkTypes.Dec) (helpers.Collection, error) {
+	if value.LTE(sdkTypes.ZeroDec()) {
+		return nil, errors.NotAuthorized
+	}
+
+	Checkpoint(collection, escrow.GetOwnerID(), escrow.GetOwnableID())
+
+	switch escrow = escrow.Decrease(value); {
+	case escrow.GetValue().LT(sdkTypes.ZeroDec()):
+		return nil, errors.InsufficientBalance
+	case escrow.GetValue().IsZero():
+		collection.Remove(escrow)
+	default:
+		collection.Mutate(escrow)
+	}
+
+	if _, err := subtractSplits(collection, escrow.GetHolderID(), escrow.GetOwnableID(), value); err != nil {
+		return nil, err
+	}
+
+	if _, err := AddSplits(collection, toID, escrow.GetOwnableID(), value); err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+// GetHolderEscrowValues returns the total value held in escrow by each holder for each ownable, keyed by the holder ID and then the
+// ownable ID
+func GetHolderEscrowValues(collection helpers.Collection) map[string]map[string]sdkTypes.Dec {
+	escrowValues := make(map[string]map[string]sdkTypes.Dec)
+
+	collection.Iterate(
+		key.EscrowPrototype(),
+		func(mappable helpers.Mappable) bool {
+			escrow := mappable.(mappables.Escrow)
+
+			ownableValues, ok := escrowValues[escrow.GetHolderID().String()]
+			if !ok {
+				ownableValues = make(map[string]sdkTypes.Dec)
+				escrowValues[escrow.GetHolderID().String()] = ownableValues
+			}
+
+			value, ok := ownableValues[escrow.GetOwnableID().String()]
+			if !ok {
+				value = sdkTypes.ZeroDec()
+			}
+
+			ownableValues[escrow.GetOwnableID().String()] = value.Add(escrow.GetValue())
+
+			return false
+		},
+	)
+
+	return escrowValues
+}
@@ -15,11 +15,31 @@ import (
 	"github.com/AssetMantle/modules/schema/helpers"
 )
 
+// AddSplits adds the value to the split of the owner, keeping the holding of the owner as of the latest distribution to the holders of the
+// ownable first
 func AddSplits(splits helpers.Collection, ownerID ids.ID, ownableID ids.ID, value sdkTypes.Dec) (helpers.Collection, error) {
 	if value.LTE(sdkTypes.ZeroDec()) {
 		return nil, errors.NotAuthorized
 	}
 
+	return addSplits(Checkpoint(splits, ownerID, ownableID), ownerID, ownableID, value)
+}
+
+// SubtractSplits subtracts the value from the split of the owner, keeping the holding of the owner as of the latest distribution to the
+// holders of the ownable first
+func SubtractSplits(splits helpers.Collection, ownerID ids.ID, ownableID ids.ID, value sdkTypes.Dec) (helpers.Collection, error) {
+	if value.LTE(sdkTypes.ZeroDec()) {
+		return nil, errors.NotAuthorized
+	}
+
+	return subtractSplits(Checkpoint(splits, ownerID, ownableID), ownerID, ownableID, value)
+}
+
+func addSplits(splits helpers.Collection, ownerID ids.ID, ownableID ids.ID, value sdkTypes.Dec) (helpers.Collection, error) {
+	if value.LTE(sdkTypes.ZeroDec()) {
+		return nil, errors.NotAuthorized
+	}
+
 	splitID := key.NewSplitID(ownerID, ownableID)
 
 	split := splits.Fetch(key.FromID(splitID)).Get(key.FromID(splitID))
@@ -32,7 +52,7 @@ func AddSplits(splits helpers.Collection, ownerID ids.ID, ownableID ids.ID, valu
 	return splits, nil
 }
 
-func SubtractSplits(splits helpers.Collection, ownerID ids.ID, ownableID ids.ID, value sdkTypes.Dec) (helpers.Collection, error) {
+func subtractSplits(splits helpers.Collection, ownerID ids.ID, ownableID ids.ID, value sdkTypes.Dec) (helpers.Collection, error) {
 	if value.LTE(sdkTypes.ZeroDec()) {
 		return nil, errors.NotAuthorized
 	}
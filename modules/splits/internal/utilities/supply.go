@@ -0,0 +1,65 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/modules/splits/internal/mappable"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+func GetSupply(collection helpers.Collection, ownableID ids.ID) sdkTypes.Dec {
+	supplyKey := key.FromSupplyID(key.NewSupplyID(ownableID))
+
+	if supply, ok := collection.Fetch(supplyKey).Get(supplyKey).(mappables.Supply); ok {
+		return supply.GetValue()
+	}
+
+	return sdkTypes.ZeroDec()
+}
+
+func IncreaseSupply(collection helpers.Collection, ownableID ids.ID, value sdkTypes.Dec) (helpers.Collection, error) {
+	if value.LTE(sdkTypes.ZeroDec()) {
+		return nil, errors.NotAuthorized
+	}
+
+	supplyKey := key.FromSupplyID(key.NewSupplyID(ownableID))
+
+	if supply, ok := collection.Fetch(supplyKey).Get(supplyKey).(mappables.Supply); ok {
+		collection.Mutate(supply.Increase(value))
+	} else {
+		collection.Add(mappable.NewSupply(ownableID, value))
+	}
+
+	return collection, nil
+}
+
+func DecreaseSupply(collection helpers.Collection, ownableID ids.ID, value sdkTypes.Dec) (helpers.Collection, error) {
+	if value.LTE(sdkTypes.ZeroDec()) {
+		return nil, errors.NotAuthorized
+	}
+
+	supplyKey := key.FromSupplyID(key.NewSupplyID(ownableID))
+
+	supply, ok := collection.Fetch(supplyKey).Get(supplyKey).(mappables.Supply)
+	if !ok {
+		return nil, errors.EntityNotFound
+	}
+
+	switch supply = supply.Decrease(value); {
+	case supply.GetValue().LT(sdkTypes.ZeroDec()):
+		return nil, errors.InsufficientBalance
+	case supply.GetValue().IsZero():
+		collection.Remove(supply)
+	default:
+		collection.Mutate(supply)
+	}
+
+	return collection, nil
+}
@@ -0,0 +1,72 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/modules/splits/internal/key"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+	"github.com/AssetMantle/modules/schema/types"
+)
+
+// GetVestings returns the vesting schedules on the split of the owner, or on all the splits of the owner if the ownable ID is empty
+func GetVestings(collection helpers.Collection, ownerID ids.ID, ownableID ids.ID) []mappables.Vesting {
+	var vestings []mappables.Vesting
+
+	// the partial key prefixes by bytes, so schedules of owners and ownables that merely start with the same bytes are filtered out
+	collection.Iterate(
+		key.FromVestingID(key.NewVestingID(ownerID, ownableID, baseIDs.NewID(""), 0)),
+		func(mappable helpers.Mappable) bool {
+			vesting := mappable.(mappables.Vesting)
+
+			if vesting.GetOwnerID().Compare(ownerID) == 0 && (len(ownableID.Bytes()) == 0 || vesting.GetOwnableID().Compare(ownableID) == 0) {
+				vestings = append(vestings, vesting)
+			}
+
+			return false
+		},
+	)
+
+	return vestings
+}
+
+// GetLockedValue returns the value of the split of the owner that its vesting schedules still lock at the height
+func GetLockedValue(collection helpers.Collection, ownerID ids.ID, ownableID ids.ID, height types.Height) sdkTypes.Dec {
+	locked := sdkTypes.ZeroDec()
+
+	for _, vesting := range GetVestings(collection, ownerID, ownableID) {
+		locked = locked.Add(vesting.GetLocked(height))
+	}
+
+	return locked
+}
+
+// SubtractUnlockedSplits subtracts the value from the split of the owner only out of the part its vesting schedules no longer lock at the
+// height, removing the schedules that have fully vested along the way
+func SubtractUnlockedSplits(collection helpers.Collection, ownerID ids.ID, ownableID ids.ID, value sdkTypes.Dec, height types.Height) (helpers.Collection, error) {
+	locked := sdkTypes.ZeroDec()
+
+	for _, vesting := range GetVestings(collection, ownerID, ownableID) {
+		if vesting.GetLocked(height).IsZero() {
+			collection.Remove(vesting)
+		} else {
+			locked = locked.Add(vesting.GetLocked(height))
+		}
+	}
+
+	if locked.IsPositive() {
+		splitKey := key.FromID(key.NewSplitID(ownerID, ownableID))
+
+		if split, ok := collection.Fetch(splitKey).Get(splitKey).(mappables.Split); ok && split.GetValue().Sub(value).LT(locked) {
+			return nil, errors.InsufficientBalance
+		}
+	}
+
+	return SubtractSplits(collection, ownerID, ownableID, value)
+}
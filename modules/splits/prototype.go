@@ -7,7 +7,9 @@ import (
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries"
 	"github.com/AssetMantle/modules/modules/splits/internal/block"
 	"github.com/AssetMantle/modules/modules/splits/internal/genesis"
+	"github.com/AssetMantle/modules/modules/splits/internal/invariants"
 	"github.com/AssetMantle/modules/modules/splits/internal/mapper"
+	"github.com/AssetMantle/modules/modules/splits/internal/migrations"
 	"github.com/AssetMantle/modules/modules/splits/internal/module"
 	"github.com/AssetMantle/modules/modules/splits/internal/parameters"
 	"github.com/AssetMantle/modules/modules/splits/internal/queries"
@@ -28,5 +30,7 @@ func Prototype() helpers.Module {
 		simulator.Prototype,
 		transactions.Prototype,
 		block.Prototype,
+		invariants.Prototype,
+		migrations.Prototype,
 	)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/spf13/cobra"
 	tendermintABCITypes "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tendermintTypes "github.com/tendermint/tendermint/types"
@@ -24,9 +25,14 @@ type Application interface {
 	GetDefaultClientHome() string
 	GetModuleBasicManager() module.BasicManager
 	GetCodec() *codec.Codec
+	// GetMigrateCommand returns the command upgrading the exported genesis of a module of the application to its consensus version
+	GetMigrateCommand() *cobra.Command
 
 	LoadHeight(int64) error
 	ExportApplicationStateAndValidators(bool, []string) (json.RawMessage, []tendermintTypes.GenesisValidator, error)
+	// SetUpgradeHandler runs the store migrations of the modules when the upgrade plan of the name is applied, the node calls it on the
+	// application returned by Initialize for the name of every upgrade plan the binary carries the migrations of, before the upgrade height
+	SetUpgradeHandler(string)
 
 	Name() string
 	AppVersion() string
@@ -29,6 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -38,6 +39,7 @@ import (
 	"honnef.co/go/tools/version"
 
 	"github.com/AssetMantle/modules/modules/assets"
+	"github.com/AssetMantle/modules/modules/assets/auxiliaries/royalty"
 	"github.com/AssetMantle/modules/modules/classifications"
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/conform"
 	"github.com/AssetMantle/modules/modules/classifications/auxiliaries/define"
@@ -55,12 +57,19 @@ import (
 	"github.com/AssetMantle/modules/modules/metas/auxiliaries/supplement"
 	"github.com/AssetMantle/modules/modules/orders"
 	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/balance"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/burn"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/lock"
 	splitsMint "github.com/AssetMantle/modules/modules/splits/auxiliaries/mint"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/release"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/renumerate"
+	"github.com/AssetMantle/modules/modules/splits/auxiliaries/settle"
 	"github.com/AssetMantle/modules/modules/splits/auxiliaries/transfer"
 	"github.com/AssetMantle/modules/schema"
 	"github.com/AssetMantle/modules/schema/applications"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/utilities/governance"
+	"github.com/AssetMantle/modules/utilities/migration"
 	wasmUtilities "github.com/AssetMantle/modules/utilities/wasm"
 )
 
@@ -81,8 +90,10 @@ type application struct {
 	slashingKeeper     slashing.Keeper
 	distributionKeeper distribution.Keeper
 	crisisKeeper       crisis.Keeper
+	upgradeKeeper      upgrade.Keeper
 
-	moduleManager *module.Manager
+	moduleManager     *module.Manager
+	simulationManager *module.SimulationManager
 
 	baseapp.BaseApp
 }
@@ -101,6 +112,9 @@ func (application application) GetModuleBasicManager() module.BasicManager {
 func (application application) GetCodec() *codec.Codec {
 	return application.codec
 }
+func (application application) GetMigrateCommand() *cobra.Command {
+	return migration.GetMigrateCmd(application.moduleBasicManager, application.codec)
+}
 func (application application) LoadHeight(height int64) error {
 	return application.BaseApp.LoadVersion(height, application.keys[baseapp.MainStoreKey])
 }
@@ -222,6 +236,18 @@ func (application application) ExportApplicationStateAndValidators(forZeroHeight
 	return applicationState, staking.WriteValidators(context, application.stakingKeeper), nil
 }
 
+func (application application) SetUpgradeHandler(name string) {
+	application.upgradeKeeper.SetUpgradeHandler(name, func(context sdkTypes.Context, _ upgrade.Plan) {
+		for _, moduleName := range application.moduleManager.OrderInitGenesis {
+			if Module, ok := application.moduleManager.Modules[moduleName].(helpers.Module); ok {
+				if err := Module.Migrate(context); err != nil {
+					panic(err)
+				}
+			}
+		}
+	})
+}
+
 func (application application) Initialize(logger log.Logger, db tendermintDB.DB, traceStore io.Writer, loadLatest bool, invCheckPeriod uint, skipUpgradeHeights map[int64]bool, home string, baseAppOptions ...func(*baseapp.BaseApp)) applications.Application {
 	application.BaseApp = *baseapp.NewBaseApp(
 		application.name,
@@ -331,7 +357,7 @@ func (application application) Initialize(logger log.Logger, db tendermintDB.DB,
 		supplyKeeper,
 		auth.FeeCollectorName,
 	)
-	upgradeKeeper := upgrade.NewKeeper(
+	application.upgradeKeeper = upgrade.NewKeeper(
 		skipUpgradeHeights,
 		application.keys[upgrade.StoreKey],
 		application.codec,
@@ -405,12 +431,18 @@ func (application application) Initialize(logger log.Logger, db tendermintDB.DB,
 		application.keys[orders.Prototype().Name()],
 		paramsKeeper.Subspace(orders.Prototype().Name()),
 		identitiesModule.GetAuxiliary(authenticate.Auxiliary.GetName()),
+		splitsModule.GetAuxiliary(balance.Auxiliary.GetName()),
 		classificationsModule.GetAuxiliary(conform.Auxiliary.GetName()),
 		classificationsModule.GetAuxiliary(define.Auxiliary.GetName()),
 		maintainersModule.GetAuxiliary(deputize.Auxiliary.GetName()),
+		splitsModule.GetAuxiliary(lock.Auxiliary.GetName()),
 		maintainersModule.GetAuxiliary(maintain.Auxiliary.GetName()),
+		classificationsModule.GetAuxiliary(member.Auxiliary.GetName()),
+		splitsModule.GetAuxiliary(release.Auxiliary.GetName()),
 		maintainersModule.GetAuxiliary(revoke.Auxiliary.GetName()),
+		assetsModule.GetAuxiliary(royalty.Auxiliary.GetName()),
 		metasModule.GetAuxiliary(scrub.Auxiliary.GetName()),
+		splitsModule.GetAuxiliary(settle.Auxiliary.GetName()),
 		maintainersModule.GetAuxiliary(super.Auxiliary.GetName()),
 		metasModule.GetAuxiliary(supplement.Auxiliary.GetName()),
 		splitsModule.GetAuxiliary(transfer.Auxiliary.GetName()),
@@ -459,7 +491,10 @@ func (application application) Initialize(logger log.Logger, db tendermintDB.DB,
 		distribution.NewCommunityPoolSpendProposalHandler(application.distributionKeeper),
 	).AddRoute(
 		upgrade.RouterKey,
-		upgrade.NewSoftwareUpgradeProposalHandler(upgradeKeeper),
+		upgrade.NewSoftwareUpgradeProposalHandler(application.upgradeKeeper),
+	).AddRoute(
+		governance.RouterKey,
+		governance.NewProposalHandler(assetsModule, classificationsModule, identitiesModule, maintainersModule, metasModule, ordersModule, splitsModule),
 	)
 
 	if len(application.enabledWasmProposalTypeList) != 0 {
@@ -486,7 +521,7 @@ func (application application) Initialize(logger log.Logger, db tendermintDB.DB,
 		slashing.NewAppModule(application.slashingKeeper, accountKeeper, application.stakingKeeper),
 		distribution.NewAppModule(application.distributionKeeper, accountKeeper, supplyKeeper, application.stakingKeeper),
 		staking.NewAppModule(application.stakingKeeper, accountKeeper, supplyKeeper),
-		upgrade.NewAppModule(upgradeKeeper),
+		upgrade.NewAppModule(application.upgradeKeeper),
 		wasm.NewAppModule(wasmKeeper),
 		evidence.NewAppModule(*evidenceKeeper),
 
@@ -520,7 +555,6 @@ func (application application) Initialize(logger log.Logger, db tendermintDB.DB,
 		gov.ModuleName,
 		mint.ModuleName,
 		supply.ModuleName,
-		crisis.ModuleName,
 		genutil.ModuleName,
 		evidence.ModuleName,
 		wasm.ModuleName,
@@ -531,11 +565,13 @@ func (application application) Initialize(logger log.Logger, db tendermintDB.DB,
 		metas.Prototype().Name(),
 		orders.Prototype().Name(),
 		splits.Prototype().Name(),
+		// crisis asserts the invariants of every module, so it is initialized once their genesis is in place
+		crisis.ModuleName,
 	)
 	application.moduleManager.RegisterInvariants(&application.crisisKeeper)
 	application.moduleManager.RegisterRoutes(application.BaseApp.Router(), application.BaseApp.QueryRouter())
 
-	module.NewSimulationManager(
+	application.simulationManager = module.NewSimulationManager(
 		auth.NewAppModule(accountKeeper),
 		bank.NewAppModule(bankKeeper, accountKeeper),
 		supply.NewAppModule(supplyKeeper, accountKeeper),
@@ -545,14 +581,15 @@ func (application application) Initialize(logger log.Logger, db tendermintDB.DB,
 		distribution.NewAppModule(application.distributionKeeper, accountKeeper, supplyKeeper, application.stakingKeeper),
 		slashing.NewAppModule(application.slashingKeeper, accountKeeper, application.stakingKeeper),
 		params.NewAppModule(),
-		assets.Prototype(),
-		classifications.Prototype(),
-		identities.Prototype(),
-		maintainers.Prototype(),
-		metas.Prototype(),
-		orders.Prototype(),
-		splits.Prototype(),
-	).RegisterStoreDecoders()
+		assetsModule,
+		classificationsModule,
+		identitiesModule,
+		maintainersModule,
+		metasModule,
+		ordersModule,
+		splitsModule,
+	)
+	application.simulationManager.RegisterStoreDecoders()
 
 	application.BaseApp.MountKVStores(application.keys)
 	application.BaseApp.MountTransientStores(transientStoreKeys)
@@ -579,6 +616,7 @@ func makeCodec(moduleBasicManager module.BasicManager) *codec.Codec {
 	Codec := codec.New()
 	moduleBasicManager.RegisterCodec(Codec)
 	schema.RegisterCodec(Codec)
+	governance.RegisterCodec(Codec)
 	sdkTypes.RegisterCodec(Codec)
 	codec.RegisterCrypto(Codec)
 	codec.RegisterEvidences(Codec)
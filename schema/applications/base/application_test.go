@@ -0,0 +1,107 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package base
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/keys"
+	"github.com/AssetMantle/modules/modules/assets"
+	"github.com/AssetMantle/modules/modules/classifications"
+	"github.com/AssetMantle/modules/modules/identities"
+	"github.com/AssetMantle/modules/modules/maintainers"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/orders"
+	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/utilities/governance"
+)
+
+func TestApplication_SetUpgradeHandler(t *testing.T) {
+	moduleBasicManager := module.NewBasicManager(
+		genutil.AppModuleBasic{},
+		auth.AppModuleBasic{},
+		bank.AppModuleBasic{},
+		staking.AppModuleBasic{},
+		mint.AppModuleBasic{},
+		distribution.AppModuleBasic{},
+		gov.NewAppModuleBasic(governance.ProposalHandler),
+		params.AppModuleBasic{},
+		crisis.AppModuleBasic{},
+		wasm.AppModuleBasic{},
+		slashing.AppModuleBasic{},
+		supply.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
+		evidence.AppModuleBasic{},
+
+		assets.Prototype(),
+		classifications.Prototype(),
+		identities.Prototype(),
+		maintainers.Prototype(),
+		metas.Prototype(),
+		orders.Prototype(),
+		splits.Prototype(),
+	)
+	moduleAccountPermissions := map[string][]string{
+		auth.FeeCollectorName:     nil,
+		distribution.ModuleName:   nil,
+		mint.ModuleName:           {supply.Minter},
+		staking.BondedPoolName:    {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+		gov.ModuleName:            {supply.Burner},
+		splits.Prototype().Name(): nil,
+	}
+
+	Application := NewApplication("test", moduleBasicManager, wasm.EnableAllProposals, moduleAccountPermissions, map[string]bool{}).Initialize(log.NewNopLogger(), tendermintDB.NewMemDB(), nil, true, 0, map[int64]bool{}, t.TempDir()).(*application)
+
+	stateBytes, err := codec.MarshalJSONIndent(Application.codec, moduleBasicManager.DefaultGenesis())
+	require.Nil(t, err)
+	Application.InitChain(abciTypes.RequestInitChain{AppStateBytes: stateBytes})
+
+	context := Application.BaseApp.NewContext(false, abciTypes.Header{Height: 1})
+
+	getVersion := func(moduleName string) uint64 {
+		versionBytes := context.KVStore(Application.keys[moduleName]).Get(keys.Versions.GenerateStoreKey([]byte(moduleName)))
+		if versionBytes == nil {
+			return 0
+		}
+
+		return binary.BigEndian.Uint64(versionBytes)
+	}
+
+	// the stores of the modules are rolled back to before they were versioned, as written by a binary without migrations
+	for _, moduleName := range []string{orders.Prototype().Name(), splits.Prototype().Name()} {
+		context.KVStore(Application.keys[moduleName]).Delete(keys.Versions.GenerateStoreKey([]byte(moduleName)))
+	}
+
+	Application.SetUpgradeHandler("test")
+	require.Equal(t, true, Application.upgradeKeeper.HasHandler("test"))
+
+	Application.upgradeKeeper.ApplyUpgrade(context, upgrade.Plan{Name: "test", Height: 1})
+
+	require.Equal(t, orders.Prototype().ConsensusVersion(), getVersion(orders.Prototype().Name()))
+	require.Equal(t, splits.Prototype().ConsensusVersion(), getVersion(splits.Prototype().Name()))
+	require.Equal(t, int64(1), Application.upgradeKeeper.GetDoneHeight(context, "test"))
+}
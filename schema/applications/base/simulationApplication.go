@@ -5,6 +5,7 @@ package base
 
 import (
 	"encoding/json"
+	"io"
 	"os"
 	"testing"
 
@@ -38,10 +39,9 @@ import (
 )
 
 type SimulationApplication struct {
-	application
+	*application
 
 	transientStoreKeys map[string]*sdkTypes.TransientStoreKey
-	simulationManager  *module.SimulationManager
 	subspaces          map[string]params.Subspace
 
 	AccountKeeper      auth.AccountKeeper
@@ -89,7 +89,7 @@ func (simulationApplication SimulationApplication) ModuleAccountAddrs() map[stri
 }
 
 func (simulationApplication SimulationApplication) SimulationManager() *module.SimulationManager {
-	return simulationApplication.simulationManager
+	return simulationApplication.application.simulationManager
 }
 
 func (simulationApplication SimulationApplication) ModuleManager() *module.Manager {
@@ -156,6 +156,11 @@ func (simulationApplication SimulationApplication) AddTestAddresses(context sdkT
 	return testAddresses
 }
 
+func (simulationApplication SimulationApplication) Initialize(logger log.Logger, db tendermintDB.DB, traceStore io.Writer, loadLatest bool, invCheckPeriod uint, skipUpgradeHeights map[int64]bool, home string, baseAppOptions ...func(*baseapp.BaseApp)) applications.Application {
+	simulationApplication.application = simulationApplication.application.Initialize(logger, db, traceStore, loadLatest, invCheckPeriod, skipUpgradeHeights, home, baseAppOptions...).(*application)
+	return &simulationApplication
+}
+
 func (simulationApplication SimulationApplication) Setup(isCheckTx bool) applications.SimulationApplication {
 	db := tendermintDB.NewMemDB()
 	newSimulationApplication := simulationApplication.Initialize(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0, map[int64]bool{}, simulationApplication.GetDefaultNodeHome()).(*SimulationApplication)
@@ -220,7 +225,7 @@ func (simulationApplication SimulationApplication) NewTestApplication(isCheckTx
 
 func NewSimulationApplication(name string, moduleBasicManager module.BasicManager, enabledWasmProposalTypeList []wasm.ProposalType, moduleAccountPermissions map[string][]string, tokenReceiveAllowedModules map[string]bool) applications.SimulationApplication {
 	return &SimulationApplication{
-		application: application{
+		application: &application{
 			name:                        name,
 			moduleBasicManager:          moduleBasicManager,
 			codec:                       makeCodec(moduleBasicManager),
@@ -2,3 +2,14 @@
 // SPDX-License-Identifier: Apache-2.0
 
 package capabilities
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/types"
+)
+
+type Limitable interface {
+	GetVested(types.Height) sdkTypes.Dec
+	GetLocked(types.Height) sdkTypes.Dec
+}
@@ -35,6 +35,9 @@ func (collection collection) GetList() []helpers.Mappable {
 func (collection collection) Iterate(partialKey helpers.Key, accumulator func(helpers.Mappable) bool) {
 	collection.mapper.Iterate(collection.context, partialKey, accumulator)
 }
+func (collection collection) IterateIndex(index helpers.Index, indexKeyBytes []byte, accumulator func(helpers.Mappable) bool) {
+	collection.mapper.IterateIndex(collection.context, index, indexKeyBytes, accumulator)
+}
 func (collection collection) Fetch(key helpers.Key) helpers.Collection {
 	var mappableList []helpers.Mappable
 
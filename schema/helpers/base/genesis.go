@@ -20,6 +20,8 @@ type genesis struct {
 	keyPrototype      func() helpers.Key
 	mappablePrototype func() helpers.Mappable
 
+	recordKeyPrototypeList []func() helpers.Key
+
 	defaultMappableList  []helpers.Mappable
 	defaultParameterList []parameters2.Parameter
 
@@ -78,6 +80,10 @@ func (genesis genesis) Export(context sdkTypes.Context, mapper helpers.Mapper, p
 	}
 	mapper.Iterate(context, genesis.keyPrototype(), appendMappableList)
 
+	for _, recordKeyPrototype := range genesis.recordKeyPrototypeList {
+		mapper.Iterate(context, recordKeyPrototype(), appendMappableList)
+	}
+
 	for _, defaultParameter := range genesis.defaultParameterList {
 		parameters = parameters.Fetch(context, defaultParameter.GetID())
 	}
@@ -98,7 +104,7 @@ func (genesis genesis) Decode(byte []byte) helpers.Genesis {
 		panic(err)
 	}
 
-	return NewGenesis(genesis.keyPrototype, genesis.mappablePrototype, genesis.defaultMappableList, genesis.defaultParameterList).Initialize(newGenesis.MappableList, newGenesis.ParameterList)
+	return NewGenesis(genesis.keyPrototype, genesis.mappablePrototype, genesis.defaultMappableList, genesis.defaultParameterList, genesis.recordKeyPrototypeList...).Initialize(newGenesis.MappableList, newGenesis.ParameterList)
 }
 func (genesis genesis) Initialize(mappableList []helpers.Mappable, parameterList []parameters2.Parameter) helpers.Genesis {
 	if len(mappableList) == 0 {
@@ -134,7 +140,8 @@ func (genesis genesis) GetMappableList() []helpers.Mappable {
 	return genesis.MappableList
 }
 
-func NewGenesis(keyPrototype func() helpers.Key, mappablePrototype func() helpers.Mappable, defaultMappableList []helpers.Mappable, defaultParameterList []parameters2.Parameter) helpers.Genesis {
+// NewGenesis creates the genesis of a module store, recordKeyPrototypeList holds the partial keys of any records kept in the store besides the mappable prototype
+func NewGenesis(keyPrototype func() helpers.Key, mappablePrototype func() helpers.Mappable, defaultMappableList []helpers.Mappable, defaultParameterList []parameters2.Parameter, recordKeyPrototypeList ...func() helpers.Key) helpers.Genesis {
 	Codec := codec.New()
 	keyPrototype().RegisterCodec(Codec)
 	mappablePrototype().RegisterCodec(Codec)
@@ -142,12 +149,13 @@ func NewGenesis(keyPrototype func() helpers.Key, mappablePrototype func() helper
 	Codec.Seal()
 
 	return genesis{
-		codec:                Codec,
-		keyPrototype:         keyPrototype,
-		mappablePrototype:    mappablePrototype,
-		defaultMappableList:  defaultMappableList,
-		defaultParameterList: defaultParameterList,
-		MappableList:         []helpers.Mappable{},
-		ParameterList:        []parameters2.Parameter{},
+		codec:                  Codec,
+		keyPrototype:           keyPrototype,
+		mappablePrototype:      mappablePrototype,
+		recordKeyPrototypeList: recordKeyPrototypeList,
+		defaultMappableList:    defaultMappableList,
+		defaultParameterList:   defaultParameterList,
+		MappableList:           []helpers.Mappable{},
+		ParameterList:          []parameters2.Parameter{},
 	}
 }
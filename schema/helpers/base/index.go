@@ -0,0 +1,35 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package base
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+type index struct {
+	name                  string
+	generateIndexKeyBytes func(helpers.Mappable) []byte
+}
+
+var _ helpers.Index = (*index)(nil)
+
+func (index index) GetName() string { return index.name }
+func (index index) GenerateIndexKeyBytes(mappable helpers.Mappable) []byte {
+	return index.generateIndexKeyBytes(mappable)
+}
+
+// NewIndex creates an index of the given name, which panics on names too long for the length prefix of index entries
+func NewIndex(name string, generateIndexKeyBytes func(helpers.Mappable) []byte) helpers.Index {
+	if len(name) > math.MaxUint8 {
+		panic(fmt.Errorf("index name of %d bytes exceeds %d bytes", len(name), math.MaxUint8))
+	}
+
+	return index{
+		name:                  name,
+		generateIndexKeyBytes: generateIndexKeyBytes,
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package base
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+type invariants struct {
+	moduleName    string
+	invariantList []helpers.Invariant
+}
+
+var _ helpers.Invariants = (*invariants)(nil)
+
+func (invariants invariants) Get(name string) helpers.Invariant {
+	for _, invariant := range invariants.invariantList {
+		if invariant.GetName() == name {
+			return invariant
+		}
+	}
+
+	return nil
+}
+func (invariants invariants) GetList() []helpers.Invariant {
+	return invariants.invariantList
+}
+func (invariants invariants) Register(invariantRegistry sdkTypes.InvariantRegistry) {
+	for _, invariant := range invariants.invariantList {
+		invariantRegistry.RegisterRoute(invariants.moduleName, invariant.GetName(), invariants.route(invariant))
+	}
+}
+func (invariants invariants) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, auxiliaryKeepers ...interface{}) helpers.Invariants {
+	invariantList := make([]helpers.Invariant, len(invariants.invariantList))
+
+	for i, invariant := range invariants.invariantList {
+		invariantList[i] = invariant.Initialize(mapper, parameters, auxiliaryKeepers...)
+	}
+
+	invariants.invariantList = invariantList
+
+	return invariants
+}
+func (invariants invariants) route(invariant helpers.Invariant) sdkTypes.Invariant {
+	return func(context sdkTypes.Context) (string, bool) {
+		message, broken := invariant.Check(context)
+		return sdkTypes.FormatInvariant(invariants.moduleName, invariant.GetName(), message), broken
+	}
+}
+
+func NewInvariants(moduleName string, invariantList ...helpers.Invariant) helpers.Invariants {
+	return invariants{
+		moduleName:    moduleName,
+		invariantList: invariantList,
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package base
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	helpersTestUtilities "github.com/AssetMantle/modules/utilities/test/schema/helpers"
+	baseTestUtilities "github.com/AssetMantle/modules/utilities/test/schema/helpers/base"
+)
+
+func TestInvariants(t *testing.T) {
+	context, storeKey, _ := baseTestUtilities.SetupTest(t)
+
+	Invariants := NewInvariants("test", helpersTestUtilities.TestInvariantPrototype()).Initialize(mapperPrototype().Initialize(storeKey), parametersPrototype())
+
+	require.Equal(t, 1, len(Invariants.GetList()))
+	require.Equal(t, "testInvariant", Invariants.Get("testInvariant").GetName())
+	require.Nil(t, Invariants.Get("missingInvariant"))
+
+	invariantRegistry := helpersTestUtilities.NewTestInvariantRegistry()
+	Invariants.Register(invariantRegistry)
+
+	route := invariantRegistry.Get("test", "testInvariant")
+	require.NotNil(t, route)
+
+	message, broken := route(context)
+	require.False(t, broken)
+	require.Contains(t, message, "test: testInvariant invariant")
+}
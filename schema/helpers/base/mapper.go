@@ -5,12 +5,15 @@ package base
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/kv"
 
+	"github.com/AssetMantle/modules/constants/keys"
 	"github.com/AssetMantle/modules/schema"
 	"github.com/AssetMantle/modules/schema/helpers"
 )
@@ -20,6 +23,7 @@ type mapper struct {
 	codec             *codec.Codec
 	keyPrototype      func() helpers.Key
 	mappablePrototype func() helpers.Mappable
+	indexList         []helpers.Index
 }
 
 var _ helpers.Mapper = (*mapper)(nil)
@@ -32,9 +36,7 @@ func (mapper mapper) GetKVStoreKey() *sdkTypes.KVStoreKey {
 	return mapper.kvStoreKey
 }
 func (mapper mapper) Create(context sdkTypes.Context, mappable helpers.Mappable) {
-	Bytes := mapper.codec.MustMarshalBinaryBare(mappable)
-	kvStore := context.KVStore(mapper.kvStoreKey)
-	kvStore.Set(mappable.GetKey().GenerateStoreKeyBytes(), Bytes)
+	mapper.set(context, mappable)
 }
 func (mapper mapper) Read(context sdkTypes.Context, key helpers.Key) helpers.Mappable {
 	kvStore := context.KVStore(mapper.kvStoreKey)
@@ -51,13 +53,17 @@ func (mapper mapper) Read(context sdkTypes.Context, key helpers.Key) helpers.Map
 	return mappable
 }
 func (mapper mapper) Update(context sdkTypes.Context, mappable helpers.Mappable) {
-	Bytes := mapper.codec.MustMarshalBinaryBare(mappable)
-	key := mappable.GetKey()
-	kvStore := context.KVStore(mapper.kvStoreKey)
-	kvStore.Set(key.GenerateStoreKeyBytes(), Bytes)
+	mapper.set(context, mappable)
 }
 func (mapper mapper) Delete(context sdkTypes.Context, key helpers.Key) {
 	kvStore := context.KVStore(mapper.kvStoreKey)
+
+	if len(mapper.indexList) != 0 {
+		if oldMappable := mapper.Read(context, key); oldMappable != nil {
+			mapper.deleteIndexEntries(kvStore, oldMappable)
+		}
+	}
+
 	kvStore.Delete(key.GenerateStoreKeyBytes())
 }
 func (mapper mapper) Iterate(context sdkTypes.Context, partialKey helpers.Key, accumulator func(helpers.Mappable) bool) {
@@ -92,7 +98,61 @@ func (mapper mapper) ReverseIterate(context sdkTypes.Context, partialKey helpers
 		}
 	}
 }
+func (mapper mapper) IterateIndex(context sdkTypes.Context, index helpers.Index, indexKeyBytes []byte, accumulator func(helpers.Mappable) bool) {
+	mapper.iterateIndex(context, index, indexKeyBytes, sdkTypes.KVStorePrefixIterator, accumulator)
+}
+
+// ReverseIterateIndex walks the entries of IterateIndex in reverse, so that the mappables indexed last under the index key bytes come first
+func (mapper mapper) ReverseIterateIndex(context sdkTypes.Context, index helpers.Index, indexKeyBytes []byte, accumulator func(helpers.Mappable) bool) {
+	mapper.iterateIndex(context, index, indexKeyBytes, sdkTypes.KVStoreReversePrefixIterator, accumulator)
+}
+
+// IterateIndexKeys calls the accumulator with the first mappable indexed under each of the index key bytes of the index, in the order of the
+// length prefixed index key bytes, seeking past the other entries under each so that the walk reads one entry per index key bytes
+func (mapper mapper) IterateIndexKeys(context sdkTypes.Context, index helpers.Index, accumulator func(helpers.Mappable) bool) {
+	store := context.KVStore(mapper.kvStoreKey)
+	indexPrefix := generateIndexPrefix(index)
+	end := sdkTypes.PrefixEndBytes(indexPrefix)
+
+	for start := indexPrefix; start != nil; {
+		var indexKeyPrefix []byte
+
+		var mappable helpers.Mappable
+
+		iterator := store.Iterator(start, end)
+
+		for ; iterator.Valid(); iterator.Next() {
+			if indexKeyPrefix == nil {
+				indexKeyPrefixLength := len(indexPrefix) + 2 + int(binary.BigEndian.Uint16(iterator.Key()[len(indexPrefix):]))
+				indexKeyPrefix = append([]byte{}, iterator.Key()[:indexKeyPrefixLength]...)
+			} else if !bytes.HasPrefix(iterator.Key(), indexKeyPrefix) {
+				break
+			}
+
+			if Bytes := store.Get(iterator.Value()); Bytes != nil {
+				mapper.codec.MustUnmarshalBinaryBare(Bytes, &mappable)
+				break
+			}
+		}
+
+		iterator.Close()
+
+		if indexKeyPrefix == nil || mappable != nil && accumulator(mappable) {
+			return
+		}
+
+		start = sdkTypes.PrefixEndBytes(indexKeyPrefix)
+	}
+}
 func (mapper mapper) StoreDecoder(_ *codec.Codec, kvA kv.Pair, kvB kv.Pair) string {
+	if bytes.HasPrefix(kvA.Key, keys.Indexes.GenerateStoreKey(nil)) {
+		return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+	}
+
+	if bytes.HasPrefix(kvA.Key, keys.Versions.GenerateStoreKey(nil)) {
+		return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+	}
+
 	if bytes.Equal(kvA.Key[:1], mapper.keyPrototype().GenerateStoreKeyBytes()) {
 		var mappableA helpers.Mappable
 
@@ -107,11 +167,89 @@ func (mapper mapper) StoreDecoder(_ *codec.Codec, kvA kv.Pair, kvB kv.Pair) stri
 
 	panic(fmt.Errorf("invalid key prefix %X", kvA.Key[:1]))
 }
+func (mapper mapper) RegisterCodec(codec *codec.Codec) {
+	mapper.keyPrototype().RegisterCodec(codec)
+	mapper.mappablePrototype().RegisterCodec(codec)
+}
 func (mapper mapper) Initialize(kvStoreKey *sdkTypes.KVStoreKey) helpers.Mapper {
 	mapper.kvStoreKey = kvStoreKey
 	return mapper
 }
-func NewMapper(keyPrototype func() helpers.Key, mappablePrototype func() helpers.Mappable) helpers.Mapper {
+func (mapper mapper) set(context sdkTypes.Context, mappable helpers.Mappable) {
+	Bytes := mapper.codec.MustMarshalBinaryBare(mappable)
+	key := mappable.GetKey()
+	kvStore := context.KVStore(mapper.kvStoreKey)
+
+	if len(mapper.indexList) != 0 {
+		if oldMappable := mapper.Read(context, key); oldMappable != nil {
+			mapper.deleteIndexEntries(kvStore, oldMappable)
+		}
+	}
+
+	kvStore.Set(key.GenerateStoreKeyBytes(), Bytes)
+
+	for _, index := range mapper.indexList {
+		if indexKeyBytes := index.GenerateIndexKeyBytes(mappable); len(indexKeyBytes) != 0 {
+			kvStore.Set(generateIndexEntryKey(index, indexKeyBytes, key), key.GenerateStoreKeyBytes())
+		}
+	}
+}
+func (mapper mapper) deleteIndexEntries(kvStore sdkTypes.KVStore, mappable helpers.Mappable) {
+	for _, index := range mapper.indexList {
+		if indexKeyBytes := index.GenerateIndexKeyBytes(mappable); len(indexKeyBytes) != 0 {
+			kvStore.Delete(generateIndexEntryKey(index, indexKeyBytes, mappable.GetKey()))
+		}
+	}
+}
+func (mapper mapper) iterateIndex(context sdkTypes.Context, index helpers.Index, indexKeyBytes []byte, prefixIterator func(sdkTypes.KVStore, []byte) sdkTypes.Iterator, accumulator func(helpers.Mappable) bool) {
+	store := context.KVStore(mapper.kvStoreKey)
+
+	var kvStorePrefixIterator sdkTypes.Iterator
+	if indexKeyBytes == nil {
+		kvStorePrefixIterator = prefixIterator(store, generateIndexPrefix(index))
+	} else {
+		kvStorePrefixIterator = prefixIterator(store, generateIndexKeyPrefix(index, indexKeyBytes))
+	}
+
+	defer kvStorePrefixIterator.Close()
+
+	for ; kvStorePrefixIterator.Valid(); kvStorePrefixIterator.Next() {
+		Bytes := store.Get(kvStorePrefixIterator.Value())
+		if Bytes == nil {
+			continue
+		}
+
+		var mappable helpers.Mappable
+
+		mapper.codec.MustUnmarshalBinaryBare(Bytes, &mappable)
+
+		if accumulator(mappable) {
+			break
+		}
+	}
+}
+
+// generateIndexPrefix prefixes all entries of an index, index key bytes are length prefixed so that entries are matched exactly, which
+// panics on index key bytes too long for their length prefix as that is an error of the index definition
+func generateIndexPrefix(index helpers.Index) []byte {
+	return keys.Indexes.GenerateStoreKey(append([]byte{uint8(len(index.GetName()))}, []byte(index.GetName())...))
+}
+func generateIndexKeyPrefix(index helpers.Index, indexKeyBytes []byte) []byte {
+	if len(indexKeyBytes) > math.MaxUint16 {
+		panic(fmt.Errorf("index %s key of %d bytes exceeds %d bytes", index.GetName(), len(indexKeyBytes), math.MaxUint16))
+	}
+
+	lengthBytes := make([]byte, 2)
+	binary.BigEndian.PutUint16(lengthBytes, uint16(len(indexKeyBytes)))
+
+	return append(append(generateIndexPrefix(index), lengthBytes...), indexKeyBytes...)
+}
+func generateIndexEntryKey(index helpers.Index, indexKeyBytes []byte, key helpers.Key) []byte {
+	return append(generateIndexKeyPrefix(index, indexKeyBytes), key.GenerateStoreKeyBytes()...)
+}
+
+// NewMapper creates a mapper for the mappable prototype, maintaining an entry for every mappable in each of the indexes
+func NewMapper(keyPrototype func() helpers.Key, mappablePrototype func() helpers.Mappable, indexList ...helpers.Index) helpers.Mapper {
 	Codec := codec.New()
 	keyPrototype().RegisterCodec(Codec)
 	mappablePrototype().RegisterCodec(Codec)
@@ -122,5 +260,6 @@ func NewMapper(keyPrototype func() helpers.Key, mappablePrototype func() helpers
 		codec:             Codec,
 		keyPrototype:      keyPrototype,
 		mappablePrototype: mappablePrototype,
+		indexList:         indexList,
 	}
 }
@@ -4,13 +4,17 @@
 package base
 
 import (
+	"bytes"
+	"math"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/kv"
 
+	"github.com/AssetMantle/modules/constants/keys"
 	"github.com/AssetMantle/modules/schema/helpers"
 	"github.com/AssetMantle/modules/utilities/test/schema/helpers/base"
 )
@@ -55,8 +59,98 @@ func TestMapper(t *testing.T) {
 
 	// Store Decoder
 	require.Equal(t, "{test1 value1}\n{test1 value1}", testMapper.StoreDecoder(codec.New(), kv.Pair{
-		Key: append([]byte{0x11}, []byte("test1")...), Value: testMapper.codec.MustMarshalBinaryBare(base.NewMappable("test1", "value1"))}, kv.Pair{
-		Key: append([]byte{0x11}, []byte("test1")...), Value: testMapper.codec.MustMarshalBinaryBare(base.NewMappable("test1", "value1"))}),
+		Key: append([]byte{0x01}, []byte("test1")...), Value: testMapper.codec.MustMarshalBinaryBare(base.NewMappable("test1", "value1"))}, kv.Pair{
+		Key: append([]byte{0x01}, []byte("test1")...), Value: testMapper.codec.MustMarshalBinaryBare(base.NewMappable("test1", "value1"))}),
 	)
+	require.Equal(t, "1\n2", testMapper.StoreDecoder(codec.New(), kv.Pair{
+		Key: keys.Versions.GenerateStoreKey(nil), Value: []byte{0, 0, 0, 0, 0, 0, 0, 1}}, kv.Pair{
+		Key: keys.Versions.GenerateStoreKey(nil), Value: []byte{0, 0, 0, 0, 0, 0, 0, 2}}),
+	)
+
+}
+
+func TestMapperIndex(t *testing.T) {
+	context, storeKey, _ := base.SetupTest(t)
+
+	valueIndex := NewIndex("value", func(mappable helpers.Mappable) []byte {
+		return []byte(reflect.ValueOf(mappable).FieldByName("Value").String())
+	})
+	testMapper := NewMapper(base.KeyPrototype, base.MappablePrototype, valueIndex).Initialize(storeKey)
+
+	collect := func(indexKeyBytes []byte) []helpers.Mappable {
+		var mappableList []helpers.Mappable
+
+		testMapper.IterateIndex(context, valueIndex, indexKeyBytes, func(mappable helpers.Mappable) bool {
+			mappableList = append(mappableList, mappable)
+			return false
+		})
+
+		return mappableList
+	}
+
+	testMapper.Create(context, base.NewMappable("test1", "value1"))
+	testMapper.Create(context, base.NewMappable("test2", "value1"))
+	testMapper.Create(context, base.NewMappable("test3", "value12"))
+	require.Equal(t, []helpers.Mappable{base.NewMappable("test1", "value1"), base.NewMappable("test2", "value1")}, collect([]byte("value1")))
+	require.Equal(t, 3, len(collect(nil)))
+
+	// Update
+	testMapper.Update(context, base.NewMappable("test2", "value2"))
+	require.Equal(t, []helpers.Mappable{base.NewMappable("test1", "value1")}, collect([]byte("value1")))
+	require.Equal(t, []helpers.Mappable{base.NewMappable("test2", "value2")}, collect([]byte("value2")))
+
+	// ReverseIterateIndex
+	var reverseMappableList []helpers.Mappable
+
+	testMapper.Create(context, base.NewMappable("test4", "value1"))
+	testMapper.ReverseIterateIndex(context, valueIndex, []byte("value1"), func(mappable helpers.Mappable) bool {
+		reverseMappableList = append(reverseMappableList, mappable)
+		return false
+	})
+	require.Equal(t, []helpers.Mappable{base.NewMappable("test4", "value1"), base.NewMappable("test1", "value1")}, reverseMappableList)
+	testMapper.Delete(context, base.NewKey("test4"))
+
+	// Delete
+	testMapper.Delete(context, base.NewKey("test1"))
+	require.Nil(t, collect([]byte("value1")))
+	require.Equal(t, 2, len(collect(nil)))
+
+	// IterateIndexKeys
+	testMapper.Create(context, base.NewMappable("test4", "value2"))
+	testMapper.Create(context, base.NewMappable("test5", "value3"))
+
+	var firstMappableList []helpers.Mappable
+
+	testMapper.IterateIndexKeys(context, valueIndex, func(mappable helpers.Mappable) bool {
+		firstMappableList = append(firstMappableList, mappable)
+		return false
+	})
+	require.Equal(t, []helpers.Mappable{base.NewMappable("test2", "value2"), base.NewMappable("test5", "value3"), base.NewMappable("test3", "value12")}, firstMappableList)
+
+	firstMappableList = nil
+
+	testMapper.IterateIndexKeys(context, valueIndex, func(mappable helpers.Mappable) bool {
+		firstMappableList = append(firstMappableList, mappable)
+		return true
+	})
+	require.Equal(t, []helpers.Mappable{base.NewMappable("test2", "value2")}, firstMappableList)
+}
+
+func TestMapperIndexKeyLength(t *testing.T) {
+	context, storeKey, _ := base.SetupTest(t)
 
+	lengthIndex := NewIndex("length", func(mappable helpers.Mappable) []byte {
+		return bytes.Repeat([]byte{1}, len(reflect.ValueOf(mappable).FieldByName("Value").String()))
+	})
+	testMapper := NewMapper(base.KeyPrototype, base.MappablePrototype, lengthIndex).Initialize(storeKey)
+
+	require.NotPanics(t, func() {
+		testMapper.Create(context, base.NewMappable("test1", strings.Repeat("v", math.MaxUint16)))
+	})
+	require.Panics(t, func() {
+		testMapper.Create(context, base.NewMappable("test2", strings.Repeat("v", math.MaxUint16+1)))
+	})
+	require.Panics(t, func() {
+		NewIndex(strings.Repeat("n", math.MaxUint8+1), lengthIndex.GenerateIndexKeyBytes)
+	})
 }
@@ -0,0 +1,80 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package base
+
+import (
+	"encoding/json"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+type migration struct {
+	version         uint64
+	prefix          []byte
+	storeMigrator   func([]byte, []byte) ([]byte, []byte, error)
+	storeRebuilder  func(sdkTypes.Context, helpers.Mapper, []interface{}) error
+	genesisMigrator func(map[string]json.RawMessage) (map[string]json.RawMessage, error)
+}
+
+var _ helpers.Migration = (*migration)(nil)
+
+func (migration migration) GetVersion() uint64 {
+	return migration.version
+}
+func (migration migration) GetPrefix() []byte {
+	return migration.prefix
+}
+func (migration migration) Migrate(key []byte, value []byte) ([]byte, []byte, error) {
+	if migration.storeMigrator == nil {
+		return key, value, nil
+	}
+
+	return migration.storeMigrator(key, value)
+}
+func (migration migration) Rebuild(context sdkTypes.Context, mapper helpers.Mapper, auxiliaryKeepers []interface{}) error {
+	if migration.storeRebuilder == nil {
+		return nil
+	}
+
+	return migration.storeRebuilder(context, mapper, auxiliaryKeepers)
+}
+func (migration migration) MigrateGenesis(genesisState map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	if migration.genesisMigrator == nil {
+		return genesisState, nil
+	}
+
+	return migration.genesisMigrator(genesisState)
+}
+
+// NewMigration creates the migration of a module store to the version, the store migrator rewrites every entry under the prefix, the store
+// rebuilder then writes the records derived from the rewritten store and the genesis migrator rewrites the application genesis state exported
+// at the previous version, any of them is left nil when there is nothing to do
+func NewMigration(version uint64, prefix []byte, storeMigrator func([]byte, []byte) ([]byte, []byte, error), storeRebuilder func(sdkTypes.Context, helpers.Mapper, []interface{}) error, genesisMigrator func(map[string]json.RawMessage) (map[string]json.RawMessage, error)) helpers.Migration {
+	return migration{
+		version:         version,
+		prefix:          prefix,
+		storeMigrator:   storeMigrator,
+		storeRebuilder:  storeRebuilder,
+		genesisMigrator: genesisMigrator,
+	}
+}
+
+// RebuildIndexes rewrites every mappable under the partial keys through the mapper, so that each gets its entries in the indexes of the
+// mapper, as mappables written before an index was added have none
+func RebuildIndexes(context sdkTypes.Context, mapper helpers.Mapper, partialKeyList ...helpers.Key) {
+	for _, partialKey := range partialKeyList {
+		var mappableList []helpers.Mappable
+
+		mapper.Iterate(context, partialKey, func(mappable helpers.Mappable) bool {
+			mappableList = append(mappableList, mappable)
+			return false
+		})
+
+		for _, mappable := range mappableList {
+			mapper.Update(context, mappable)
+		}
+	}
+}
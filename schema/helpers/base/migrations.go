@@ -0,0 +1,126 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package base
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+// initialVersion is the version of module stores no migration has run on, including those written before stores were versioned
+const initialVersion = 1
+
+type migrations struct {
+	migrationList []helpers.Migration
+}
+
+var _ helpers.Migrations = (*migrations)(nil)
+
+func (migrations migrations) GetConsensusVersion() uint64 {
+	return initialVersion + uint64(len(migrations.migrationList))
+}
+func (migrations migrations) Get(version uint64) helpers.Migration {
+	for _, migration := range migrations.migrationList {
+		if migration.GetVersion() == version {
+			return migration
+		}
+	}
+
+	return nil
+}
+func (migrations migrations) GetList() []helpers.Migration {
+	return migrations.migrationList
+}
+func (migrations migrations) Migrate(context sdkTypes.Context, mapper helpers.Mapper, auxiliaryKeepers []interface{}, fromVersion uint64) error {
+	if err := migrations.validateVersion(fromVersion); err != nil {
+		return err
+	}
+
+	for _, migration := range migrations.migrationList[fromVersion-initialVersion:] {
+		if migration.GetPrefix() != nil {
+			if err := migrate(context.KVStore(mapper.GetKVStoreKey()), migration); err != nil {
+				return fmt.Errorf("migration to version %d: %w", migration.GetVersion(), err)
+			}
+		}
+
+		if err := migration.Rebuild(context, mapper, auxiliaryKeepers); err != nil {
+			return fmt.Errorf("migration to version %d: %w", migration.GetVersion(), err)
+		}
+	}
+
+	return nil
+}
+func (migrations migrations) MigrateGenesis(genesisState map[string]json.RawMessage, fromVersion uint64) (map[string]json.RawMessage, error) {
+	if err := migrations.validateVersion(fromVersion); err != nil {
+		return nil, err
+	}
+
+	for _, migration := range migrations.migrationList[fromVersion-initialVersion:] {
+		var err error
+		if genesisState, err = migration.MigrateGenesis(genesisState); err != nil {
+			return nil, fmt.Errorf("genesis migration to version %d: %w", migration.GetVersion(), err)
+		}
+	}
+
+	return genesisState, nil
+}
+func (migrations migrations) validateVersion(version uint64) error {
+	if version < initialVersion || version > migrations.GetConsensusVersion() {
+		return errors.InvalidRequest
+	}
+
+	return nil
+}
+
+// migrate rewrites the key range of the migration, every old entry is deleted before any new one is written so that
+// entries moved onto keys of the range are never overwritten by the rewrite
+func migrate(kvStore sdkTypes.KVStore, migration helpers.Migration) error {
+	kvStorePrefixIterator := sdkTypes.KVStorePrefixIterator(kvStore, migration.GetPrefix())
+
+	var keyList, newKeyList, newValueList [][]byte
+	for ; kvStorePrefixIterator.Valid(); kvStorePrefixIterator.Next() {
+		newKey, newValue, err := migration.Migrate(kvStorePrefixIterator.Key(), kvStorePrefixIterator.Value())
+		if err != nil {
+			kvStorePrefixIterator.Close()
+			return err
+		}
+
+		keyList = append(keyList, kvStorePrefixIterator.Key())
+		newKeyList = append(newKeyList, newKey)
+		newValueList = append(newValueList, newValue)
+	}
+
+	kvStorePrefixIterator.Close()
+
+	for _, key := range keyList {
+		kvStore.Delete(key)
+	}
+
+	for i, newKey := range newKeyList {
+		if newValueList[i] != nil {
+			kvStore.Set(newKey, newValueList[i])
+		}
+	}
+
+	return nil
+}
+
+// NewMigrations registers the migrations of a module store, each upgrading it from the version before its own, so that
+// the consensus version of the module is one past the number of migrations
+func NewMigrations(migrationList ...helpers.Migration) helpers.Migrations {
+	for i, migration := range migrationList {
+		if migration.GetVersion() != initialVersion+uint64(i)+1 {
+			panic(fmt.Errorf("migration to version %d registered at version %d", migration.GetVersion(), initialVersion+uint64(i)+1))
+		}
+	}
+
+	return migrations{
+		migrationList: migrationList,
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package base
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseTestUtilities "github.com/AssetMantle/modules/utilities/test/schema/helpers/base"
+)
+
+func TestMigrations(t *testing.T) {
+	context, storeKey, _ := baseTestUtilities.SetupTest(t)
+	kvStore := context.KVStore(storeKey)
+	testMapper := NewMapper(baseTestUtilities.KeyPrototype, baseTestUtilities.MappablePrototype).Initialize(storeKey)
+
+	kvStore.Set([]byte("a1"), []byte("v1"))
+	kvStore.Set([]byte("a2"), []byte("v2"))
+	kvStore.Set([]byte("a3"), []byte("v3"))
+	kvStore.Set([]byte("b1"), []byte("w1"))
+
+	Migrations := NewMigrations(
+		// shifts every entry of the range onto the key following its own and drops the last one
+		NewMigration(2, []byte("a"), func(key []byte, value []byte) ([]byte, []byte, error) {
+			if bytes.Equal(key, []byte("a3")) {
+				return key, nil, nil
+			}
+
+			return []byte{key[0], key[1] + 1}, value, nil
+		}, nil, func(genesisState map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+			genesisState["test"] = append(genesisState["test"], '2')
+			return genesisState, nil
+		}),
+		// rewrites the range and then writes a record counting the auxiliary keepers it is given
+		NewMigration(3, []byte("b"), func(key []byte, value []byte) ([]byte, []byte, error) {
+			return key, append(value, '3'), nil
+		}, func(context sdkTypes.Context, mapper helpers.Mapper, auxiliaryKeepers []interface{}) error {
+			context.KVStore(mapper.GetKVStoreKey()).Set([]byte("c1"), []byte{byte(len(auxiliaryKeepers))})
+			return nil
+		}, nil),
+	)
+
+	require.Equal(t, uint64(3), Migrations.GetConsensusVersion())
+	require.Equal(t, 2, len(Migrations.GetList()))
+	require.Equal(t, uint64(2), Migrations.Get(2).GetVersion())
+	require.Nil(t, Migrations.Get(4))
+
+	require.Equal(t, errors.InvalidRequest, Migrations.Migrate(context, testMapper, nil, 0))
+	require.Equal(t, errors.InvalidRequest, Migrations.Migrate(context, testMapper, nil, 4))
+
+	require.Nil(t, Migrations.Migrate(context, testMapper, []interface{}{"auxiliary"}, 1))
+	require.Nil(t, kvStore.Get([]byte("a1")))
+	require.Equal(t, []byte("v1"), kvStore.Get([]byte("a2")))
+	require.Equal(t, []byte("v2"), kvStore.Get([]byte("a3")))
+	require.Equal(t, []byte("w13"), kvStore.Get([]byte("b1")))
+	require.Equal(t, []byte{1}, kvStore.Get([]byte("c1")))
+
+	require.Nil(t, Migrations.Migrate(context, testMapper, nil, 3))
+	require.Equal(t, []byte("w13"), kvStore.Get([]byte("b1")))
+	require.Equal(t, []byte{1}, kvStore.Get([]byte("c1")))
+
+	require.Equal(t, errors.MockError, NewMigrations(NewMigration(2, nil, nil, func(sdkTypes.Context, helpers.Mapper, []interface{}) error {
+		return errors.MockError
+	}, nil)).Migrate(context, testMapper, nil, 1).(interface{ Unwrap() error }).Unwrap())
+
+	genesisState, err := Migrations.MigrateGenesis(map[string]json.RawMessage{"test": json.RawMessage("1")}, 1)
+	require.Nil(t, err)
+	require.Equal(t, map[string]json.RawMessage{"test": json.RawMessage("12")}, genesisState)
+
+	genesisState, err = Migrations.MigrateGenesis(map[string]json.RawMessage{"test": json.RawMessage("1")}, 2)
+	require.Nil(t, err)
+	require.Equal(t, map[string]json.RawMessage{"test": json.RawMessage("1")}, genesisState)
+
+	require.Panics(t, func() {
+		NewMigrations(NewMigration(3, nil, nil, nil, nil))
+	})
+}
+
+func TestRebuildIndexes(t *testing.T) {
+	context, storeKey, _ := baseTestUtilities.SetupTest(t)
+
+	valueIndex := NewIndex("value", func(mappable helpers.Mappable) []byte {
+		return []byte(reflect.ValueOf(mappable).FieldByName("Value").String())
+	})
+
+	// mappables written before the index was added
+	NewMapper(baseTestUtilities.KeyPrototype, baseTestUtilities.MappablePrototype).Initialize(storeKey).Create(context, baseTestUtilities.NewMappable("test1", "value1"))
+	NewMapper(baseTestUtilities.KeyPrototype, baseTestUtilities.MappablePrototype).Initialize(storeKey).Create(context, baseTestUtilities.NewMappable("test2", "value2"))
+
+	testMapper := NewMapper(baseTestUtilities.KeyPrototype, baseTestUtilities.MappablePrototype, valueIndex).Initialize(storeKey)
+
+	collect := func() []helpers.Mappable {
+		var mappableList []helpers.Mappable
+
+		testMapper.IterateIndex(context, valueIndex, []byte("value1"), func(mappable helpers.Mappable) bool {
+			mappableList = append(mappableList, mappable)
+			return false
+		})
+
+		return mappableList
+	}
+
+	require.Nil(t, collect())
+
+	RebuildIndexes(context, testMapper, baseTestUtilities.KeyPrototype())
+	require.Equal(t, []helpers.Mappable{baseTestUtilities.NewMappable("test1", "value1")}, collect())
+
+	RebuildIndexes(context, testMapper, baseTestUtilities.KeyPrototype())
+	require.Equal(t, []helpers.Mappable{baseTestUtilities.NewMappable("test1", "value1")}, collect())
+}
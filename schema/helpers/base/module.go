@@ -4,6 +4,7 @@
 package base
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -20,6 +21,7 @@ import (
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/keys"
 	"github.com/AssetMantle/modules/schema/helpers"
 )
 
@@ -34,7 +36,10 @@ type module struct {
 	simulatorPrototype    func() helpers.Simulator
 	transactionsPrototype func() helpers.Transactions
 	blockPrototype        func() helpers.Block
+	invariantsPrototype   func() helpers.Invariants
+	migrationsPrototype   func() helpers.Migrations
 
+	kvStoreKey   *sdkTypes.KVStoreKey
 	auxiliaries  helpers.Auxiliaries
 	genesis      helpers.Genesis
 	mapper       helpers.Mapper
@@ -42,6 +47,9 @@ type module struct {
 	queries      helpers.Queries
 	transactions helpers.Transactions
 	block        helpers.Block
+	invariants   helpers.Invariants
+
+	auxiliaryKeepers []interface{}
 }
 
 var _ helpers.Module = (*module)(nil)
@@ -62,8 +70,12 @@ func (module module) RegisterStoreDecoder(storeDecoderRegistry sdkTypes.StoreDec
 	storeDecoderRegistry[module.name] = module.mapperPrototype().StoreDecoder
 }
 
-func (module module) WeightedOperations(_ sdkTypesModule.SimulationState) []simulation.WeightedOperation {
-	return nil
+func (module module) WeightedOperations(simulationState sdkTypesModule.SimulationState) []simulation.WeightedOperation {
+	if module.mapper == nil {
+		panic(errors.UninitializedUsage)
+	}
+
+	return module.simulatorPrototype().Initialize(module.mapper, module.parameters, module.auxiliaryKeepers...).WeightedOperations(simulationState.AppParams, simulationState.Cdc)
 }
 
 func (module module) Name() string {
@@ -73,6 +85,8 @@ func (module module) RegisterCodec(codec *codec.Codec) {
 	for _, transaction := range module.transactionsPrototype().GetList() {
 		transaction.RegisterCodec(codec)
 	}
+
+	module.mapperPrototype().RegisterCodec(codec)
 }
 func (module module) DefaultGenesis() json.RawMessage {
 	return module.genesisPrototype().Default().Encode()
@@ -84,6 +98,7 @@ func (module module) ValidateGenesis(rawMessage json.RawMessage) error {
 func (module module) RegisterRESTRoutes(cliContext context.CLIContext, router *mux.Router) {
 	for _, query := range module.queriesPrototype().GetList() {
 		router.HandleFunc("/"+module.Name()+"/"+query.GetName()+fmt.Sprintf("/{%s}", query.GetName()), query.RESTQueryHandler(cliContext)).Methods("GET")
+		router.HandleFunc("/"+module.Name()+"/"+query.GetName(), query.RESTQueryHandler(cliContext)).Methods("GET")
 	}
 
 	for _, transaction := range module.transactionsPrototype().GetList() {
@@ -130,7 +145,13 @@ func (module module) GetQueryCmd(codec *codec.Codec) *cobra.Command {
 
 	return rootQueryCommand
 }
-func (module module) RegisterInvariants(_ sdkTypes.InvariantRegistry) {}
+func (module module) RegisterInvariants(invariantRegistry sdkTypes.InvariantRegistry) {
+	if module.invariants == nil {
+		panic(errors.UninitializedUsage)
+	}
+
+	module.invariants.Register(invariantRegistry)
+}
 func (module module) Route() string {
 	return module.name
 }
@@ -174,6 +195,8 @@ func (module module) InitGenesis(context sdkTypes.Context, rawMessage json.RawMe
 
 	genesisState.Import(context, module.mapper, module.parameters)
 
+	module.setVersion(context, module.ConsensusVersion())
+
 	return []abciTypes.ValidatorUpdate{}
 }
 func (module module) ExportGenesis(context sdkTypes.Context) json.RawMessage {
@@ -200,7 +223,32 @@ func (module module) GetAuxiliary(auxiliaryName string) helpers.Auxiliary {
 
 	panic(fmt.Errorf("auxiliary %v not found/initialized", auxiliaryName))
 }
+func (module module) GetParameters() helpers.Parameters {
+	if module.parameters == nil {
+		panic(errors.UninitializedUsage)
+	}
+
+	return module.parameters
+}
+func (module module) ConsensusVersion() uint64 {
+	return module.migrationsPrototype().GetConsensusVersion()
+}
+func (module module) Migrate(context sdkTypes.Context) error {
+	if module.kvStoreKey == nil {
+		panic(errors.UninitializedUsage)
+	}
+
+	if err := module.migrationsPrototype().Migrate(context, module.mapper, module.auxiliaryKeepers, module.getVersion(context)); err != nil {
+		return fmt.Errorf("module %v: %w", module.Name(), err)
+	}
 
+	module.setVersion(context, module.ConsensusVersion())
+
+	return nil
+}
+func (module module) MigrateGenesis(genesisState map[string]json.RawMessage, fromVersion uint64) (map[string]json.RawMessage, error) {
+	return module.migrationsPrototype().MigrateGenesis(genesisState, fromVersion)
+}
 func (module module) DecodeModuleTransactionRequest(transactionName string, rawMessage json.RawMessage) (sdkTypes.Msg, error) {
 	if transaction := module.transactionsPrototype().Get(transactionName); transaction != nil {
 		return transaction.DecodeTransactionRequest(rawMessage)
@@ -210,6 +258,8 @@ func (module module) DecodeModuleTransactionRequest(transactionName string, rawM
 }
 
 func (module module) Initialize(kvStoreKey *sdkTypes.KVStoreKey, paramsSubspace params.Subspace, auxiliaryKeepers ...interface{}) helpers.Module {
+	module.kvStoreKey = kvStoreKey
+
 	module.mapper = module.mapperPrototype().Initialize(kvStoreKey)
 
 	module.genesis = module.genesisPrototype().Initialize(module.genesisPrototype().GetMappableList(), module.genesisPrototype().GetParameterList())
@@ -246,10 +296,33 @@ func (module module) Initialize(kvStoreKey *sdkTypes.KVStoreKey, paramsSubspace
 
 	module.block = module.blockPrototype().Initialize(module.mapper, module.parameters, auxiliaryKeepers...)
 
+	module.invariants = module.invariantsPrototype().Initialize(module.mapper, module.parameters, auxiliaryKeepers...)
+
+	module.auxiliaryKeepers = auxiliaryKeepers
+
 	return module
 }
 
-func NewModule(name string, auxiliariesPrototype func() helpers.Auxiliaries, genesisPrototype func() helpers.Genesis, mapperPrototype func() helpers.Mapper, parametersPrototype func() helpers.Parameters, queriesPrototype func() helpers.Queries, simulatorPrototype func() helpers.Simulator, transactionsPrototype func() helpers.Transactions, blockPrototype func() helpers.Block) helpers.Module {
+// getVersion reads the version the store of the module is at, stores written before they were versioned are at the initial version
+func (module module) getVersion(context sdkTypes.Context) uint64 {
+	if versionBytes := context.KVStore(module.kvStoreKey).Get(module.generateVersionKey()); versionBytes != nil {
+		return binary.BigEndian.Uint64(versionBytes)
+	}
+
+	return initialVersion
+}
+func (module module) setVersion(context sdkTypes.Context, version uint64) {
+	versionBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(versionBytes, version)
+	context.KVStore(module.kvStoreKey).Set(module.generateVersionKey(), versionBytes)
+}
+
+// generateVersionKey keys the version record by the name of the module, as modules sharing a store each keep their own version
+func (module module) generateVersionKey() []byte {
+	return keys.Versions.GenerateStoreKey([]byte(module.name))
+}
+
+func NewModule(name string, auxiliariesPrototype func() helpers.Auxiliaries, genesisPrototype func() helpers.Genesis, mapperPrototype func() helpers.Mapper, parametersPrototype func() helpers.Parameters, queriesPrototype func() helpers.Queries, simulatorPrototype func() helpers.Simulator, transactionsPrototype func() helpers.Transactions, blockPrototype func() helpers.Block, invariantsPrototype func() helpers.Invariants, migrationsPrototype func() helpers.Migrations) helpers.Module {
 	return module{
 		name:                  name,
 		auxiliariesPrototype:  auxiliariesPrototype,
@@ -260,5 +333,7 @@ func NewModule(name string, auxiliariesPrototype func() helpers.Auxiliaries, gen
 		simulatorPrototype:    simulatorPrototype,
 		transactionsPrototype: transactionsPrototype,
 		blockPrototype:        blockPrototype,
+		invariantsPrototype:   invariantsPrototype,
+		migrationsPrototype:   migrationsPrototype,
 	}
 }
@@ -49,6 +49,12 @@ var transactionsPrototype = func() helpers.Transactions {
 		baseTestUtilities.TestTransactionKeeperPrototype)}}
 }
 var blockPrototype = func() helpers.Block { return helpersTestUtilities.TestBlockPrototype() }
+var invariantsPrototype = func() helpers.Invariants {
+	return NewInvariants("test", helpersTestUtilities.TestInvariantPrototype())
+}
+var migrationsPrototype = func() helpers.Migrations {
+	return NewMigrations(NewMigration(2, []byte("testMigration"), nil, nil, nil))
+}
 
 func TestModule(t *testing.T) {
 	context, storeKey, transientStoreKey := baseTestUtilities.SetupTest(t)
@@ -56,7 +62,7 @@ func TestModule(t *testing.T) {
 	subspace := params.NewSubspace(codec, storeKey, transientStoreKey, "test") // .WithKeyTable(parametersPrototype().GetKeyTable())
 	// subspace.SetParamSet(context, parametersPrototype())
 	Module := NewModule("test", auxiliariesPrototype, genesisPrototype,
-		mapperPrototype, parametersPrototype, queriesPrototype, simulatorPrototype, transactionsPrototype, blockPrototype).Initialize(storeKey, subspace).(module)
+		mapperPrototype, parametersPrototype, queriesPrototype, simulatorPrototype, transactionsPrototype, blockPrototype, invariantsPrototype, migrationsPrototype).Initialize(storeKey, subspace).(module)
 
 	// AppModuleBasic
 	require.Equal(t, "test", Module.Name())
@@ -84,9 +90,11 @@ func TestModule(t *testing.T) {
 	require.Equal(t, "test", Module.GetQueryCmd(codec).Name())
 
 	// AppModule
+	invariantRegistry := helpersTestUtilities.NewTestInvariantRegistry()
 	require.NotPanics(t, func() {
-		Module.RegisterInvariants(nil)
+		Module.RegisterInvariants(invariantRegistry)
 	})
+	require.NotNil(t, invariantRegistry.Get("test", "testInvariant"))
 	require.Equal(t, "test", Module.Route())
 
 	response, err := Module.NewHandler()(context, baseTestUtilities.NewTestMessage(sdkTypes.AccAddress("addr"), "id"))
@@ -113,6 +121,29 @@ func TestModule(t *testing.T) {
 	})
 
 	require.Equal(t, Module.DefaultGenesis(), Module.ExportGenesis(context))
+
+	// Migrations
+	require.Equal(t, uint64(2), Module.ConsensusVersion())
+	require.Equal(t, uint64(2), Module.getVersion(context))
+	Module.setVersion(context, 1)
+	require.Nil(t, Module.Migrate(context))
+	require.Equal(t, uint64(2), Module.getVersion(context))
+
+	// a module sharing the store keeps its own version
+	sharingModule := NewModule("sharing", auxiliariesPrototype, genesisPrototype,
+		mapperPrototype, parametersPrototype, queriesPrototype, simulatorPrototype, transactionsPrototype, blockPrototype, invariantsPrototype, migrationsPrototype).Initialize(storeKey, params.NewSubspace(codec, storeKey, transientStoreKey, "sharing")).(module)
+	require.Equal(t, uint64(1), sharingModule.getVersion(context))
+	sharingModule.setVersion(context, 2)
+	Module.setVersion(context, 1)
+	require.Equal(t, uint64(2), sharingModule.getVersion(context))
+	require.Nil(t, Module.Migrate(context))
+
+	migratedGenesisState, err := Module.MigrateGenesis(map[string]json.RawMessage{Module.Name(): Module.DefaultGenesis()}, 1)
+	require.Nil(t, err)
+	require.Equal(t, map[string]json.RawMessage{Module.Name(): Module.DefaultGenesis()}, migratedGenesisState)
+	_, err = Module.MigrateGenesis(map[string]json.RawMessage{Module.Name(): Module.DefaultGenesis()}, 3)
+	require.NotNil(t, err)
+
 	// AppModuleSimulation
 	require.Panics(t, func() {
 		Module.GenerateGenesisState(&sdkModule.SimulationState{})
@@ -77,7 +77,7 @@ func (parameters parameters) Mutate(context sdkTypes.Context, newParameter param
 	for i, parameter := range parameters.parameterList {
 		if parameter.GetID().Compare(newParameter.GetID()) == 0 {
 			parameters.parameterList[i] = newParameter
-			parameters.paramsSubspace.Set(context, parameter.GetID().Bytes(), parameter.GetData())
+			parameters.paramsSubspace.Set(context, newParameter.GetID().Bytes(), newParameter.GetData())
 
 			break
 		}
@@ -69,7 +69,18 @@ func (query query) RESTQueryHandler(outerCliContext context.CLIContext) http.Han
 			return
 		}
 
-		queryRequest := query.requestPrototype().FromMap(mux.Vars(httpRequest))
+		vars := make(map[string]string)
+		for name, valueList := range httpRequest.URL.Query() {
+			if len(valueList) != 0 {
+				vars[name] = valueList[0]
+			}
+		}
+
+		for name, value := range mux.Vars(httpRequest) {
+			vars[name] = value
+		}
+
+		queryRequest := query.requestPrototype().FromMap(vars)
 
 		response, height, err := query.query(queryRequest, cliContext)
 		if err != nil {
@@ -14,6 +14,7 @@ type Collection interface {
 	GetList() []Mappable
 
 	Iterate(Key, func(Mappable) bool)
+	IterateIndex(Index, []byte, func(Mappable) bool)
 	Fetch(Key) Collection
 	Add(Mappable) Collection
 	Remove(Mappable) Collection
@@ -11,15 +11,22 @@ import (
 var (
 	AddMaintainer           = baseHelpers.NewCLIFlag("addMaintainer", false, "AddMaintainer")
 	AssetID                 = baseHelpers.NewCLIFlag("assetID", "", "AssetID")
+	Claimable               = baseHelpers.NewCLIFlag("claimable", false, "Claimable")
 	ClassificationID        = baseHelpers.NewCLIFlag("classificationID", "", "ClassificationID")
 	Coins                   = baseHelpers.NewCLIFlag("coins", "", "Coins")
+	Cursor                  = baseHelpers.NewCLIFlag("cursor", "", "Cursor")
 	Data                    = baseHelpers.NewCLIFlag("data", "", "Data")
+	Denom                   = baseHelpers.NewCLIFlag("denom", "", "Denom")
+	DistributionID          = baseHelpers.NewCLIFlag("distributionID", "", "DistributionID")
 	ExpiresIn               = baseHelpers.NewCLIFlag("expiresIn", int64(-1), "ExpiresIn")
 	FromID                  = baseHelpers.NewCLIFlag("fromID", "", "FromID")
+	HeldOwnableID           = baseHelpers.NewCLIFlag("heldOwnableID", "", "HeldOwnableID")
+	HolderID                = baseHelpers.NewCLIFlag("holderID", "", "HolderID")
 	IdentityID              = baseHelpers.NewCLIFlag("identityID", "", "IdentityID")
 	ImmutableMetaProperties = baseHelpers.NewCLIFlag("immutableMetaProperties", "", "immutableMetaProperties")
 	ImmutableProperties     = baseHelpers.NewCLIFlag("immutableProperties", "", "immutableProperties")
 	KafkaNodes              = baseHelpers.NewCLIFlag("kafkaNodes", "localhost:9092", "Space separated addresses in quotes of the kafka listening node: example: --kafkaPort \"addr1 addr2\" ")
+	Limit                   = baseHelpers.NewCLIFlag("limit", 0, "Limit")
 	MaintainerID            = baseHelpers.NewCLIFlag("maintainerID", "", "MaintainerID")
 	MaintainedProperties    = baseHelpers.NewCLIFlag("maintainedProperties", "", "MaintainedProperties")
 	MakerOwnableID          = baseHelpers.NewCLIFlag("makerOwnableID", "", "MakerOwnableID")
@@ -29,14 +36,26 @@ var (
 	MetaID                  = baseHelpers.NewCLIFlag("metaID", "", "MetaID")
 	MutateMaintainer        = baseHelpers.NewCLIFlag("mutateMaintainer", false, "MutateMaintainer")
 	NubID                   = baseHelpers.NewCLIFlag("nubID", "", "NubID")
+	Offset                  = baseHelpers.NewCLIFlag("offset", 0, "Offset")
 	OrderID                 = baseHelpers.NewCLIFlag("orderID", "", "OrderID")
 	OwnableID               = baseHelpers.NewCLIFlag("ownableID", "", "MakerOwnableID")
+	OwnerID                 = baseHelpers.NewCLIFlag("ownerID", "", "OwnerID")
+	Outputs                 = baseHelpers.NewCLIFlag("outputs", "", "Path to a CSV or JSON file listing the outputs by toID, ownableID and value")
+	Period                  = baseHelpers.NewCLIFlag("period", int64(0), "Period")
+	Prefix                  = baseHelpers.NewCLIFlag("prefix", "", "Prefix")
 	Queuing                 = baseHelpers.NewCLIFlag("queuing", false, "Enable kafka queuing and squashing of transactions")
+	ReferenceID             = baseHelpers.NewCLIFlag("referenceID", "", "ReferenceID")
 	RemoveMaintainer        = baseHelpers.NewCLIFlag("removeMaintainer", false, "RemoveMaintainer")
+	Reverse                 = baseHelpers.NewCLIFlag("reverse", false, "Reverse")
 	Value                   = baseHelpers.NewCLIFlag("value", "0", "Value")
+	SpenderID               = baseHelpers.NewCLIFlag("spenderID", "", "SpenderID")
 	SplitID                 = baseHelpers.NewCLIFlag("splitID", "", "SplitID")
 	To                      = baseHelpers.NewCLIFlag("to", "", "To")
 	ToID                    = baseHelpers.NewCLIFlag("toID", "", "ToID")
 	TakerOwnableID          = baseHelpers.NewCLIFlag("takerOwnableID", "", "TakerOwnableID")
 	TakerOwnableSplit       = baseHelpers.NewCLIFlag("takerOwnableSplit", "0", "TakerOwnableSplit")
+	TimeInForce             = baseHelpers.NewCLIFlag("timeInForce", "GTC", "TimeInForce")
+	TriggerRate             = baseHelpers.NewCLIFlag("triggerRate", "", "TriggerRate")
+	VestingKind             = baseHelpers.NewCLIFlag("vestingKind", "linear", "VestingKind")
+	VestsIn                 = baseHelpers.NewCLIFlag("vestsIn", int64(0), "VestsIn")
 )
@@ -0,0 +1,10 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package helpers
+
+type Index interface {
+	GetName() string
+	// GenerateIndexKeyBytes returns the bytes under which the mappable is indexed, or nil if the mappable is not indexed
+	GenerateIndexKeyBytes(Mappable) []byte
+}
@@ -0,0 +1,15 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package helpers
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Invariant interface {
+	GetName() string
+	// Check returns a description of the broken state and true when the invariant does not hold
+	Check(sdkTypes.Context) (string, bool)
+	Initialize(Mapper, Parameters, ...interface{}) Invariant
+}
@@ -0,0 +1,16 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package helpers
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Invariants interface {
+	Get(string) Invariant
+	GetList() []Invariant
+
+	Register(sdkTypes.InvariantRegistry)
+	Initialize(Mapper, Parameters, ...interface{}) Invariants
+}
@@ -11,6 +11,7 @@ import (
 
 type Mapper interface {
 	NewCollection(sdkTypes.Context) Collection
+	GetKVStoreKey() *sdkTypes.KVStoreKey
 
 	Create(sdkTypes.Context, Mappable)
 	Read(sdkTypes.Context, Key) Mappable
@@ -18,8 +19,12 @@ type Mapper interface {
 	Delete(sdkTypes.Context, Key)
 	Iterate(sdkTypes.Context, Key, func(Mappable) bool)
 	ReverseIterate(sdkTypes.Context, Key, func(Mappable) bool)
+	IterateIndex(sdkTypes.Context, Index, []byte, func(Mappable) bool)
+	ReverseIterateIndex(sdkTypes.Context, Index, []byte, func(Mappable) bool)
+	IterateIndexKeys(sdkTypes.Context, Index, func(Mappable) bool)
 
 	StoreDecoder(*codec.Codec, kv.Pair, kv.Pair) string
+	RegisterCodec(*codec.Codec)
 
 	Initialize(*sdkTypes.KVStoreKey) Mapper
 }
@@ -0,0 +1,25 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package helpers
+
+import (
+	"encoding/json"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Migration interface {
+	// GetVersion returns the version the store of the module is at once the migration has run
+	GetVersion() uint64
+	// GetPrefix returns the prefix of the key range the migration rewrites, a nil prefix rewrites no entry
+	GetPrefix() []byte
+
+	// Migrate rewrites an entry of the key range, a nil value deletes it
+	Migrate(key []byte, value []byte) ([]byte, []byte, error)
+	// Rebuild writes the records the version derives from the rewritten store, through the mapper and auxiliary keepers of the module
+	Rebuild(sdkTypes.Context, Mapper, []interface{}) error
+	// MigrateGenesis rewrites the genesis state of the application exported at the previous version, which holds the genesis of every module
+	// by name so that the records the version derives from other modules can be written along with theirs
+	MigrateGenesis(map[string]json.RawMessage) (map[string]json.RawMessage, error)
+}
@@ -0,0 +1,22 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package helpers
+
+import (
+	"encoding/json"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Migrations interface {
+	// GetConsensusVersion returns the version the store of the module is at once every migration has run
+	GetConsensusVersion() uint64
+	Get(uint64) Migration
+	GetList() []Migration
+
+	// Migrate runs the migrations following the version the store of the mapper is at, in order
+	Migrate(sdkTypes.Context, Mapper, []interface{}, uint64) error
+	// MigrateGenesis runs the genesis migrations following the version the genesis was exported at on the application genesis state, in order
+	MigrateGenesis(map[string]json.RawMessage, uint64) (map[string]json.RawMessage, error)
+}
@@ -17,6 +17,13 @@ type Module interface {
 	sdkTypesModule.AppModuleSimulation
 
 	GetAuxiliary(string) Auxiliary
+	GetParameters() Parameters
+
+	ConsensusVersion() uint64
+	// Migrate runs the migrations of the module following the version its store is at
+	Migrate(sdkTypes.Context) error
+	// MigrateGenesis upgrades the genesis of the module exported at the version to its consensus version within the application genesis state
+	MigrateGenesis(map[string]json.RawMessage, uint64) (map[string]json.RawMessage, error)
 
 	DecodeModuleTransactionRequest(string, json.RawMessage) (sdkTypes.Msg, error)
 
@@ -16,4 +16,6 @@ type Simulator interface {
 	WeightedOperations(simulation.AppParams, *codec.Codec) simulation.WeightedOperations
 	WeightedProposalContentList() []simulation.WeightedProposalContent
 	ParamChangeList(*rand.Rand) []simulation.ParamChange
+
+	Initialize(Mapper, Parameters, ...interface{}) Simulator
 }
@@ -0,0 +1,25 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappables
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/capabilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/types"
+)
+
+type Allowance interface {
+	GetSpenderID() ids.ID
+	GetValue() sdkTypes.Dec
+	GetExpiry() types.Height
+	IsExpired(types.Height) bool
+
+	Decrease(sdkTypes.Dec) Allowance
+
+	capabilities.Ownable
+	helpers.Mappable
+}
@@ -0,0 +1,19 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappables
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/capabilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+type Checkpoint interface {
+	GetSequence() int64
+	GetValue() sdkTypes.Dec
+
+	capabilities.Ownable
+	helpers.Mappable
+}
@@ -8,11 +8,22 @@ import (
 )
 
 func RegisterCodec(codec *codec.Codec) {
+	codec.RegisterInterface((*Allowance)(nil), nil)
 	codec.RegisterInterface((*Asset)(nil), nil)
+	codec.RegisterInterface((*Claim)(nil), nil)
+	codec.RegisterInterface((*Checkpoint)(nil), nil)
 	codec.RegisterInterface((*Classification)(nil), nil)
+	codec.RegisterInterface((*Distribution)(nil), nil)
+	codec.RegisterInterface((*Escrow)(nil), nil)
+	codec.RegisterInterface((*Expiry)(nil), nil)
 	codec.RegisterInterface((*Identity)(nil), nil)
+	codec.RegisterInterface((*LastPrice)(nil), nil)
 	codec.RegisterInterface((*Maintainer)(nil), nil)
 	codec.RegisterInterface((*Meta)(nil), nil)
 	codec.RegisterInterface((*Order)(nil), nil)
 	codec.RegisterInterface((*Split)(nil), nil)
+	codec.RegisterInterface((*Stop)(nil), nil)
+	codec.RegisterInterface((*Supply)(nil), nil)
+	codec.RegisterInterface((*Trade)(nil), nil)
+	codec.RegisterInterface((*Vesting)(nil), nil)
 }
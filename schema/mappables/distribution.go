@@ -0,0 +1,24 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappables
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/types"
+)
+
+type Distribution interface {
+	GetDistributorID() ids.ID
+	GetHeldOwnableID() ids.ID
+	GetSequence() int64
+	GetOwnableID() ids.ID
+	GetValue() sdkTypes.Dec
+	GetTotal() sdkTypes.Dec
+	GetHeight() types.Height
+
+	helpers.Mappable
+}
@@ -0,0 +1,24 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappables
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+)
+
+type Escrow interface {
+	GetHolderID() ids.ID
+	GetReferenceID() ids.ID
+	GetOwnerID() ids.ID
+	GetOwnableID() ids.ID
+	GetValue() sdkTypes.Dec
+
+	Increase(sdkTypes.Dec) Escrow
+	Decrease(sdkTypes.Dec) Escrow
+
+	helpers.Mappable
+}
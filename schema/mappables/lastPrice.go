@@ -0,0 +1,22 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappables
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/types"
+)
+
+type LastPrice interface {
+	GetMakerOwnableID() ids.ID
+	GetTakerOwnableID() ids.ID
+	GetExchangeRate() sdkTypes.Dec
+	GetHeight() types.Height
+	IsChecked() bool
+
+	helpers.Mappable
+}
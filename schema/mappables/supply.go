@@ -0,0 +1,21 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappables
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+)
+
+type Supply interface {
+	GetOwnableID() ids.ID
+	GetValue() sdkTypes.Dec
+
+	Increase(sdkTypes.Dec) Supply
+	Decrease(sdkTypes.Dec) Supply
+
+	helpers.Mappable
+}
@@ -0,0 +1,26 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappables
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/types"
+)
+
+type Trade interface {
+	GetHeight() types.Height
+	GetOrderID() ids.ID
+	GetMakerID() ids.ID
+	GetTakerID() ids.ID
+	GetMakerOwnableID() ids.ID
+	GetTakerOwnableID() ids.ID
+	GetMakerOwnableSplit() sdkTypes.Dec
+	GetTakerOwnableSplit() sdkTypes.Dec
+	GetExchangeRate() sdkTypes.Dec
+
+	helpers.Mappable
+}
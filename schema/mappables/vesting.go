@@ -0,0 +1,26 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mappables
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/capabilities"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	"github.com/AssetMantle/modules/schema/types"
+)
+
+type Vesting interface {
+	GetGranterID() ids.ID
+	GetKind() ids.ID
+	GetValue() sdkTypes.Dec
+	GetStart() types.Height
+	GetEnd() types.Height
+	GetPeriod() int64
+
+	capabilities.Ownable
+	capabilities.Limitable
+	helpers.Mappable
+}
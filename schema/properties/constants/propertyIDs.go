@@ -10,17 +10,22 @@ import (
 
 // Note: Arranged alphabetically
 var (
-	AuthenticationProperty = baseIDs.NewPropertyID(baseIDs.NewID("authentication"), constants.ListDataID)
-	BurnProperty           = baseIDs.NewPropertyID(baseIDs.NewID("burn"), constants.HeightDataID)
-	CreationProperty       = baseIDs.NewPropertyID(baseIDs.NewID("creation"), constants.HeightDataID)
-	ExchangeRateProperty   = baseIDs.NewPropertyID(baseIDs.NewID("exchangeRate"), constants.DecDataID)
-	// TODO Set max expiry as order module parameter
+	AuctionIntervalProperty      = baseIDs.NewPropertyID(baseIDs.NewID("auctionInterval"), constants.DecDataID)
+	AuthenticationProperty       = baseIDs.NewPropertyID(baseIDs.NewID("authentication"), constants.ListDataID)
+	BurnProperty                 = baseIDs.NewPropertyID(baseIDs.NewID("burn"), constants.HeightDataID)
+	CreationProperty             = baseIDs.NewPropertyID(baseIDs.NewID("creation"), constants.HeightDataID)
+	ExchangeRateProperty         = baseIDs.NewPropertyID(baseIDs.NewID("exchangeRate"), constants.DecDataID)
 	ExpiryProperty               = baseIDs.NewPropertyID(baseIDs.NewID("expiry"), constants.HeightDataID)
 	LockProperty                 = baseIDs.NewPropertyID(baseIDs.NewID("lock"), constants.HeightDataID)
 	MaintainedPropertiesProperty = baseIDs.NewPropertyID(baseIDs.NewID("maintainedProperties"), constants.ListDataID)
+	MakerFeeProperty             = baseIDs.NewPropertyID(baseIDs.NewID("makerFee"), constants.DecDataID)
 	MakerOwnableSplitProperty    = baseIDs.NewPropertyID(baseIDs.NewID("makerOwnableSplit"), constants.DecDataID)
 	NubIDProperty                = baseIDs.NewPropertyID(baseIDs.NewID("nubID"), constants.IDDataID)
 	PermissionsProperty          = baseIDs.NewPropertyID(baseIDs.NewID("permissions"), constants.ListDataID)
+	RoyaltyRateProperty          = baseIDs.NewPropertyID(baseIDs.NewID("royaltyRate"), constants.DecDataID)
+	RoyaltyRecipientProperty     = baseIDs.NewPropertyID(baseIDs.NewID("royaltyRecipient"), constants.IDDataID)
 	SupplyProperty               = baseIDs.NewPropertyID(baseIDs.NewID("supply"), constants.DecDataID)
+	TakerFeeProperty             = baseIDs.NewPropertyID(baseIDs.NewID("takerFee"), constants.DecDataID)
 	TakerIDProperty              = baseIDs.NewPropertyID(baseIDs.NewID("takerID"), constants.IDDataID)
+	TimeInForceProperty          = baseIDs.NewPropertyID(baseIDs.NewID("timeInForce"), constants.IDDataID)
 )
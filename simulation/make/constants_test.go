@@ -30,6 +30,7 @@ import (
 	"github.com/AssetMantle/modules/modules/metas"
 	"github.com/AssetMantle/modules/modules/orders"
 	"github.com/AssetMantle/modules/modules/splits"
+	"github.com/AssetMantle/modules/utilities/governance"
 )
 
 const applicationName = "SimulationApplication"
@@ -41,7 +42,7 @@ var moduleBasicManager = module.NewBasicManager(
 	staking.AppModuleBasic{},
 	mint.AppModuleBasic{},
 	distribution.AppModuleBasic{},
-	gov.NewAppModuleBasic(append(wasmClient.ProposalHandlers, paramsClient.ProposalHandler, distribution.ProposalHandler, upgradeClient.ProposalHandler)...),
+	gov.NewAppModuleBasic(append(wasmClient.ProposalHandlers, paramsClient.ProposalHandler, distribution.ProposalHandler, upgradeClient.ProposalHandler, governance.ProposalHandler)...),
 	params.AppModuleBasic{},
 	crisis.AppModuleBasic{},
 	wasm.AppModuleBasic{},
@@ -0,0 +1,314 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authExported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/AssetMantle/modules/modules/identities/auxiliaries/authenticate"
+	schemaHelpers "github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/mappables"
+)
+
+// listQueryName is the name of the paginated list query every module registers
+const listQueryName = "list"
+
+type listRequest struct {
+	PartialID ids.ID `json:"partialID"`
+	Offset    int    `json:"offset"`
+	Limit     int    `json:"limit"`
+	Cursor    string `json:"cursor"`
+	Reverse   bool   `json:"reverse"`
+}
+
+type listResponse struct {
+	Success    bool                     `json:"success"`
+	List       []schemaHelpers.Mappable `json:"list"`
+	NextCursor string                   `json:"nextCursor"`
+}
+
+// vestingsQueryName is the name of the query of the vesting schedules on the splits of an owner
+const vestingsQueryName = "vestings"
+
+type vestingsRequest struct {
+	OwnerID   ids.ID `json:"ownerID"`
+	OwnableID ids.ID `json:"ownableID"`
+}
+
+type vestingsResponse struct {
+	Success  bool `json:"success"`
+	Holdings []struct {
+		OwnableID ids.ID       `json:"ownableID"`
+		Locked    sdkTypes.Dec `json:"locked"`
+	} `json:"holdings"`
+}
+
+// GetAccount reads the account of the address through the auth querier, so that simulated transactions are signed with its current number and sequence
+func GetAccount(context sdkTypes.Context, baseApp *baseapp.BaseApp, codec *codec.Codec, address sdkTypes.AccAddress) (authExported.Account, error) {
+	requestBytes, err := codec.MarshalJSON(auth.NewQueryAccountParams(address))
+	if err != nil {
+		return nil, err
+	}
+
+	responseBytes, err := baseApp.QueryRouter().Route(auth.QuerierRoute)(context, []string{auth.QueryAccount}, abciTypes.RequestQuery{Data: requestBytes})
+	if err != nil {
+		return nil, err
+	}
+
+	var account authExported.Account
+	if err := codec.UnmarshalJSON(responseBytes, &account); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// DeliverMessage signs the message with the key of the simulation account and delivers it in a transaction of its own
+func DeliverMessage(context sdkTypes.Context, baseApp *baseapp.BaseApp, codec *codec.Codec, chainID string, simulationAccount simulation.Account, message sdkTypes.Msg) (simulation.OperationMsg, error) {
+	account, err := GetAccount(context, baseApp, codec, simulationAccount.Address)
+	if err != nil {
+		return simulation.NoOpMsg(message.Route()), err
+	}
+
+	tx := helpers.GenTx(
+		[]sdkTypes.Msg{message},
+		sdkTypes.NewCoins(),
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simulationAccount.PrivKey,
+	)
+
+	if _, _, err := baseApp.Deliver(tx); err != nil {
+		return simulation.NoOpMsg(message.Route()), fmt.Errorf("%s %s: %w", message.Route(), message.Type(), err)
+	}
+
+	return simulation.NewOperationMsg(message, true, ""), nil
+}
+
+// GetMappableList reads every mappable stored by the module through its list query, page by page
+func GetMappableList(context sdkTypes.Context, baseApp *baseapp.BaseApp, codec *codec.Codec, moduleName string) ([]schemaHelpers.Mappable, error) {
+	var mappableList []schemaHelpers.Mappable
+
+	cursor := ""
+
+	for {
+		requestBytes, err := codec.MarshalJSON(listRequest{PartialID: baseIDs.NewID(""), Cursor: cursor})
+		if err != nil {
+			return nil, err
+		}
+
+		responseBytes, err := baseApp.QueryRouter().Route(moduleName)(context, []string{listQueryName}, abciTypes.RequestQuery{Data: requestBytes})
+		if err != nil {
+			return nil, err
+		}
+
+		var response listResponse
+		if err := codec.UnmarshalJSON(responseBytes, &response); err != nil {
+			return nil, err
+		}
+
+		if !response.Success {
+			return nil, fmt.Errorf("%s list query failed", moduleName)
+		}
+
+		mappableList = append(mappableList, response.List...)
+
+		if response.NextCursor == "" {
+			return mappableList, nil
+		}
+
+		cursor = response.NextCursor
+	}
+}
+
+// GetIdentityIDList reads the IDs of every identity through the list query of the identities module
+func GetIdentityIDList(context sdkTypes.Context, baseApp *baseapp.BaseApp, codec *codec.Codec, identitiesModuleName string) ([]ids.ID, error) {
+	mappableList, err := GetMappableList(context, baseApp, codec, identitiesModuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	identityIDList := make([]ids.ID, 0, len(mappableList))
+
+	for _, mappable := range mappableList {
+		if identity, ok := mappable.(mappables.Identity); ok {
+			identityIDList = append(identityIDList, identity.GetID())
+		}
+	}
+
+	return identityIDList, nil
+}
+
+// RandomAccount picks a simulation account at random among those that can sign messages of the modules, the struct validator
+// applies the required tag of message addresses to each of their bytes, so addresses containing a zero byte are never picked
+func RandomAccount(r *rand.Rand, simulationAccountList []simulation.Account) (simulation.Account, bool) {
+	for _, i := range r.Perm(len(simulationAccountList)) {
+		if bytes.IndexByte(simulationAccountList[i].Address, 0) == -1 {
+			return simulationAccountList[i], true
+		}
+	}
+
+	return simulation.Account{}, false
+}
+
+// RandomControlledIdentity picks an identity of the list at random along with a simulation account provisioned to it,
+// it returns false when no account controls any of the identities
+func RandomControlledIdentity(r *rand.Rand, context sdkTypes.Context, authenticateAuxiliary schemaHelpers.Auxiliary, identityIDList []ids.ID, simulationAccountList []simulation.Account) (ids.ID, simulation.Account, bool) {
+	for _, i := range r.Perm(len(identityIDList)) {
+		if simulationAccount, found := RandomProvisionedAccount(r, context, authenticateAuxiliary, identityIDList[i], simulationAccountList); found {
+			return baseIDs.NewID(identityIDList[i].String()), simulationAccount, true
+		}
+	}
+
+	return nil, simulation.Account{}, false
+}
+
+// RandomProvisionedAccount picks a simulation account provisioned to the identity at random
+func RandomProvisionedAccount(r *rand.Rand, context sdkTypes.Context, authenticateAuxiliary schemaHelpers.Auxiliary, identityID ids.ID, simulationAccountList []simulation.Account) (simulation.Account, bool) {
+	for _, i := range r.Perm(len(simulationAccountList)) {
+		if authenticateAuxiliary.GetKeeper().Help(context, authenticate.NewAuxiliaryRequest(simulationAccountList[i].Address, identityID)).IsSuccessful() {
+			return simulationAccountList[i], true
+		}
+	}
+
+	return simulation.Account{}, false
+}
+
+// GetClassificationMap reads every classification through the list query of the classifications module, keyed by its ID
+func GetClassificationMap(context sdkTypes.Context, baseApp *baseapp.BaseApp, codec *codec.Codec, classificationsModuleName string) (map[string]mappables.Classification, error) {
+	mappableList, err := GetMappableList(context, baseApp, codec, classificationsModuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	classificationMap := make(map[string]mappables.Classification, len(mappableList))
+
+	for _, mappable := range mappableList {
+		if classification, ok := mappable.(mappables.Classification); ok {
+			classificationMap[classification.GetID().String()] = classification
+		}
+	}
+
+	return classificationMap, nil
+}
+
+// GetMaintainerList reads every maintainer through the list query of the maintainers module
+func GetMaintainerList(context sdkTypes.Context, baseApp *baseapp.BaseApp, codec *codec.Codec, maintainersModuleName string) ([]mappables.Maintainer, error) {
+	mappableList, err := GetMappableList(context, baseApp, codec, maintainersModuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	maintainerList := make([]mappables.Maintainer, 0, len(mappableList))
+
+	for _, mappable := range mappableList {
+		if maintainer, ok := mappable.(mappables.Maintainer); ok {
+			maintainerList = append(maintainerList, maintainer)
+		}
+	}
+
+	return maintainerList, nil
+}
+
+// RandomControlledMaintainer picks a maintainer of a classification accepted by the filter at random, along with the classification
+// and a simulation account provisioned to the maintaining identity, it returns false when there is none
+func RandomControlledMaintainer(r *rand.Rand, context sdkTypes.Context, authenticateAuxiliary schemaHelpers.Auxiliary, maintainerList []mappables.Maintainer, classificationMap map[string]mappables.Classification, filter func(mappables.Classification) bool, simulationAccountList []simulation.Account) (mappables.Maintainer, mappables.Classification, simulation.Account, bool) {
+	for _, i := range r.Perm(len(maintainerList)) {
+		classification, found := classificationMap[maintainerList[i].GetMaintainedClassificationID().String()]
+		if !found || !filter(classification) {
+			continue
+		}
+
+		if simulationAccount, found := RandomProvisionedAccount(r, context, authenticateAuxiliary, maintainerList[i].GetIdentityID(), simulationAccountList); found {
+			return maintainerList[i], classification, simulationAccount, true
+		}
+	}
+
+	return nil, nil, simulation.Account{}, false
+}
+
+// GetSplitList reads every split through the list query of the splits module, less the part its vesting schedules lock, leaving out the
+// splits that are locked entirely so that simulated messages only spend what can be moved
+func GetSplitList(context sdkTypes.Context, baseApp *baseapp.BaseApp, codec *codec.Codec, splitsModuleName string) ([]mappables.Split, error) {
+	mappableList, err := GetMappableList(context, baseApp, codec, splitsModuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	splitList := make([]mappables.Split, 0, len(mappableList))
+	lockedValues := make(map[string]map[string]sdkTypes.Dec)
+
+	for _, mappable := range mappableList {
+		split, ok := mappable.(mappables.Split)
+		if !ok {
+			continue
+		}
+
+		ownerLockedValues, ok := lockedValues[split.GetOwnerID().String()]
+		if !ok {
+			if ownerLockedValues, err = getLockedValues(context, baseApp, codec, splitsModuleName, split.GetOwnerID()); err != nil {
+				return nil, err
+			}
+
+			lockedValues[split.GetOwnerID().String()] = ownerLockedValues
+		}
+
+		if locked, ok := ownerLockedValues[split.GetOwnableID().String()]; ok && locked.IsPositive() {
+			if split.GetValue().LTE(locked) {
+				continue
+			}
+
+			split = split.Send(locked).(mappables.Split)
+		}
+
+		splitList = append(splitList, split)
+	}
+
+	return splitList, nil
+}
+
+// getLockedValues reads the values the vesting schedules of the owner lock through the vestings query of the splits module, keyed by the
+// ownable ID
+func getLockedValues(context sdkTypes.Context, baseApp *baseapp.BaseApp, codec *codec.Codec, splitsModuleName string, ownerID ids.ID) (map[string]sdkTypes.Dec, error) {
+	requestBytes, err := codec.MarshalJSON(vestingsRequest{OwnerID: ownerID, OwnableID: baseIDs.NewID("")})
+	if err != nil {
+		return nil, err
+	}
+
+	responseBytes, err := baseApp.QueryRouter().Route(splitsModuleName)(context, []string{vestingsQueryName}, abciTypes.RequestQuery{Data: requestBytes})
+	if err != nil {
+		return nil, err
+	}
+
+	var response vestingsResponse
+	if err := codec.UnmarshalJSON(responseBytes, &response); err != nil {
+		return nil, err
+	}
+
+	if !response.Success {
+		return nil, fmt.Errorf("%s vestings query failed", splitsModuleName)
+	}
+
+	lockedValues := make(map[string]sdkTypes.Dec)
+	for _, holding := range response.Holdings {
+		lockedValues[holding.OwnableID.String()] = holding.Locked
+	}
+
+	return lockedValues, nil
+}
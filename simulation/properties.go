@@ -0,0 +1,77 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"math/rand"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/AssetMantle/modules/schema/data"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	dataConstants "github.com/AssetMantle/modules/schema/data/constants"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/schema/lists"
+	baseLists "github.com/AssetMantle/modules/schema/lists/base"
+	"github.com/AssetMantle/modules/schema/properties"
+	baseProperties "github.com/AssetMantle/modules/schema/properties/base"
+	baseTypes "github.com/AssetMantle/modules/schema/types/base"
+)
+
+// GenerateRandomPropertyDefinitionList generates between one and maxCount string properties with random keys and no data,
+// so that classifications defined with them are distinct and documents of them can be created with any values
+func GenerateRandomPropertyDefinitionList(r *rand.Rand, maxCount int) lists.PropertyList {
+	propertyList := make([]properties.Property, r.Intn(maxCount)+1)
+
+	for i := range propertyList {
+		propertyList[i] = baseProperties.NewProperty(baseIDs.NewID(simulation.RandStringOfLength(r, 16)), baseData.NewStringData(""))
+	}
+
+	return baseLists.NewPropertyList(propertyList...)
+}
+
+// GenerateRandomMetaPropertyList generates a meta property with random data for every property of the list that is not excluded,
+// the data takes the type of the property so that the generated list conforms to the classification the list is read from
+func GenerateRandomMetaPropertyList(r *rand.Rand, propertyList lists.PropertyList, excludedPropertyIDs ...ids.PropertyID) lists.MetaPropertyList {
+	var metaPropertyList []properties.MetaProperty
+
+	for _, property := range propertyList.GetList() {
+		if isExcluded(property.GetID(), excludedPropertyIDs) {
+			continue
+		}
+
+		metaPropertyList = append(metaPropertyList, baseProperties.NewMetaProperty(property.GetKey(), generateRandomDataOfType(r, property.GetType())))
+	}
+
+	return baseLists.NewMetaPropertyList(metaPropertyList...)
+}
+
+func generateRandomDataOfType(r *rand.Rand, typeID ids.ID) data.Data {
+	switch {
+	case typeID.Compare(dataConstants.BooleanDataID) == 0:
+		return baseData.NewBooleanData(RandomBool(r))
+	case typeID.Compare(dataConstants.DecDataID) == 0:
+		return baseData.NewDecData(sdkTypes.NewDec(int64(r.Intn(1000) + 1)))
+	case typeID.Compare(dataConstants.HeightDataID) == 0:
+		return baseData.NewHeightData(baseTypes.NewHeight(int64(r.Intn(1000) + 1)))
+	case typeID.Compare(dataConstants.IDDataID) == 0:
+		return baseData.NewIDData(baseIDs.NewID(simulation.RandStringOfLength(r, 16)))
+	case typeID.Compare(dataConstants.ListDataID) == 0:
+		return baseData.NewListData(baseData.NewStringData(simulation.RandStringOfLength(r, 16)))
+	default:
+		return baseData.NewStringData(simulation.RandStringOfLength(r, 16))
+	}
+}
+
+func isExcluded(propertyID ids.PropertyID, excludedPropertyIDs []ids.PropertyID) bool {
+	for _, excludedPropertyID := range excludedPropertyIDs {
+		if propertyID.Compare(excludedPropertyID) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
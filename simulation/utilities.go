@@ -24,3 +24,21 @@ func GenerateRandomAddresses(r *rand.Rand) []sdkTypes.AccAddress {
 
 	return addresses
 }
+
+// RandomPositiveInt returns a random integer between one and max, both included
+func RandomPositiveInt(r *rand.Rand, max sdkTypes.Int) sdkTypes.Int {
+	if max.LTE(sdkTypes.OneInt()) {
+		return sdkTypes.OneInt()
+	}
+
+	return simulation.RandomAmount(r, max.SubRaw(1)).AddRaw(1)
+}
+
+// RandomSplitValue returns a random whole number of units of the value, or the value itself when it is smaller than a unit
+func RandomSplitValue(r *rand.Rand, value sdkTypes.Dec) sdkTypes.Dec {
+	if value.LT(sdkTypes.OneDec()) {
+		return value
+	}
+
+	return sdkTypes.NewDecFromInt(RandomPositiveInt(r, value.TruncateInt()))
+}
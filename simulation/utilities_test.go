@@ -4,6 +4,8 @@ import (
 	"math/rand"
 	"reflect"
 	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGenerateRandomAddresses(t *testing.T) {
@@ -63,3 +65,56 @@ func TestRandomBool(t *testing.T) {
 		})
 	}
 }
+
+func TestRandomPositiveInt(t *testing.T) {
+	type args struct {
+		r   *rand.Rand
+		max sdkTypes.Int
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{"one", args{rand.New(rand.NewSource(7)), sdkTypes.OneInt()}},
+		{"zero is raised to one", args{rand.New(rand.NewSource(7)), sdkTypes.ZeroInt()}},
+		{"larger than int64", args{rand.New(rand.NewSource(7)), sdkTypes.NewIntWithDecimal(1, 30)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				if got := RandomPositiveInt(tt.args.r, tt.args.max); !got.IsPositive() || got.GT(sdkTypes.MaxInt(tt.args.max, sdkTypes.OneInt())) {
+					t.Errorf("RandomPositiveInt() = %v, want between 1 and %v", got, tt.args.max)
+				}
+			}
+		})
+	}
+}
+
+func TestRandomSplitValue(t *testing.T) {
+	type args struct {
+		r     *rand.Rand
+		value sdkTypes.Dec
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{"smaller than a unit", args{rand.New(rand.NewSource(7)), sdkTypes.SmallestDec()}},
+		{"whole units", args{rand.New(rand.NewSource(7)), sdkTypes.NewDec(10)}},
+		{"fractional units", args{rand.New(rand.NewSource(7)), sdkTypes.NewDecWithPrec(105, 1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				got := RandomSplitValue(tt.args.r, tt.args.value)
+				if !got.IsPositive() || got.GT(tt.args.value) {
+					t.Errorf("RandomSplitValue() = %v, want between 0 and %v", got, tt.args.value)
+				}
+
+				if tt.args.value.GTE(sdkTypes.OneDec()) && !got.Equal(got.TruncateDec()) {
+					t.Errorf("RandomSplitValue() = %v, want a whole number of units", got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govClient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govREST "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+)
+
+// ProposalHandler registers the parameter change proposal with the command line and the REST interfaces of the governance module
+var ProposalHandler = govClient.NewProposalHandler(GetCmdSubmitProposal, ProposalRESTHandler)
+
+type parameterChangeProposalJSON struct {
+	Title               string            `json:"title" yaml:"title"`
+	Description         string            `json:"description" yaml:"description"`
+	ParameterChangeList []ParameterChange `json:"parameterChangeList" yaml:"parameterChangeList"`
+	Deposit             sdkTypes.Coins    `json:"deposit" yaml:"deposit"`
+}
+
+type parameterChangeProposalRequest struct {
+	BaseReq             rest.BaseReq        `json:"base_req" yaml:"base_req"`
+	Title               string              `json:"title" yaml:"title"`
+	Description         string              `json:"description" yaml:"description"`
+	ParameterChangeList []ParameterChange   `json:"parameterChangeList" yaml:"parameterChangeList"`
+	Proposer            sdkTypes.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit             sdkTypes.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+func GetCmdSubmitProposal(codec *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "parameter-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to change the parameters of the modules",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to change the parameters of the modules along with an initial deposit.
+The proposal details must be supplied via a JSON file, every change is validated against
+the parameter of the module before any of them is applied.
+
+Example:
+$ %s tx gov submit-proposal parameter-change <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Orders Parameter Change",
+  "description": "Lower the maximum order expiry",
+  "parameterChangeList": [
+    {
+      "module": "orders",
+      "parameter": {
+        "type": "github.com/AssetMantle/modules/schema/parameters/base/parameter",
+        "value": {
+          "id": {"type": "github.com/AssetMantle/modules/schema/ids/base/id", "value": {"idString": "maxOrderExpiry"}},
+          "data": {"type": "github.com/AssetMantle/modules/schema/data/base/decData", "value": {"value": "100000.000000000000000000"}}
+        }
+      }
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(command *cobra.Command, args []string) error {
+			reader := bufio.NewReader(command.InOrStdin())
+			transactionBuilder := auth.NewTxBuilderFromCLI(reader).WithTxEncoder(utils.GetTxEncoder(codec))
+			cliContext := context.NewCLIContextWithInput(reader).WithCodec(codec)
+
+			proposalBytes, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal parameterChangeProposalJSON
+			if err := codec.UnmarshalJSON(proposalBytes, &proposal); err != nil {
+				return err
+			}
+
+			message := govTypes.NewMsgSubmitProposal(NewParameterChangeProposal(proposal.Title, proposal.Description, proposal.ParameterChangeList), proposal.Deposit, cliContext.GetFromAddress())
+			if err := message.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliContext, transactionBuilder, []sdkTypes.Msg{message})
+		},
+	}
+}
+
+func ProposalRESTHandler(cliContext context.CLIContext) govREST.ProposalRESTHandler {
+	return govREST.ProposalRESTHandler{
+		SubRoute: "parameter_change",
+		Handler: func(responseWriter http.ResponseWriter, httpRequest *http.Request) {
+			var request parameterChangeProposalRequest
+			if !rest.ReadRESTReq(responseWriter, httpRequest, cliContext.Codec, &request) {
+				return
+			}
+
+			request.BaseReq = request.BaseReq.Sanitize()
+			if !request.BaseReq.ValidateBasic(responseWriter) {
+				return
+			}
+
+			message := govTypes.NewMsgSubmitProposal(NewParameterChangeProposal(request.Title, request.Description, request.ParameterChangeList), request.Deposit, request.Proposer)
+			if err := message.ValidateBasic(); err != nil {
+				rest.WriteErrorResponse(responseWriter, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			utils.WriteGenerateStdTxResponse(responseWriter, cliContext, request.BaseReq, []sdkTypes.Msg{message})
+		},
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/AssetMantle/modules/schema"
+	codecUtilities "github.com/AssetMantle/modules/utilities/codec"
+)
+
+func RegisterCodec(codec *codec.Codec) {
+	codecUtilities.RegisterModuleConcrete(codec, parameterChangeProposal{})
+}
+
+// the governance module signs and stores proposals with a codec of its own, which has to know of the parameters they carry
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeParameterChange)
+	schema.RegisterCodec(govTypes.ModuleCdc)
+	RegisterCodec(govTypes.ModuleCdc)
+}
@@ -0,0 +1,9 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+// RouterKey is the governance route the proposals changing module parameters are handled at
+const RouterKey = "parameters"
+
+const ProposalTypeParameterChange = "ModuleParameterChange"
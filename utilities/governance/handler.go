@@ -0,0 +1,71 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/parameters"
+)
+
+// NewProposalHandler handles the parameter change proposals of the modules, every change is run through the validator of the
+// parameter before any of them is applied
+func NewProposalHandler(moduleList ...helpers.Module) govTypes.Handler {
+	return func(context sdkTypes.Context, content govTypes.Content) error {
+		switch proposal := content.(type) {
+		case parameterChangeProposal:
+			return handleParameterChangeProposal(context, proposal, moduleList)
+		default:
+			return errors.IncorrectMessage
+		}
+	}
+}
+
+func handleParameterChangeProposal(context sdkTypes.Context, proposal parameterChangeProposal, moduleList []helpers.Module) error {
+	moduleParametersList := make([]helpers.Parameters, len(proposal.ParameterChangeList))
+	parameterList := make([]parameters.Parameter, len(proposal.ParameterChangeList))
+
+	for i, parameterChange := range proposal.ParameterChangeList {
+		for _, module := range moduleList {
+			if module.Name() == parameterChange.Module {
+				moduleParametersList[i] = module.GetParameters()
+				break
+			}
+		}
+
+		if moduleParametersList[i] == nil {
+			return errors.EntityNotFound
+		}
+
+		parameter := moduleParametersList[i].Get(parameterChange.Parameter.GetID())
+		if parameter == nil {
+			return errors.EntityNotFound
+		}
+
+		parameterList[i] = parameter.Mutate(parameterChange.Parameter.GetData())
+
+		if err := parameterList[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	for i, parameter := range parameterList {
+		moduleParametersList[i].Mutate(context, parameter)
+
+		context.EventManager().EmitEvent(
+			sdkTypes.NewEvent(
+				events.ParameterChanged,
+				sdkTypes.NewAttribute(events.AttributeKeyModule, proposal.ParameterChangeList[i].Module),
+				sdkTypes.NewAttribute(events.AttributeKeyParameterID, parameter.GetID().String()),
+				sdkTypes.NewAttribute(events.AttributeKeyData, parameter.GetData().String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tendermintDB "github.com/tendermint/tm-db"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/constants/events"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/schema"
+	"github.com/AssetMantle/modules/schema/data"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/parameters/base"
+	"github.com/AssetMantle/modules/utilities/governance"
+)
+
+var maxDataSizeID = baseIDs.NewID("maxDataSize")
+
+func createTestInput(t *testing.T) (sdkTypes.Context, helpers.Module) {
+	var Codec = codec.New()
+	schema.RegisterCodec(Codec)
+	sdkTypes.RegisterCodec(Codec)
+	codec.RegisterCrypto(Codec)
+	Codec.Seal()
+
+	storeKey := sdkTypes.NewKVStoreKey("test")
+	paramsStoreKey := sdkTypes.NewKVStoreKey("testParams")
+	paramsTransientStoreKeys := sdkTypes.NewTransientStoreKey("testParamsTransient")
+	paramsKeeper := params.NewKeeper(
+		Codec,
+		paramsStoreKey,
+		paramsTransientStoreKeys,
+	)
+	module := metas.Prototype().Initialize(storeKey, paramsKeeper.Subspace(metas.Prototype().Name()))
+
+	memDB := tendermintDB.NewMemDB()
+	commitMultiStore := store.NewCommitMultiStore(memDB)
+	commitMultiStore.MountStoreWithDB(storeKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsStoreKey, sdkTypes.StoreTypeIAVL, memDB)
+	commitMultiStore.MountStoreWithDB(paramsTransientStoreKeys, sdkTypes.StoreTypeTransient, memDB)
+	err := commitMultiStore.LoadLatestVersion()
+	require.Nil(t, err)
+
+	context := sdkTypes.NewContext(commitMultiStore, abciTypes.Header{
+		ChainID: "test",
+	}, false, log.NewNopLogger())
+
+	for _, parameter := range module.GetParameters().GetList() {
+		module.GetParameters().Mutate(context, parameter)
+	}
+
+	return context, module
+}
+
+func newMaxDataSizeChange(module string, Data data.Data) governance.ParameterChange {
+	return governance.NewParameterChange(module, baseTypes.NewParameter(maxDataSizeID, Data, nil))
+}
+
+func Test_NewProposalHandler(t *testing.T) {
+	context, module := createTestInput(t)
+	handler := governance.NewProposalHandler(module)
+	defaultMaxDataSize := module.GetParameters().Fetch(context, maxDataSizeID).Get(maxDataSizeID).GetData()
+
+	t.Run("UnknownContent", func(t *testing.T) {
+		require.Equal(t, errors.IncorrectMessage, handler(context, govTypes.NewTextProposal("title", "description")))
+	})
+
+	t.Run("UnknownModule", func(t *testing.T) {
+		require.Equal(t, errors.EntityNotFound, handler(context, governance.NewParameterChangeProposal("title", "description", []governance.ParameterChange{newMaxDataSizeChange("orders", baseData.NewDecData(sdkTypes.NewDec(10)))})))
+	})
+
+	t.Run("UnknownParameter", func(t *testing.T) {
+		require.Equal(t, errors.EntityNotFound, handler(context, governance.NewParameterChangeProposal("title", "description", []governance.ParameterChange{governance.NewParameterChange(metas.Prototype().Name(), baseTypes.NewParameter(baseIDs.NewID("unknown"), baseData.NewDecData(sdkTypes.NewDec(10)), nil))})))
+	})
+
+	t.Run("InvalidData", func(t *testing.T) {
+		require.Equal(t, errors.InvalidParameter, handler(context, governance.NewParameterChangeProposal("title", "description", []governance.ParameterChange{
+			newMaxDataSizeChange(metas.Prototype().Name(), baseData.NewDecData(sdkTypes.NewDec(10))),
+			newMaxDataSizeChange(metas.Prototype().Name(), baseData.NewDecData(sdkTypes.NewDec(-1))),
+		})))
+		require.Equal(t, errors.IncorrectFormat, handler(context, governance.NewParameterChangeProposal("title", "description", []governance.ParameterChange{newMaxDataSizeChange(metas.Prototype().Name(), baseData.NewStringData("10"))})))
+		require.Equal(t, 0, module.GetParameters().Fetch(context, maxDataSizeID).Get(maxDataSizeID).GetData().Compare(defaultMaxDataSize))
+	})
+
+	t.Run("PositiveCase", func(t *testing.T) {
+		context := context.WithEventManager(sdkTypes.NewEventManager())
+		require.Nil(t, handler(context, governance.NewParameterChangeProposal("title", "description", []governance.ParameterChange{newMaxDataSizeChange(metas.Prototype().Name(), baseData.NewDecData(sdkTypes.NewDec(10)))})))
+		require.Equal(t, 0, module.GetParameters().Fetch(context, maxDataSizeID).Get(maxDataSizeID).GetData().Compare(baseData.NewDecData(sdkTypes.NewDec(10))))
+		require.Equal(t, 1, len(context.EventManager().Events()))
+		require.Equal(t, events.ParameterChanged, context.EventManager().Events()[0].Type)
+	})
+}
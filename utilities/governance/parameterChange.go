@@ -0,0 +1,36 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	"fmt"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/parameters"
+)
+
+// ParameterChange sets the data of a parameter of a module, only the ID and the data of the parameter are carried, it is validated
+// against the parameter the module defines when the proposal passes
+type ParameterChange struct {
+	Module    string               `json:"module" yaml:"module"`
+	Parameter parameters.Parameter `json:"parameter" yaml:"parameter"`
+}
+
+func (parameterChange ParameterChange) String() string {
+	return fmt.Sprintf("%s: %s", parameterChange.Module, parameterChange.Parameter.String())
+}
+func (parameterChange ParameterChange) ValidateBasic() error {
+	if parameterChange.Module == "" || parameterChange.Parameter == nil || parameterChange.Parameter.GetID() == nil || parameterChange.Parameter.GetData() == nil {
+		return errors.IncorrectFormat
+	}
+
+	return nil
+}
+
+func NewParameterChange(module string, parameter parameters.Parameter) ParameterChange {
+	return ParameterChange{
+		Module:    module,
+		Parameter: parameter,
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	"strings"
+
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/AssetMantle/modules/constants/errors"
+)
+
+type parameterChangeProposal struct {
+	Title               string            `json:"title" yaml:"title"`
+	Description         string            `json:"description" yaml:"description"`
+	ParameterChangeList []ParameterChange `json:"parameterChangeList" yaml:"parameterChangeList"`
+}
+
+var _ govTypes.Content = (*parameterChangeProposal)(nil)
+
+func (parameterChangeProposal parameterChangeProposal) GetTitle() string {
+	return parameterChangeProposal.Title
+}
+func (parameterChangeProposal parameterChangeProposal) GetDescription() string {
+	return parameterChangeProposal.Description
+}
+func (parameterChangeProposal parameterChangeProposal) ProposalRoute() string {
+	return RouterKey
+}
+func (parameterChangeProposal parameterChangeProposal) ProposalType() string {
+	return ProposalTypeParameterChange
+}
+func (parameterChangeProposal parameterChangeProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(parameterChangeProposal); err != nil {
+		return err
+	}
+
+	if len(parameterChangeProposal.ParameterChangeList) == 0 {
+		return errors.IncorrectFormat
+	}
+
+	for _, parameterChange := range parameterChangeProposal.ParameterChangeList {
+		if err := parameterChange.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+func (parameterChangeProposal parameterChangeProposal) String() string {
+	var stringBuilder strings.Builder
+
+	stringBuilder.WriteString("Parameter Change Proposal:\n")
+	stringBuilder.WriteString("  Title:       " + parameterChangeProposal.Title + "\n")
+	stringBuilder.WriteString("  Description: " + parameterChangeProposal.Description + "\n")
+	stringBuilder.WriteString("  Changes:\n")
+
+	for _, parameterChange := range parameterChangeProposal.ParameterChangeList {
+		stringBuilder.WriteString("    " + parameterChange.String() + "\n")
+	}
+
+	return stringBuilder.String()
+}
+
+func NewParameterChangeProposal(title string, description string, parameterChangeList []ParameterChange) govTypes.Content {
+	return parameterChangeProposal{
+		Title:               title,
+		Description:         description,
+		ParameterChangeList: parameterChangeList,
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	"strings"
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	baseData "github.com/AssetMantle/modules/schema/data/base"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	baseTypes "github.com/AssetMantle/modules/schema/parameters/base"
+)
+
+func Test_ParameterChangeProposal(t *testing.T) {
+	parameterChange := NewParameterChange("metas", baseTypes.NewParameter(baseIDs.NewID("maxDataSize"), baseData.NewDecData(sdkTypes.NewDec(100)), nil))
+	proposal := NewParameterChangeProposal("title", "description", []ParameterChange{parameterChange})
+
+	require.Equal(t, "title", proposal.GetTitle())
+	require.Equal(t, "description", proposal.GetDescription())
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, ProposalTypeParameterChange, proposal.ProposalType())
+	require.Nil(t, proposal.ValidateBasic())
+	require.True(t, strings.Contains(proposal.String(), parameterChange.String()))
+
+	require.NotNil(t, NewParameterChangeProposal("", "description", []ParameterChange{parameterChange}).ValidateBasic())
+	require.Equal(t, errors.IncorrectFormat, NewParameterChangeProposal("title", "description", nil).ValidateBasic())
+	require.Equal(t, errors.IncorrectFormat, NewParameterChangeProposal("title", "description", []ParameterChange{NewParameterChange("", parameterChange.Parameter)}).ValidateBasic())
+	require.Equal(t, errors.IncorrectFormat, NewParameterChangeProposal("title", "description", []ParameterChange{NewParameterChange("metas", nil)}).ValidateBasic())
+}
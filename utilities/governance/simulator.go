@@ -0,0 +1,46 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package governance
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/AssetMantle/modules/schema/data"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+// SimulateParameterChangeProposalContent proposes one of the simulated parameter changes of the module, it proposes nothing
+// when the module simulates none
+func SimulateParameterChangeProposalContent(codec *codec.Codec, parameters helpers.Parameters, paramChangeList func(*rand.Rand) []simulation.ParamChange) simulation.ContentSimulatorFn {
+	return func(r *rand.Rand, _ sdkTypes.Context, _ []simulation.Account) govTypes.Content {
+		simulatedParamChangeList := paramChangeList(r)
+		if len(simulatedParamChangeList) == 0 {
+			return nil
+		}
+
+		paramChange := simulatedParamChangeList[r.Intn(len(simulatedParamChangeList))]
+
+		parameter := parameters.Get(baseIDs.NewID(paramChange.Key))
+		if parameter == nil {
+			return nil
+		}
+
+		var Data data.Data
+		if err := codec.UnmarshalJSON([]byte(paramChange.SimValue(r)), &Data); err != nil {
+			panic(err)
+		}
+
+		return NewParameterChangeProposal(
+			simulation.RandStringOfLength(r, 140),
+			simulation.RandStringOfLength(r, 5000),
+			[]ParameterChange{NewParameterChange(paramChange.Subspace, parameter.Mutate(Data))},
+		)
+	}
+}
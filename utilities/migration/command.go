@@ -0,0 +1,90 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	tendermintTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+// GetMigrateCmd upgrades the genesis of a module exported at a version to the consensus version of the module, offline
+func GetMigrateCmd(moduleBasicManager module.BasicManager, codec *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate [module] [from-version] [genesis-file]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Migrate the genesis of a module to its consensus version",
+		Long: fmt.Sprintf(`Migrate the genesis of a module exported at a version to the consensus version of the module and print to STDOUT.
+
+Example:
+$ %s migrate orders 1 /path/to/genesis.json
+`, version.ServerName),
+		RunE: func(command *cobra.Command, args []string) error {
+			Module, ok := moduleBasicManager[args[0]].(helpers.Module)
+			if !ok {
+				return fmt.Errorf("module %s not found", args[0])
+			}
+
+			fromVersion, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			genesisDocument, err := tendermintTypes.GenesisDocFromFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			genesisState, err := MigrateGenesisState(Module, fromVersion, codec, genesisDocument.AppState)
+			if err != nil {
+				return err
+			}
+
+			genesisDocument.AppState = genesisState
+
+			genesisDocumentBytes, err := codec.MarshalJSONIndent(genesisDocument, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			sortedGenesisDocumentBytes, err := sdkTypes.SortJSON(genesisDocumentBytes)
+			if err != nil {
+				return err
+			}
+
+			command.Println(string(sortedGenesisDocumentBytes))
+
+			return nil
+		},
+	}
+}
+
+// MigrateGenesisState upgrades the genesis of the module within the application genesis state, along with the records its migrations derive
+// for the modules it relies on
+func MigrateGenesisState(Module helpers.Module, fromVersion uint64, codec *codec.Codec, genesisState json.RawMessage) (json.RawMessage, error) {
+	var genesisStateMap map[string]json.RawMessage
+	if err := codec.UnmarshalJSON(genesisState, &genesisStateMap); err != nil {
+		return nil, err
+	}
+
+	if _, found := genesisStateMap[Module.Name()]; !found {
+		return nil, fmt.Errorf("genesis of module %s not found", Module.Name())
+	}
+
+	genesisStateMap, err := Module.MigrateGenesis(genesisStateMap, fromVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.MarshalJSON(genesisStateMap)
+}
@@ -0,0 +1,46 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/modules/assets"
+	"github.com/AssetMantle/modules/modules/metas"
+	"github.com/AssetMantle/modules/modules/orders"
+	"github.com/AssetMantle/modules/modules/splits"
+)
+
+func TestMigrateGenesisState(t *testing.T) {
+	Codec := codec.New()
+	genesisState, err := Codec.MarshalJSON(map[string]json.RawMessage{
+		metas.Prototype().Name():  metas.Prototype().DefaultGenesis(),
+		orders.Prototype().Name(): orders.Prototype().DefaultGenesis(),
+		splits.Prototype().Name(): splits.Prototype().DefaultGenesis(),
+	})
+	require.Nil(t, err)
+
+	migratedGenesisState, err := MigrateGenesisState(orders.Prototype(), 1, Codec, genesisState)
+	require.Nil(t, err)
+	require.JSONEq(t, string(genesisState), string(migratedGenesisState))
+
+	_, err = MigrateGenesisState(orders.Prototype(), orders.Prototype().ConsensusVersion()+1, Codec, genesisState)
+	require.NotNil(t, err)
+
+	_, err = MigrateGenesisState(assets.Prototype(), 1, Codec, genesisState)
+	require.NotNil(t, err)
+}
+
+func TestGetMigrateCmd(t *testing.T) {
+	command := GetMigrateCmd(module.NewBasicManager(orders.Prototype()), codec.New())
+	require.Equal(t, "migrate", command.Name())
+	require.NotNil(t, command.Args(command, []string{"orders", "1"}))
+	require.NotNil(t, command.RunE(command, []string{"metas", "1", "genesis.json"}))
+	require.NotNil(t, command.RunE(command, []string{"orders", "one", "genesis.json"}))
+}
@@ -0,0 +1,32 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/ids"
+)
+
+type queryKeeper struct {
+	mapper    helpers.Mapper
+	codec     *codec.Codec
+	keyFromID func(ids.ID) helpers.Key
+}
+
+var _ helpers.QueryKeeper = (*queryKeeper)(nil)
+
+func (queryKeeper queryKeeper) Enquire(context sdkTypes.Context, queryRequest helpers.QueryRequest) helpers.QueryResponse {
+	request := queryRequestFromInterface(queryRequest)
+	mappableList, nextCursor, err := Paginate(context, queryKeeper.mapper, queryKeeper.keyFromID(request.PartialID), request.Offset, request.Limit, request.Cursor, request.Reverse)
+
+	return newQueryResponse(queryKeeper.codec, mappableList, nextCursor, err)
+}
+
+func (queryKeeper queryKeeper) Initialize(mapper helpers.Mapper, _ helpers.Parameters, _ []interface{}) helpers.Keeper {
+	queryKeeper.mapper = mapper
+	return queryKeeper
+}
@@ -0,0 +1,70 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/constants"
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+// Paginate collects a page of mappables stored under the partial key, in store key order or in reverse.
+// Iteration resumes from the cursor when one is given, the offset is then skipped and at most limit mappables are returned
+// along with the cursor of the next page, which is empty once the last page is reached.
+func Paginate(context sdkTypes.Context, mapper helpers.Mapper, partialKey helpers.Key, offset int, limit int, cursor string, reverse bool) ([]helpers.Mappable, string, error) {
+	cursorBytes, err := hex.DecodeString(cursor)
+	if err != nil {
+		return nil, "", errors.IncorrectFormat
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+
+	if limit <= 0 {
+		limit = constants.DefaultPaginationLimit
+	} else if limit > constants.MaxPaginationLimit {
+		limit = constants.MaxPaginationLimit
+	}
+
+	var mappableList []helpers.Mappable
+
+	nextCursor := ""
+	accumulator := func(mappable helpers.Mappable) bool {
+		storeKeyBytes := mappable.GetKey().GenerateStoreKeyBytes()
+
+		if len(cursorBytes) != 0 {
+			if comparison := bytes.Compare(storeKeyBytes, cursorBytes); (!reverse && comparison < 0) || (reverse && comparison > 0) {
+				return false
+			}
+		}
+
+		if offset > 0 {
+			offset--
+			return false
+		}
+
+		if len(mappableList) == limit {
+			nextCursor = hex.EncodeToString(storeKeyBytes)
+			return true
+		}
+
+		mappableList = append(mappableList, mappable)
+
+		return false
+	}
+
+	if reverse {
+		mapper.ReverseIterate(context, partialKey, accumulator)
+	} else {
+		mapper.Iterate(context, partialKey, accumulator)
+	}
+
+	return mappableList, nextCursor, nil
+}
@@ -0,0 +1,43 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/utilities/test/schema/helpers/base"
+)
+
+func TestPaginate(t *testing.T) {
+	context, storeKey, _ := base.SetupTest(t)
+
+	mapper := baseHelpers.NewMapper(base.KeyPrototype, base.MappablePrototype).Initialize(storeKey)
+	mapper.Create(context, base.NewMappable("test1", "value1"))
+	mapper.Create(context, base.NewMappable("test2", "value2"))
+	mapper.Create(context, base.NewMappable("test3", "value3"))
+	mapper.Create(context, base.NewMappable("other", "value4"))
+
+	mappableList, nextCursor, err := Paginate(context, mapper, base.NewKey("test"), 0, 2, "", false)
+	require.Nil(t, err)
+	require.Equal(t, []helpers.Mappable{base.NewMappable("test1", "value1"), base.NewMappable("test2", "value2")}, mappableList)
+	require.Equal(t, hex.EncodeToString(base.NewKey("test3").GenerateStoreKeyBytes()), nextCursor)
+
+	mappableList, nextCursor, err = Paginate(context, mapper, base.NewKey("test"), 0, 2, nextCursor, false)
+	require.Nil(t, err)
+	require.Equal(t, []helpers.Mappable{base.NewMappable("test3", "value3")}, mappableList)
+	require.Equal(t, "", nextCursor)
+
+	mappableList, _, err = Paginate(context, mapper, base.NewKey("test"), 1, 0, "", true)
+	require.Nil(t, err)
+	require.Equal(t, []helpers.Mappable{base.NewMappable("test2", "value2"), base.NewMappable("test1", "value1")}, mappableList)
+
+	_, _, err = Paginate(context, mapper, base.NewKey("test"), 0, 0, "invalid", false)
+	require.Equal(t, errors.IncorrectFormat, err)
+}
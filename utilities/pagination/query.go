@@ -0,0 +1,38 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	"github.com/AssetMantle/modules/schema/ids"
+)
+
+// queryName is the name every module serves its list query under
+const queryName = "list"
+
+// NewQuery creates the list query of the module, which pages through the mappables of its store whose ID starts with the partial ID,
+// the codec of the module encodes the requests and responses and keyFromID turns the partial ID into the partial key of its mappables
+func NewQuery(moduleName string, codec *codec.Codec, keyFromID func(ids.ID) helpers.Key) helpers.Query {
+	return baseHelpers.NewQuery(
+		queryName,
+		"",
+		"",
+
+		moduleName,
+
+		func() helpers.QueryRequest { return queryRequest{codec: codec} },
+		func() helpers.QueryResponse { return queryResponse{codec: codec} },
+		func() helpers.QueryKeeper { return queryKeeper{codec: codec, keyFromID: keyFromID} },
+
+		constants.Prefix,
+		constants.Offset,
+		constants.Limit,
+		constants.Cursor,
+		constants.Reverse,
+	)
+}
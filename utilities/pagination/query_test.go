@@ -0,0 +1,78 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/AssetMantle/modules/constants/errors"
+	"github.com/AssetMantle/modules/schema/helpers"
+	baseHelpers "github.com/AssetMantle/modules/schema/helpers/base"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+	"github.com/AssetMantle/modules/utilities/test/schema/helpers/base"
+)
+
+func TestNewQuery(t *testing.T) {
+	context, storeKey, _ := base.SetupTest(t)
+
+	Codec := base.MakeCodec()
+	base.MappablePrototype().RegisterCodec(Codec)
+
+	mapper := baseHelpers.NewMapper(base.KeyPrototype, base.MappablePrototype).Initialize(storeKey)
+	mapper.Create(context, base.NewMappable("test1", "value1"))
+	mapper.Create(context, base.NewMappable("test2", "value2"))
+	mapper.Create(context, base.NewMappable("test3", "value3"))
+	mapper.Create(context, base.NewMappable("other", "value4"))
+
+	keyFromID := func(id ids.ID) helpers.Key { return base.NewKey(id.String()) }
+	require.Equal(t, queryName, NewQuery("test", Codec, keyFromID).GetName())
+
+	testQueryKeeper := queryKeeper{codec: Codec, keyFromID: keyFromID}.Initialize(mapper, nil, []interface{}{}).(queryKeeper)
+	cursor := hex.EncodeToString(base.NewKey("test3").GenerateStoreKeyBytes())
+
+	tests := []struct {
+		name         string
+		vars         map[string]string
+		wantRequest  helpers.QueryRequest
+		wantValid    bool
+		wantResponse helpers.QueryResponse
+	}{
+		{"first page", map[string]string{"list": "test", "limit": "2"}, newQueryRequest(Codec, baseIDs.NewID("test"), 0, 2, "", false), true, newQueryResponse(Codec, []helpers.Mappable{base.NewMappable("test1", "value1"), base.NewMappable("test2", "value2")}, cursor, nil)},
+		{"page resumed from the cursor", map[string]string{"list": "test", "limit": "2", "cursor": cursor}, newQueryRequest(Codec, baseIDs.NewID("test"), 0, 2, cursor, false), true, newQueryResponse(Codec, []helpers.Mappable{base.NewMappable("test3", "value3")}, "", nil)},
+		{"prefix given by flag in reverse", map[string]string{"prefix": "test", "offset": "1", "reverse": "true"}, newQueryRequest(Codec, baseIDs.NewID("test"), 1, 0, "", true), true, newQueryResponse(Codec, []helpers.Mappable{base.NewMappable("test2", "value2"), base.NewMappable("test1", "value1")}, "", nil)},
+		{"negative offset", map[string]string{"list": "other", "offset": "-1"}, newQueryRequest(Codec, baseIDs.NewID("other"), -1, 0, "", false), false, newQueryResponse(Codec, []helpers.Mappable{base.NewMappable("other", "value4")}, "", nil)},
+		{"cursor not hexadecimal", map[string]string{"list": "test", "cursor": "cursor"}, newQueryRequest(Codec, baseIDs.NewID("test"), 0, 0, "cursor", false), false, newQueryResponse(Codec, nil, "", errors.IncorrectFormat)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := queryRequest{codec: Codec}.FromMap(tt.vars)
+			require.Equal(t, tt.wantRequest, request)
+			require.Equal(t, tt.wantValid, request.Validate() == nil)
+
+			encodedRequest, err := request.Encode()
+			require.Nil(t, err)
+			decodedRequest, err := queryRequest{codec: Codec}.Decode(encodedRequest)
+			require.Nil(t, err)
+			require.Equal(t, request, decodedRequest)
+
+			response := testQueryKeeper.Enquire(context, request)
+			require.Equal(t, tt.wantResponse, response)
+			require.Equal(t, tt.wantResponse.GetError() == nil, response.IsSuccessful())
+
+			if response.IsSuccessful() {
+				encodedResponse, err := response.Encode()
+				require.Nil(t, err)
+				decodedResponse, err := queryResponse{codec: Codec}.Decode(encodedResponse)
+				require.Nil(t, err)
+				require.Equal(t, response, decodedResponse)
+			}
+		})
+	}
+
+	require.Equal(t, queryRequest{}, queryRequestFromInterface(nil))
+}
@@ -0,0 +1,85 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"strconv"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+	"github.com/AssetMantle/modules/schema/helpers/constants"
+	"github.com/AssetMantle/modules/schema/ids"
+	baseIDs "github.com/AssetMantle/modules/schema/ids/base"
+)
+
+type queryRequest struct {
+	codec *codec.Codec
+
+	PartialID ids.ID `json:"partialID"`
+	Offset    int    `json:"offset" valid:"range(0|2147483647)~offset must not be negative"`
+	Limit     int    `json:"limit" valid:"range(0|2147483647)~limit must not be negative"`
+	Cursor    string `json:"cursor" valid:"hexadecimal~cursor must be hexadecimal,optional"`
+	Reverse   bool   `json:"reverse"`
+}
+
+var _ helpers.QueryRequest = (*queryRequest)(nil)
+
+// Validate godoc
+// @Summary List the mappables of a module page by page
+// @Description Lists the mappables of the module whose ID starts with the partial ID, resuming from the cursor returned by the previous page.
+// @Accept text/plain
+// @Produce json
+// @Tags List
+// @Param module path string true "Module"
+// @Param list path string false "Partial ID"
+// @Param offset query int false "Offset"
+// @Param limit query int false "Limit"
+// @Param cursor query string false "Cursor"
+// @Param reverse query bool false "Reverse"
+// @Success 200 {object} queryResponse "Message for a successful search."
+// @Failure default  {object}  queryResponse "Message for an unexpected error."
+// @Router /{module}/list/{list} [get]
+func (queryRequest queryRequest) Validate() error {
+	_, err := govalidator.ValidateStruct(queryRequest)
+	return err
+}
+func (queryRequest queryRequest) FromCLI(cliCommand helpers.CLICommand, _ context.CLIContext) helpers.QueryRequest {
+	return newQueryRequest(queryRequest.codec, baseIDs.NewID(cliCommand.ReadString(constants.Prefix)), cliCommand.ReadInt(constants.Offset), cliCommand.ReadInt(constants.Limit), cliCommand.ReadString(constants.Cursor), cliCommand.ReadBool(constants.Reverse))
+}
+func (queryRequest queryRequest) FromMap(vars map[string]string) helpers.QueryRequest {
+	partialID, ok := vars[queryName]
+	if !ok {
+		partialID = vars[constants.Prefix.GetName()]
+	}
+
+	offset, _ := strconv.Atoi(vars[constants.Offset.GetName()])
+	limit, _ := strconv.Atoi(vars[constants.Limit.GetName()])
+	reverse, _ := strconv.ParseBool(vars[constants.Reverse.GetName()])
+
+	return newQueryRequest(queryRequest.codec, baseIDs.NewID(partialID), offset, limit, vars[constants.Cursor.GetName()], reverse)
+}
+func (queryRequest queryRequest) Encode() ([]byte, error) {
+	return queryRequest.codec.MarshalJSON(queryRequest)
+}
+func (queryRequest queryRequest) Decode(bytes []byte) (helpers.QueryRequest, error) {
+	if err := queryRequest.codec.UnmarshalJSON(bytes, &queryRequest); err != nil {
+		return nil, err
+	}
+
+	return queryRequest, nil
+}
+func queryRequestFromInterface(request helpers.QueryRequest) queryRequest {
+	switch value := request.(type) {
+	case queryRequest:
+		return value
+	default:
+		return queryRequest{}
+	}
+}
+func newQueryRequest(codec *codec.Codec, partialID ids.ID, offset int, limit int, cursor string, reverse bool) helpers.QueryRequest {
+	return queryRequest{codec: codec, PartialID: partialID, Offset: offset, Limit: limit, Cursor: cursor, Reverse: reverse}
+}
@@ -0,0 +1,52 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+type queryResponse struct {
+	codec *codec.Codec
+
+	Success    bool               `json:"success"`
+	Error      error              `json:"error" swaggertype:"string"`
+	List       []helpers.Mappable `json:"list"`
+	NextCursor string             `json:"nextCursor"`
+}
+
+var _ helpers.QueryResponse = (*queryResponse)(nil)
+
+func (queryResponse queryResponse) IsSuccessful() bool {
+	return queryResponse.Success
+}
+func (queryResponse queryResponse) GetError() error {
+	return queryResponse.Error
+}
+func (queryResponse queryResponse) Encode() ([]byte, error) {
+	return queryResponse.codec.MarshalJSON(queryResponse)
+}
+func (queryResponse queryResponse) Decode(bytes []byte) (helpers.QueryResponse, error) {
+	if err := queryResponse.codec.UnmarshalJSON(bytes, &queryResponse); err != nil {
+		return nil, err
+	}
+
+	return queryResponse, nil
+}
+func newQueryResponse(codec *codec.Codec, mappableList []helpers.Mappable, nextCursor string, error error) helpers.QueryResponse {
+	success := true
+	if error != nil {
+		success = false
+	}
+
+	return queryResponse{
+		codec:      codec,
+		Success:    success,
+		Error:      error,
+		List:       mappableList,
+		NextCursor: nextCursor,
+	}
+}
@@ -60,7 +60,7 @@ type testKey struct {
 var _ helpers.Key = (*testKey)(nil)
 
 func (t testKey) GenerateStoreKeyBytes() []byte {
-	return append([]byte{0x11}, []byte(t.ID)...)
+	return append([]byte{0x01}, []byte(t.ID)...)
 }
 
 func (t testKey) RegisterCodec(codec *codec.Codec) {
@@ -0,0 +1,52 @@
+// Copyright [2021] - [2022], AssetMantle Pte. Ltd. and the code contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package helpers
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/AssetMantle/modules/schema/helpers"
+)
+
+type invariant struct {
+	mapper     helpers.Mapper
+	parameters helpers.Parameters
+}
+
+var _ helpers.Invariant = (*invariant)(nil)
+
+func (i invariant) GetName() string {
+	return "testInvariant"
+}
+
+func (i invariant) Check(_ sdkTypes.Context) (string, bool) {
+	return "", false
+}
+
+func (i invariant) Initialize(mapper helpers.Mapper, parameters helpers.Parameters, _ ...interface{}) helpers.Invariant {
+	return invariant{mapper, parameters}
+}
+
+func TestInvariantPrototype() helpers.Invariant {
+	return invariant{}
+}
+
+type invariantRegistry map[string]sdkTypes.Invariant
+
+var _ sdkTypes.InvariantRegistry = (*invariantRegistry)(nil)
+
+func (invariantRegistry invariantRegistry) RegisterRoute(moduleName, route string, invariant sdkTypes.Invariant) {
+	invariantRegistry[moduleName+"/"+route] = invariant
+}
+
+func (invariantRegistry invariantRegistry) Get(moduleName, route string) sdkTypes.Invariant {
+	return invariantRegistry[moduleName+"/"+route]
+}
+
+func NewTestInvariantRegistry() interface {
+	sdkTypes.InvariantRegistry
+	Get(string, string) sdkTypes.Invariant
+} {
+	return invariantRegistry{}
+}